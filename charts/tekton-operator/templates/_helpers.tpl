@@ -112,10 +112,28 @@ tekton-operator
 {{- end -}}
 
 {{- define "tekton-operator.pruner-image" -}}
-{{- if contains "sha256:" .Values.pruner.image.tag -}}
-{{- printf "%s@%s" .Values.pruner.image.repository .Values.pruner.image.tag -}}
+{{- $tag := default .Chart.AppVersion .Values.pruner.image.tag -}}
+{{- $image := default "ghcr.io/tektoncd/operator/pruner-5927729788eb54a0ffdceb259cf26686" .Values.pruner.image.repository -}}
+{{- if contains "sha256:" $tag -}}
+{{- printf "%s@%s" $image $tag -}}
 {{- else -}}
-{{- printf "%s:%s" .Values.pruner.image.repository .Values.pruner.image.tag -}}
+{{- printf "%s:%s" $image $tag -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "tekton-operator.verification-image" -}}
+{{- if contains "sha256:" .Values.verification.image.tag -}}
+{{- printf "%s@%s" .Values.verification.image.repository .Values.verification.image.tag -}}
+{{- else -}}
+{{- printf "%s:%s" .Values.verification.image.repository .Values.verification.image.tag -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "tekton-operator.results-backup-uploader-image" -}}
+{{- if contains "sha256:" .Values.resultsBackup.uploaderImage.tag -}}
+{{- printf "%s@%s" .Values.resultsBackup.uploaderImage.repository .Values.resultsBackup.uploaderImage.tag -}}
+{{- else -}}
+{{- printf "%s:%s" .Values.resultsBackup.uploaderImage.repository .Values.resultsBackup.uploaderImage.tag -}}
 {{- end -}}
 {{- end -}}
 
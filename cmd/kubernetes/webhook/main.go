@@ -69,6 +69,7 @@ func main() {
 		certificates.NewController,
 		webhook.NewDefaultingAdmissionController,
 		webhook.NewValidationAdmissionController,
+		webhook.NewConversionController,
 		webhook.NewConfigValidationController,
 	)
 }
@@ -106,6 +106,7 @@ func main() {
 		certificates.NewController,
 		webhook.NewDefaultingAdmissionController,
 		webhook.NewValidationAdmissionController,
+		webhook.NewConversionController,
 		webhook.NewConfigValidationController,
 	)
 }
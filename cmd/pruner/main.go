@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The pruner deletes the completed PipelineRuns and TaskRuns of the
+// namespaces configured by the operator, it is run by the CronJobs of the
+// job-based pruner.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/tektoncd/operator/pkg/jobpruner"
+	"go.uber.org/zap"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/signals"
+)
+
+func main() {
+	namespaces := flag.String("namespaces", "", "space separated prune configurations of the namespaces, namespace;--keep=N,--keep-since=M;resources;prunePerResource")
+	dryRun := flag.Bool("dry-run", false, "report the runs which would be deleted without deleting them")
+	resultsGracePeriod := flag.Duration("results-grace-period", 0, "keep the runs not stored in Tekton Results until this long after their completion, 0 disables it")
+	cfg := injection.ParseAndGetRESTConfigOrDie()
+
+	zapLogger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatalf("failed to create the logger: %v", err)
+	}
+	logger := zapLogger.Sugar()
+	defer func() { _ = logger.Sync() }()
+
+	configs, err := jobpruner.ParseNamespaceConfigs(*namespaces)
+	if err != nil {
+		logger.Fatalw("invalid prune configuration", "error", err)
+	}
+
+	ctx := signals.NewContext()
+	summary, candidates := jobpruner.New(dynamic.NewForConfigOrDie(cfg), logger).
+		WithResultsGuard(*resultsGracePeriod).Prune(ctx, configs, *dryRun)
+	logger.Infow("pruning completed", "dryRun", summary.DryRun, "deleted", summary.Deleted, "candidates", summary.Candidates,
+		"kept", summary.Kept, "failed", summary.Failed)
+
+	err = jobpruner.Report(ctx, kubernetes.NewForConfigOrDie(cfg),
+		os.Getenv(jobpruner.NamespaceEnvKey), os.Getenv(jobpruner.JobNameEnvKey), summary, candidates)
+	if err != nil {
+		logger.Errorw("failed to report the summary", "error", err)
+	}
+	if summary.Failed > 0 {
+		_ = logger.Sync()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/openshift-pipelines/pipelines-as-code/pkg/cli"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/operator/pkg/reconciler/render"
+	"sigs.k8s.io/yaml"
+)
+
+func DiffCommand(ioStreams *cli.IOStreams) *cobra.Command {
+	opts := &renderOptions{}
+	var newKodata, output string
+	cmd := &cobra.Command{
+		Use:   "diff OLD [NEW]",
+		Short: "Compares the manifests the operator applies for two custom resources or two payloads",
+		Long: `Compares the manifests the operator applies, resource by resource.
+
+Either pass two custom resource files rendered against the same payload,
+or one custom resource file rendered against the payloads given by
+--kodata and --new-kodata, for instance two operator releases.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("Need one or two arguments, the custom resource files")
+			}
+			oldFile, newFile := args[0], args[0]
+			if len(args) == 2 {
+				newFile = args[1]
+			}
+			oldOpts := opts.options()
+			newOpts := oldOpts
+			if newKodata != "" {
+				newOpts.KoDataPath = newKodata
+			}
+			if oldFile == newFile && oldOpts.KoDataPath == newOpts.KoDataPath {
+				return fmt.Errorf("Nothing to compare, pass a second custom resource file or --new-kodata")
+			}
+
+			oldManifest, err := renderFile(oldFile, oldOpts)
+			if err != nil {
+				return fmt.Errorf("failed to render %s: %w", oldFile, err)
+			}
+			newManifest, err := renderFile(newFile, newOpts)
+			if err != nil {
+				return fmt.Errorf("failed to render %s: %w", newFile, err)
+			}
+			return writeDiff(ioStreams.Out, render.Diff(oldManifest, newManifest), output)
+		},
+	}
+	opts.addFlags(cmd)
+	cmd.Flags().StringVar(&newKodata, "new-kodata", "", "Directory holding the component payloads to compare with, defaults to --kodata")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format, text, json or yaml")
+	return cmd
+}
+
+func writeDiff(out io.Writer, diffs []render.ResourceDiff, output string) error {
+	switch output {
+	case "text":
+		return render.WriteDiff(out, diffs)
+	case "json":
+		data, err := json.MarshalIndent(diffs, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(diffs)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+	return fmt.Errorf("Unsupported output format %s", output)
+}
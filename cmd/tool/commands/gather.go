@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openshift-pipelines/pipelines-as-code/pkg/cli"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/gather"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+type gatherOptions struct {
+	kubeconfig string
+	output     string
+	gather     gather.Options
+}
+
+func (o *gatherOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file (default $KUBECONFIG or ~/.kube/config)")
+	cmd.Flags().StringVarP(&o.output, "output", "o", "", "Tarball to write, - for stdout (default must-gather-<timestamp>.tar.gz)")
+	cmd.Flags().StringVar(&o.gather.OperatorNamespace, "namespace", gather.DefaultOperatorNamespace, "Namespace of the operator")
+	cmd.Flags().StringVar(&o.gather.TargetNamespace, "target-namespace", "", "Namespace of the components (default the target namespace of the TektonConfig)")
+	cmd.Flags().DurationVar(&o.gather.Since, "since", gather.DefaultSince, "Only collect the events and logs more recent than this")
+	cmd.Flags().Int64Var(&o.gather.LogLines, "log-lines", gather.DefaultLogLines, "Number of log lines collected for each container")
+}
+
+func GatherCommand(ioStreams *cli.IOStreams) *cobra.Command {
+	opts := &gatherOptions{}
+	cmd := &cobra.Command{
+		Use:   "gather",
+		Short: "Collects the operator resources, component workloads, events and logs into a tarball, with secrets redacted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+				&clientcmd.ClientConfigLoadingRules{ExplicitPath: opts.kubeconfig, Precedence: clientcmd.NewDefaultClientConfigLoadingRules().Precedence},
+				&clientcmd.ConfigOverrides{}).ClientConfig()
+			if err != nil {
+				return err
+			}
+			kubeClient, err := kubernetes.NewForConfig(config)
+			if err != nil {
+				return err
+			}
+			operatorClient, err := versioned.NewForConfig(config)
+			if err != nil {
+				return err
+			}
+			return runGather(cmd.Context(), ioStreams, gather.New(kubeClient, operatorClient, opts.gather), opts.output)
+		},
+	}
+	opts.addFlags(cmd)
+	return cmd
+}
+
+func runGather(ctx context.Context, ioStreams *cli.IOStreams, g *gather.Gatherer, output string) error {
+	if output == "-" {
+		return g.Gather(ctx, ioStreams.Out)
+	}
+	if output == "" {
+		output = fmt.Sprintf("must-gather-%s.tar.gz", time.Now().Format("20060102-150405"))
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	if err := g.Gather(ctx, f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(ioStreams.Out, "Wrote %s\n", output)
+	return nil
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-pipelines/pipelines-as-code/pkg/cli"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+type migratePrunerOptions struct {
+	kubeconfig string
+	apply      bool
+	force      bool
+}
+
+func (o *migratePrunerOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file (default $KUBECONFIG or ~/.kube/config)")
+	cmd.Flags().BoolVar(&o.apply, "apply", false, "Update the TektonConfig, disabling the job-based pruner and enabling TektonPruner with the migrated config")
+	cmd.Flags().BoolVar(&o.force, "force", false, "Apply the migrated config even if some settings could not be mapped or change which runs are pruned")
+}
+
+func MigratePrunerCommand(ioStreams *cli.IOStreams) *cobra.Command {
+	opts := &migratePrunerOptions{}
+	cmd := &cobra.Command{
+		Use:   "migrate-pruner",
+		Short: "Translates the job-based pruner spec and the prune namespace annotations into a TektonPruner config",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+				&clientcmd.ClientConfigLoadingRules{ExplicitPath: opts.kubeconfig, Precedence: clientcmd.NewDefaultClientConfigLoadingRules().Precedence},
+				&clientcmd.ConfigOverrides{}).ClientConfig()
+			if err != nil {
+				return err
+			}
+			kubeClient, err := kubernetes.NewForConfig(config)
+			if err != nil {
+				return err
+			}
+			operatorClient, err := versioned.NewForConfig(config)
+			if err != nil {
+				return err
+			}
+			// the invalid annotations are reported as notes
+			ctx := logging.WithLogger(cmd.Context(), zap.NewNop().Sugar())
+			return runMigratePruner(ctx, ioStreams, kubeClient, operatorClient, opts.apply, opts.force)
+		},
+	}
+	opts.addFlags(cmd)
+	return cmd
+}
+
+func runMigratePruner(ctx context.Context, ioStreams *cli.IOStreams, kubeClient kubernetes.Interface, operatorClient versioned.Interface, apply, force bool) error {
+	tc, err := operatorClient.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	migration, err := common.MigratePruneConfig(ctx, kubeClient, tc)
+	if err != nil {
+		return err
+	}
+	for _, note := range migration.Notes {
+		fmt.Fprintf(ioStreams.ErrOut, "note: %s\n", note)
+	}
+	for _, warning := range migration.Warnings {
+		fmt.Fprintf(ioStreams.ErrOut, "warning: %s\n", warning)
+	}
+
+	if apply {
+		// e.g. skipped namespaces would be pruned with the global config,
+		// the notes such as the schedules do not change which runs are kept
+		if len(migration.Warnings) > 0 && !force {
+			return fmt.Errorf("%d settings can not be migrated as they are, review the warnings and rerun with --force to apply anyway", len(migration.Warnings))
+		}
+		tc.Spec.Pruner.Disabled = true
+		tc.Spec.TektonPruner.Disabled = ptr.Bool(false)
+		tc.Spec.TektonPruner.GlobalConfig = migration.GlobalConfig
+		if _, err := operatorClient.OperatorV1alpha1().TektonConfigs().Update(ctx, tc, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		fmt.Fprintf(ioStreams.Out, "Updated TektonConfig %s\n", tc.GetName())
+		return nil
+	}
+
+	out, err := yaml.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"pruner": map[string]interface{}{"disabled": true},
+			"tektonpruner": map[string]interface{}{
+				"disabled":      false,
+				"global-config": migration.GlobalConfig,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = ioStreams.Out.Write(out)
+	return err
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/openshift-pipelines/pipelines-as-code/pkg/cli"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/operator/pkg/reconciler/render"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+)
+
+type renderOptions struct {
+	platform string
+	kodata   string
+}
+
+func (o *renderOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.platform, "platform", render.PlatformKubernetes, "Platform the operator runs on, kubernetes or openshift")
+	cmd.Flags().StringVar(&o.kodata, "kodata", "", "Directory holding the component payloads (default cmd/<platform>/operator/kodata)")
+}
+
+func (o *renderOptions) options() render.Options {
+	kodata := o.kodata
+	if kodata == "" {
+		kodata = filepath.Join("cmd", o.platform, "operator", "kodata")
+	}
+	return render.Options{Platform: o.platform, KoDataPath: kodata}
+}
+
+func RenderCommand(ioStreams *cli.IOStreams) *cobra.Command {
+	opts := &renderOptions{}
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Prints the manifests the operator applies for a TektonConfig or component custom resource",
+		Long: `Prints the manifests the operator applies for a TektonConfig, TektonPipeline,
+TektonTrigger, TektonChain, TektonResult or TektonDashboard custom resource.
+
+A TektonConfig is rendered as the TektonPipeline, TektonTrigger, TektonChain,
+TektonResult and, on kubernetes, TektonDashboard its profile enables. The
+pruners and the scheduler are not rendered: neither the CronJobs of the
+job-based pruner, which depend on the annotations of the namespaces of the
+cluster, nor TektonPruner and TektonScheduler. The other components the
+TektonConfig enables on openshift are not rendered either.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("Need one and only one argument, the custom resource file")
+			}
+			manifest, err := renderFile(args[0], opts.options())
+			if err != nil {
+				return err
+			}
+			return render.WriteYAML(ioStreams.Out, manifest)
+		},
+	}
+	opts.addFlags(cmd)
+	return cmd
+}
+
+func renderFile(filename string, opts render.Options) (mf.Manifest, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	return renderData(data, opts)
+}
+
+func renderData(data []byte, opts render.Options) (mf.Manifest, error) {
+	// the reconcilers log every transformation, keep the output clean
+	ctx := logging.WithLogger(context.Background(), zap.NewNop().Sugar())
+	comp, err := render.Decode(ctx, data)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	return render.Render(ctx, comp, opts)
+}
@@ -24,6 +24,10 @@ func main() {
 	cmd.AddCommand(commands.BumpCommand(ioStreams))
 	cmd.AddCommand(commands.CheckCommand(ioStreams))
 	cmd.AddCommand(commands.ComponentVersionCommand(ioStreams))
+	cmd.AddCommand(commands.RenderCommand(ioStreams))
+	cmd.AddCommand(commands.DiffCommand(ioStreams))
+	cmd.AddCommand(commands.GatherCommand(ioStreams))
+	cmd.AddCommand(commands.MigratePrunerCommand(ioStreams))
 
 	if err := cmd.Execute(); err != nil {
 		os.Exit(1)
@@ -0,0 +1,55 @@
+#!/usr/bin/env bash
+
+# Copyright 2026 The Tekton Authors
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# This script adds the webhook conversion to the generated CRDs which serve
+# operator.tekton.dev/v1beta1. controller-gen does not generate it, and without
+# it the API server serves v1beta1 objects without converting them.
+#
+# The conversion is served by the operator webhook on /resource-conversion,
+# which injects its CA bundle in the CRDs when it starts. The namespace of the
+# service is set by kustomize, and by hack/sync-helm-crds.sh for the Helm chart.
+#
+# Prerequisites: Run `make generate-crds` first, which calls this script.
+
+set -euo pipefail
+
+REPO_ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+GENERATED_DIR="${REPO_ROOT}/config/base/generated-crds"
+
+for file in "${GENERATED_DIR}"/*.yaml; do
+  if ! grep -qE '^    name: v1beta1$' "$file" || grep -qE '^  conversion:$' "$file"; then
+    continue
+  fi
+  tmpfile=$(mktemp)
+  awk '
+    { print }
+    /^spec:$/ && !done {
+      print "  conversion:"
+      print "    strategy: Webhook"
+      print "    webhook:"
+      print "      clientConfig:"
+      print "        service:"
+      print "          name: tekton-operator-webhook"
+      print "          namespace: tekton-operator"
+      print "          path: /resource-conversion"
+      print "      conversionReviewVersions:"
+      print "      - v1"
+      done = 1
+    }
+  ' "$file" > "$tmpfile"
+  mv "$tmpfile" "$file"
+  echo "  Added conversion: $(basename "$file")"
+done
@@ -62,6 +62,13 @@ strip_int_formats() {
   grep -v '^\s*format: int\(32\|64\)$'
 }
 
+# template_conversion points the webhook conversion added by
+# hack/add-crd-conversion.sh to the webhook service of the release
+template_conversion() {
+  sed -e 's/^          name: tekton-operator-webhook$/          name: {{ include "tekton-operator.fullname" . }}-webhook/' \
+    -e 's/^          namespace: tekton-operator$/          namespace: {{ .Release.Namespace }}/'
+}
+
 # assemble_helm_crds assembles a Helm chart CRD file from multiple generated CRDs
 assemble_helm_crds() {
   local output_file="$1"
@@ -72,7 +79,7 @@ assemble_helm_crds() {
   echo "${condition}" > "$output_file"
   for crd_file in "${crd_files[@]}"; do
     echo "---" >> "$output_file"
-    inject_labels "${GENERATED_DIR}/${crd_file}" | strip_leading_separator | strip_int_formats >> "$output_file"
+    inject_labels "${GENERATED_DIR}/${crd_file}" | strip_leading_separator | strip_int_formats | template_conversion >> "$output_file"
   done
   echo '{{- end -}}' >> "$output_file"
 
@@ -39,7 +39,8 @@ bash ${REPO_ROOT_DIR}/hack/generate-groups.sh "deepcopy,client,informer,lister"
 ${PREFIX}/deepcopy-gen \
   --output-file zz_generated.deepcopy.go \
   --go-header-file ${REPO_ROOT_DIR}/hack/boilerplate/boilerplate.go.txt \
-  github.com/tektoncd/operator/pkg/apis/operator/v1alpha1
+  github.com/tektoncd/operator/pkg/apis/operator/v1alpha1 \
+  github.com/tektoncd/operator/pkg/apis/operator/v1beta1
 
 # Knative Injection
 # This generates the knative inject packages for the operator package (v1alpha1).
@@ -39,4 +39,25 @@ type AdditionalOptions struct {
 	// +kubebuilder:validation:Schemaless
 	StatefulSets                map[string]appsv1.StatefulSet          `json:"statefulSets,omitempty"`
 	WebhookConfigurationOptions map[string]WebhookConfigurationOptions `json:"webhookConfigurationOptions,omitempty"`
+	// IgnoreDifferences lists fields of the resources which are not reconciled,
+	// the values set on the cluster (for example by an HPA, a VPA or a service
+	// mesh injector) are kept
+	// +optional
+	IgnoreDifferences []IgnoreDifference `json:"ignoreDifferences,omitempty"`
+}
+
+// IgnoreDifference selects fields of resources which are not reconciled
+type IgnoreDifference struct {
+	// Group of the resources, empty for the core group
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind of the resources
+	Kind string `json:"kind"`
+	// Name of the resources, shell patterns are supported.
+	// All the resources of the kind are selected when empty
+	// +optional
+	Name string `json:"name,omitempty"`
+	// JSONPointers are the paths of the ignored fields, as defined in RFC 6901
+	// e.g. /spec/replicas
+	JSONPointers []string `json:"jsonPointers"`
 }
@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	"fmt"
+	pathpkg "path"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
@@ -38,10 +40,32 @@ func (w *WebhookConfigurationOptions) validate(path string) (errs *apis.FieldErr
 	return errs
 }
 
+func (i *IgnoreDifference) validate(path string) (errs *apis.FieldError) {
+	if i.Kind == "" {
+		errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("%s.kind", path)))
+	}
+	if _, err := pathpkg.Match(i.Name, ""); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(i.Name, fmt.Sprintf("%s.name", path)))
+	}
+	if len(i.JSONPointers) == 0 {
+		errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("%s.jsonPointers", path)))
+	}
+	for index, pointer := range i.JSONPointers {
+		if !strings.HasPrefix(pointer, "/") {
+			errs = errs.Also(apis.ErrInvalidArrayValue(pointer, fmt.Sprintf("%s.jsonPointers", path), index))
+		}
+	}
+	return errs
+}
+
 func (op *AdditionalOptions) validate(path string) (errs *apis.FieldError) {
+	errs = errs.Also(op.validateCredentials(path))
+	for index, ignoreDifference := range op.IgnoreDifferences {
+		errs = errs.Also(ignoreDifference.validate(fmt.Sprintf("%s.ignoreDifferences[%d]", path, index)))
+	}
 	if op.WebhookConfigurationOptions != nil {
 		for _, webhookConfig := range op.WebhookConfigurationOptions {
-			return webhookConfig.validate(path)
+			return errs.Also(webhookConfig.validate(path))
 		}
 	}
 	return errs
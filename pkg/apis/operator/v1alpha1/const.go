@@ -51,6 +51,14 @@ const (
 	DeploymentSpecHashValueLabelKey = "operator.tekton.dev/deployment-spec-applied-hash" // used to recreate pods, if there is a change detected in deployments spec
 	PreUpgradeVersionKey            = "operator.tekton.dev/pre-upgrade-version"          // used to monitor and execute pre upgrade functions
 	PostUpgradeVersionKey           = "operator.tekton.dev/post-upgrade-version"         // used to monitor and execute post upgrade functions
+	PausedKey                       = "operator.tekton.dev/paused"                       // set to "true" to pause the reconciliation of a resource and the resources it owns
+	IgnoreDifferencesKey            = "operator.tekton.dev/ignore-differences"           // JSON list of the pointers of the fields which are not reconciled
+	RolledBackFromKey               = "operator.tekton.dev/rolled-back-from"             // operator version whose failed upgrade of a component was rolled back
+	SnapshotOfKey                   = "operator.tekton.dev/snapshot-of"                  // name of the installer set kept by a snapshot installer set
+	IrreversibleUpgradeVersionKey   = "operator.tekton.dev/irreversible-upgrade-version" // operator version for which irreversible upgrade steps ran
+	IrreversibleUpgradeStepsKey     = "operator.tekton.dev/irreversible-upgrade-steps"   // irreversible upgrade steps which ran, rollback is refused after them
+	VerifiedVersionKey              = "operator.tekton.dev/verified-version"             // operator version of the last completed post-install verification
+	VerificationKey                 = "operator.tekton.dev/verification"                 // marks the resources created by the post-install verification
 
 	UpgradePending = "upgrade pending"
 	Reinstalling   = "reinstalling"
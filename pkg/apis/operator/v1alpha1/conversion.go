@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+// The kinds below are also served as v1beta1. v1beta1 is the conversion hub
+// and implements the conversions, v1alpha1 only needs to be Convertible
+
+// ConvertTo implements apis.Convertible
+func (tc *TektonConfig) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (tc *TektonConfig) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (tp *TektonPipeline) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (tp *TektonPipeline) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (tr *TektonTrigger) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (tr *TektonTrigger) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (tc *TektonChain) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (tc *TektonChain) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (tr *TektonResult) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (tr *TektonResult) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (td *TektonDashboard) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (td *TektonDashboard) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (ta *TektonAddon) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (ta *TektonAddon) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (tp *TektonPruner) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (tp *TektonPruner) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (ts *TektonScheduler) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (ts *TektonScheduler) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (t *TektonMulticlusterProxyAAE) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (t *TektonMulticlusterProxyAAE) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (mag *ManualApprovalGate) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (mag *ManualApprovalGate) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (pac *OpenShiftPipelinesAsCode) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (pac *OpenShiftPipelinesAsCode) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
+
+// ConvertTo implements apis.Convertible
+func (ss *SyncerService) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible
+func (ss *SyncerService) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+var (
+	// credentialName matches the names of the env variables and of the
+	// ConfigMap keys which carry a credential
+	credentialName = regexp.MustCompile(`(?i)(password|passwd|secret|token|api[_-]?key|private[_-]?key|credential|mongo[_-]?server[_-]?url)`)
+	// credentialReference matches the names which only locate a credential,
+	// e.g. signers.kms.auth.token-path or SECRET_NAME
+	credentialReference = regexp.MustCompile(`(?i)[_.-](path|file|dir|name|address|audience|role)$`)
+)
+
+// isInlineCredential tells whether the value of the env variable or of the
+// ConfigMap key is a credential, flags such as secret-auto-create are not
+func isInlineCredential(name, value string) bool {
+	if value == "" || !credentialName.MatchString(name) || credentialReference.MatchString(name) {
+		return false
+	}
+	_, err := strconv.ParseBool(value)
+	return err != nil
+}
+
+// validateCredentials warns about the credentials set inline in the env of
+// the containers and in the data of the ConfigMaps, they are stored in clear
+// text in the CR and rendered as they are in the manifests
+func (op *AdditionalOptions) validateCredentials(path string) (errs *apis.FieldError) {
+	for _, name := range sortedKeys(op.ConfigMaps) {
+		cm := op.ConfigMaps[name]
+		for _, key := range sortedKeys(cm.Data) {
+			if isInlineCredential(key, cm.Data[key]) {
+				errs = errs.Also(apis.ErrGeneric("credentials set inline are stored in clear text, mount them from a Secret instead",
+					fmt.Sprintf("%s.configMaps.%s.data.%s", path, name, key)).At(apis.WarningLevel))
+			}
+		}
+	}
+	for _, name := range sortedKeys(op.Deployments) {
+		errs = errs.Also(validatePodSpecCredentials(op.Deployments[name].Spec.Template.Spec, fmt.Sprintf("%s.deployments.%s.spec.template.spec", path, name)))
+	}
+	for _, name := range sortedKeys(op.StatefulSets) {
+		errs = errs.Also(validatePodSpecCredentials(op.StatefulSets[name].Spec.Template.Spec, fmt.Sprintf("%s.statefulSets.%s.spec.template.spec", path, name)))
+	}
+	return errs
+}
+
+func validatePodSpecCredentials(spec corev1.PodSpec, path string) (errs *apis.FieldError) {
+	for i, c := range spec.InitContainers {
+		errs = errs.Also(validateEnvCredentials(c.Env, fmt.Sprintf("%s.initContainers[%d].env", path, i)))
+	}
+	for i, c := range spec.Containers {
+		errs = errs.Also(validateEnvCredentials(c.Env, fmt.Sprintf("%s.containers[%d].env", path, i)))
+	}
+	return errs
+}
+
+// validateEnvCredentials warns about the env variables carrying a credential
+// as a literal value rather than from a Secret
+func validateEnvCredentials(envs []corev1.EnvVar, path string) (errs *apis.FieldError) {
+	for i, env := range envs {
+		if isInlineCredential(env.Name, env.Value) {
+			errs = errs.Also(inlineCredentialWarning(fmt.Sprintf("%s[%d].value", path, i), fmt.Sprintf("%s[%d].valueFrom.secretKeyRef", path, i)))
+		}
+	}
+	return errs
+}
+
+func validateSecretKeySelector(ref *corev1.SecretKeySelector, path string) (errs *apis.FieldError) {
+	if ref.Name == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".name"))
+	}
+	if ref.Key == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".key"))
+	}
+	return errs
+}
+
+func inlineCredentialWarning(path, secretPath string) *apis.FieldError {
+	return apis.ErrGeneric(fmt.Sprintf("credentials set inline are stored in clear text, reference a Secret with %s instead", secretPath), path).
+		At(apis.WarningLevel)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
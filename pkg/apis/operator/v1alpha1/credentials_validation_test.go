@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestAdditionalOptionsValidateCredentials(t *testing.T) {
+	podSpec := func(env ...corev1.EnvVar) corev1.PodTemplateSpec {
+		return corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "controller", Env: env}}}}
+	}
+	tests := []struct {
+		name     string
+		options  AdditionalOptions
+		warnings []string
+	}{
+		{
+			name: "no credentials",
+			options: AdditionalOptions{
+				ConfigMaps: map[string]corev1.ConfigMap{
+					"chains-config":     {Data: map[string]string{"signers.kms.auth.token-path": "/etc/token", "artifacts.taskrun.format": "in-toto"}},
+					"pipelines-as-code": {Data: map[string]string{"secret-auto-create": "true"}},
+				},
+				Deployments: map[string]appsv1.Deployment{
+					"tekton-pipelines-controller": {Spec: appsv1.DeploymentSpec{Template: podSpec(
+						corev1.EnvVar{Name: "API_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "token"}}},
+						corev1.EnvVar{Name: "TOKEN_PATH", Value: "/etc/token"},
+					)}},
+				},
+			},
+		},
+		{
+			name: "inline credentials",
+			options: AdditionalOptions{
+				ConfigMaps: map[string]corev1.ConfigMap{
+					"chains-config": {Data: map[string]string{"signers.kms.auth.token": "s.token"}},
+				},
+				Deployments: map[string]appsv1.Deployment{
+					"tekton-triggers-controller": {Spec: appsv1.DeploymentSpec{Template: podSpec(corev1.EnvVar{Name: "GITHUB_TOKEN", Value: "ghp_token"})}},
+				},
+				StatefulSets: map[string]appsv1.StatefulSet{
+					"tekton-results-postgres": {Spec: appsv1.StatefulSetSpec{Template: podSpec(corev1.EnvVar{Name: "POSTGRES_PASSWORD", Value: "hunter2"})}},
+				},
+			},
+			warnings: []string{
+				"spec.options.configMaps.chains-config.data.signers.kms.auth.token",
+				"spec.options.deployments.tekton-triggers-controller.spec.template.spec.containers[0].env[0].value",
+				"spec.options.statefulSets.tekton-results-postgres.spec.template.spec.containers[0].env[0].value",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.options.validateCredentials("spec.options")
+			assert.Assert(t, err.Filter(apis.ErrorLevel) == nil, err)
+			if len(test.warnings) == 0 {
+				assert.Assert(t, err == nil, err)
+			}
+			for _, warning := range test.warnings {
+				assert.ErrorContains(t, err.Filter(apis.WarningLevel), warning)
+			}
+		})
+	}
+}
+
+func TestTektonTriggerValidateInlineCredentials(t *testing.T) {
+	tr := &TektonTrigger{
+		ObjectMeta: metav1.ObjectMeta{Name: TriggerResourceName},
+		Spec: TektonTriggerSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Trigger: Trigger{Options: AdditionalOptions{Deployments: map[string]appsv1.Deployment{
+				"tekton-triggers-core-interceptors": {Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "interceptors", Env: []corev1.EnvVar{{Name: "WEBHOOK_SECRET", Value: "s3cr3t"}}}},
+				}}}},
+			}}},
+		},
+	}
+	err := tr.Validate(context.TODO())
+	assert.Assert(t, err.Filter(apis.ErrorLevel) == nil, err)
+	assert.ErrorContains(t, err.Filter(apis.WarningLevel), "reference a Secret with spec.options.deployments.tekton-triggers-core-interceptors.spec.template.spec.containers[0].env[0].valueFrom.secretKeyRef instead")
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// IngressTypeIngress exposes a component with a networking.k8s.io Ingress
+	IngressTypeIngress = "Ingress"
+	// IngressTypeHTTPRoute exposes a component with a Gateway API HTTPRoute
+	IngressTypeHTTPRoute = "HTTPRoute"
+	// IngressTypeGRPCRoute exposes a gRPC component with a Gateway API GRPCRoute
+	IngressTypeGRPCRoute = "GRPCRoute"
+)
+
+// IngressConfig exposes a component outside of the cluster on Kubernetes,
+// with an Ingress or a Gateway API route owned by the operator.
+type IngressConfig struct {
+	// Enabled creates the Ingress or the route of the component.
+	// Removing it deletes the resource on the next reconcile.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Type of the resource, Ingress (default) or HTTPRoute
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Host the component is served on
+	// +optional
+	Host string `json:"host,omitempty"`
+	// Path the component is served on, defaults to /
+	// +optional
+	Path string `json:"path,omitempty"`
+	// ClassName is the ingressClassName of the Ingress
+	// +optional
+	ClassName string `json:"className,omitempty"`
+	// Gateway the route is attached to, required for the Gateway API routes
+	// +optional
+	Gateway *GatewayReference `json:"gateway,omitempty"`
+	// TLSSecret is the Secret in the target namespace holding the certificate
+	// of the host. It applies to the Ingress, TLS is terminated by the
+	// listener of the Gateway for the routes
+	// +optional
+	TLSSecret string `json:"tlsSecret,omitempty"`
+	// Annotations of the Ingress or the route, e.g. for the ingress
+	// controller or cert-manager
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// GatewayReference identifies the Gateway, and optionally its listener, a
+// route is attached to
+type GatewayReference struct {
+	Name string `json:"name"`
+	// Namespace of the Gateway, defaults to the target namespace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName is the name of the listener of the Gateway
+	// +optional
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// GetType returns the configured type or the default one
+func (c IngressConfig) GetType() string {
+	if c.Type == "" {
+		return IngressTypeIngress
+	}
+	return c.Type
+}
+
+// GetPath returns the configured path or the default one
+func (c IngressConfig) GetPath() string {
+	if c.Path == "" {
+		return "/"
+	}
+	return c.Path
+}
+
+func (c IngressConfig) validate(path string) (errs *apis.FieldError) {
+	if !c.Enabled {
+		return nil
+	}
+	types := []string{IngressTypeIngress, IngressTypeHTTPRoute}
+	if !isIngressType(c.GetType(), types) {
+		errs = errs.Also(apis.ErrInvalidValue(c.Type, path+".type", "must be one of "+strings.Join(types, ", ")))
+	}
+	if c.Host != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(strings.TrimPrefix(c.Host, "*.")) {
+			errs = errs.Also(apis.ErrInvalidValue(c.Host, path+".host", msg))
+		}
+	}
+	if !strings.HasPrefix(c.GetPath(), "/") {
+		errs = errs.Also(apis.ErrInvalidValue(c.Path, path+".path", "must be an absolute path"))
+	}
+	if c.GetType() == IngressTypeIngress {
+		if c.Gateway != nil {
+			errs = errs.Also(apis.ErrDisallowedFields(path + ".gateway"))
+		}
+		if c.TLSSecret != "" && c.Host == "" {
+			errs = errs.Also(apis.ErrMissingField(path + ".host"))
+		}
+		return errs
+	}
+	if c.Gateway == nil || c.Gateway.Name == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".gateway.name"))
+	}
+	if c.ClassName != "" {
+		errs = errs.Also(apis.ErrDisallowedFields(path + ".className"))
+	}
+	if c.TLSSecret != "" {
+		errs = errs.Also(apis.ErrDisallowedFields(path + ".tlsSecret"))
+	}
+	return errs
+}
+
+func isIngressType(t string, types []string) bool {
+	for _, it := range types {
+		if t == it {
+			return true
+		}
+	}
+	return false
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 
@@ -47,3 +48,30 @@ func (c NetworkPolicyConfig) validate(path string) (errs *apis.FieldError) {
 	}
 	return errs
 }
+
+// PipelineNetworkPolicy configures the NetworkPolicies generated in the
+// namespaces where PipelineRuns execute. They apply to the TaskRun pods, which
+// may only reach DNS, the API server, the Tekton webhook and resolvers, the
+// Results API and the configured egress targets.
+type PipelineNetworkPolicy struct {
+	// NamespaceSelector selects the namespaces in which the operator generates
+	// and owns the policies. Nil disables them, and the generated policies
+	// are removed on the next reconcile.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Egress lists the additional egress targets of the TaskRun pods, such as
+	// git servers or registries.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Egress []networkingv1.NetworkPolicyEgressRule `json:"egress,omitempty"`
+}
+
+func (p PipelineNetworkPolicy) validate(path string) (errs *apis.FieldError) {
+	if p.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(p.NamespaceSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), path+".namespaceSelector"))
+		}
+	}
+	return errs
+}
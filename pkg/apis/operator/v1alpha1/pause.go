@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// Paused is a Condition indicating that the reconciliation of the resource
+	// is paused. It is informational and does not affect the readiness
+	Paused apis.ConditionType = "Paused"
+
+	// PausedReason is the reason of the Paused condition
+	PausedReason = "ReconciliationPaused"
+)
+
+// pauseCondSet manages the Paused condition, which is not part of the
+// dependents of any of the condition sets
+var pauseCondSet = apis.NewLivingConditionSet()
+
+// IsPaused returns true if the reconciliation of the object is paused
+// through the PausedKey annotation
+func IsPaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[PausedKey] == "true"
+}
+
+// MarkPaused sets the Paused condition
+func MarkPaused(s apis.ConditionsAccessor, msg string) {
+	pauseCondSet.Manage(s).SetCondition(apis.Condition{
+		Type:     Paused,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   PausedReason,
+		Message:  msg,
+	})
+}
+
+// MarkResumed removes the Paused condition and returns true if it was set
+func MarkResumed(s apis.ConditionsAccessor) bool {
+	if pauseCondSet.Manage(s).GetCondition(Paused) == nil {
+		return false
+	}
+	_ = pauseCondSet.Manage(s).ClearCondition(Paused)
+	return true
+}
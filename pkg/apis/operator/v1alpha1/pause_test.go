@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apistest "knative.dev/pkg/apis/testing"
+)
+
+func TestIsPaused(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "no annotations", want: false},
+		{name: "paused", annotations: map[string]string{PausedKey: "true"}, want: true},
+		{name: "not paused", annotations: map[string]string{PausedKey: "false"}, want: false},
+		{name: "invalid value", annotations: map[string]string{PausedKey: "yes"}, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tp := &TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: PipelineResourceName, Annotations: test.annotations}}
+			if got := IsPaused(tp); got != test.want {
+				t.Errorf("IsPaused() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMarkPausedAndResumed(t *testing.T) {
+	tp := &TektonPipelineStatus{}
+	tp.InitializeConditions()
+	tp.MarkPreReconcilerComplete()
+	tp.MarkInstallerSetAvailable()
+	tp.MarkInstallerSetReady()
+	tp.MarkPostReconcilerComplete()
+
+	if resumed := MarkResumed(tp); resumed {
+		t.Errorf("MarkResumed() = true, want false when not paused")
+	}
+
+	MarkPaused(tp, "paused for maintenance")
+	apistest.CheckConditionSucceeded(tp, Paused, t)
+	if cond := tp.GetCondition(Paused); cond.Reason != PausedReason || cond.Message != "paused for maintenance" {
+		t.Errorf("unexpected Paused condition: %v", cond)
+	}
+	// pausing must not change the readiness
+	if ready := tp.IsReady(); !ready {
+		t.Errorf("tp.IsReady() = %v, want true", ready)
+	}
+
+	if resumed := MarkResumed(tp); !resumed {
+		t.Errorf("MarkResumed() = false, want true when paused")
+	}
+	if cond := tp.GetCondition(Paused); cond != nil {
+		t.Errorf("Paused condition not removed: %v", cond)
+	}
+	if ready := tp.IsReady(); !ready {
+		t.Errorf("tp.IsReady() = %v, want true", ready)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// ResultsGuarded is a Condition indicating whether the pruners keep the
+	// runs not yet stored in Tekton Results. It is informational and does
+	// not affect the readiness
+	ResultsGuarded apis.ConditionType = "ResultsGuarded"
+
+	// ResultsGuardDisabledReason is the reason of the ResultsGuarded condition
+	// when the guard is disabled while TektonResult is enabled
+	ResultsGuardDisabledReason = "ResultsGuardDisabled"
+
+	// ResultsStoredAnnotation is set by the Results watcher on the runs it
+	// stored
+	ResultsStoredAnnotation = "results.tekton.dev/stored"
+
+	// DefaultResultsGuardGracePeriod is the time after the completion of a run
+	// after which it is pruned, even if it was not stored
+	DefaultResultsGuardGracePeriod = 24 * time.Hour
+)
+
+// resultsGuardCondSet manages the ResultsGuarded condition, which is not part
+// of the dependents of the TektonConfig condition set
+var resultsGuardCondSet = apis.NewLivingConditionSet()
+
+// ResultsGuard keeps the pruners from deleting the runs which were not
+// stored in Tekton Results yet, it applies when TektonResult is enabled
+type ResultsGuard struct {
+	// Disabled lets the pruners delete the runs not yet stored in Tekton Results
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+	// GracePeriod after the completion of a run after which it is pruned even
+	// if it was not stored, defaults to 24h
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// GetGracePeriod returns the configured grace period or the default one
+func (g ResultsGuard) GetGracePeriod() time.Duration {
+	if g.GracePeriod == nil {
+		return DefaultResultsGuardGracePeriod
+	}
+	return g.GracePeriod.Duration
+}
+
+func (g ResultsGuard) validate(path string) (errs *apis.FieldError) {
+	if g.GracePeriod != nil && g.GracePeriod.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(g.GracePeriod.Duration.String(), path+".gracePeriod"))
+	}
+	return errs
+}
+
+// IsResultEnabled returns true when the profile installs TektonResult and it
+// is not disabled
+func (s *TektonConfigSpec) IsResultEnabled() bool {
+	return !s.Result.Disabled && (s.Profile == ProfileAll || s.Profile == ProfileBasic)
+}
+
+// ResultsGuardActive returns true when the pruners keep the runs not yet
+// stored in Tekton Results
+func (s *TektonConfigSpec) ResultsGuardActive() bool {
+	return s.IsResultEnabled() && !s.ResultsGuard.Disabled
+}
+
+// MarkResultsGuardDisabled sets the ResultsGuarded condition to false as a
+// warning, the pruners may delete runs before they are stored
+func (tcs *TektonConfigStatus) MarkResultsGuardDisabled(msg string) {
+	resultsGuardCondSet.Manage(tcs).SetCondition(apis.Condition{
+		Type:     ResultsGuarded,
+		Status:   corev1.ConditionFalse,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   ResultsGuardDisabledReason,
+		Message:  msg,
+	})
+}
+
+// MarkResultsGuardActive sets the ResultsGuarded condition to true
+func (tcs *TektonConfigStatus) MarkResultsGuardActive() {
+	resultsGuardCondSet.Manage(tcs).SetCondition(apis.Condition{
+		Type:     ResultsGuarded,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+	})
+}
+
+// ClearResultsGuard removes the ResultsGuarded condition, when TektonResult is
+// not enabled
+func (tcs *TektonConfigStatus) ClearResultsGuard() {
+	_ = resultsGuardCondSet.Manage(tcs).ClearCondition(ResultsGuarded)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// RolledBack is a Condition indicating that components were restored to
+	// the installer sets of the previous release after a failed upgrade.
+	// It is informational and does not affect the readiness
+	RolledBack apis.ConditionType = "RolledBack"
+
+	// UpgradeRolledBackReason is the reason of the RolledBack condition when
+	// the previous installer sets were restored
+	UpgradeRolledBackReason = "UpgradeRolledBack"
+	// RollbackRefusedReason is the reason of the RolledBack condition when a
+	// failed upgrade can't be rolled back safely
+	RollbackRefusedReason = "RollbackRefused"
+
+	// DefaultRollbackDeadline is the time given to upgraded components to
+	// become ready before they are rolled back
+	DefaultRollbackDeadline = 10 * time.Minute
+)
+
+// rollbackCondSet manages the RolledBack condition, which is not part of the
+// dependents of the TektonConfig condition set
+var rollbackCondSet = apis.NewLivingConditionSet()
+
+// Rollback configures the automatic rollback of components which don't
+// become ready after an upgrade
+type Rollback struct {
+	// Disabled turns off the automatic rollback, failed upgrades are left as they are
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+	// Deadline within which the installer sets of an upgraded component must
+	// become ready, defaults to 10m
+	// +optional
+	Deadline *metav1.Duration `json:"deadline,omitempty"`
+}
+
+// GetDeadline returns the configured deadline or the default one
+func (r Rollback) GetDeadline() time.Duration {
+	if r.Deadline == nil {
+		return DefaultRollbackDeadline
+	}
+	return r.Deadline.Duration
+}
+
+func (r Rollback) validate(path string) (errs *apis.FieldError) {
+	if r.Deadline != nil && r.Deadline.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(r.Deadline.Duration.String(), path+".deadline"))
+	}
+	return errs
+}
+
+// MarkRolledBack sets the RolledBack condition after components were restored
+func (tcs *TektonConfigStatus) MarkRolledBack(msg string) {
+	rollbackCondSet.Manage(tcs).SetCondition(apis.Condition{
+		Type:     RolledBack,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   UpgradeRolledBackReason,
+		Message:  msg,
+	})
+}
+
+// MarkRollbackRefused sets the RolledBack condition to false when a failed
+// upgrade is not rolled back because it would be unsafe
+func (tcs *TektonConfigStatus) MarkRollbackRefused(msg string) {
+	rollbackCondSet.Manage(tcs).SetCondition(apis.Condition{
+		Type:     RolledBack,
+		Status:   corev1.ConditionFalse,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   RollbackRefusedReason,
+		Message:  msg,
+	})
+}
+
+// ClearRolledBack removes the RolledBack condition and returns true if it was set
+func (tcs *TektonConfigStatus) ClearRolledBack() bool {
+	if rollbackCondSet.Manage(tcs).GetCondition(RolledBack) == nil {
+		return false
+	}
+	_ = rollbackCondSet.Manage(tcs).ClearCondition(RolledBack)
+	return true
+}
+
+// GetIrreversibleUpgrades returns the operator version for which irreversible
+// upgrade steps were executed and the names of those steps
+func (tcs *TektonConfigStatus) GetIrreversibleUpgrades() (string, []string) {
+	version := tcs.Annotations[IrreversibleUpgradeVersionKey]
+	steps := tcs.Annotations[IrreversibleUpgradeStepsKey]
+	if version == "" || steps == "" {
+		return version, nil
+	}
+	return version, strings.Split(steps, ",")
+}
+
+// AddIrreversibleUpgrades records irreversible upgrade steps executed for
+// the operator version, the steps recorded for another version are dropped
+func (tcs *TektonConfigStatus) AddIrreversibleUpgrades(version string, steps ...string) {
+	if len(steps) == 0 {
+		return
+	}
+	recordedVersion, recorded := tcs.GetIrreversibleUpgrades()
+	if recordedVersion != version {
+		recorded = nil
+	}
+	for _, step := range steps {
+		if !isValueInArray(recorded, step) {
+			recorded = append(recorded, step)
+		}
+	}
+	if tcs.Annotations == nil {
+		tcs.Annotations = map[string]string{}
+	}
+	tcs.Annotations[IrreversibleUpgradeVersionKey] = version
+	tcs.Annotations[IrreversibleUpgradeStepsKey] = strings.Join(recorded, ",")
+}
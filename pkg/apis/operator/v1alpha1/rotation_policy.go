@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// RotateAnnotation requests the rotation of the material generated by
+	// the operator for a component, every new value triggers one rotation
+	RotateAnnotation = "operator.tekton.dev/rotate"
+
+	// minimumRotationValidity keeps the material from being rotated on
+	// every reconciliation
+	minimumRotationValidity = time.Hour
+)
+
+// RotationPolicy configures the rotation of material generated by the
+// operator, like certificates, passwords and signing keys
+type RotationPolicy struct {
+	// Validity is how long the material is used. The material is rotated
+	// automatically only when it is set, certificates default to one year
+	// +optional
+	Validity *metav1.Duration `json:"validity,omitempty"`
+	// RenewBefore is how long before the end of the validity the material
+	// is rotated, a third of the validity by default
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// GetRenewBefore returns how long before the end of the validity the
+// material is rotated, the default applies when renewBefore does not fit in
+// the validity
+func (p RotationPolicy) GetRenewBefore(validity time.Duration) time.Duration {
+	if p.RenewBefore != nil && p.RenewBefore.Duration < validity {
+		return p.RenewBefore.Duration
+	}
+	return validity / 3
+}
+
+// NextRotation returns when material issued at issuedAt is rotated, zero
+// when the policy has no validity
+func (p RotationPolicy) NextRotation(issuedAt time.Time) time.Time {
+	if p.Validity == nil {
+		return time.Time{}
+	}
+	return issuedAt.Add(p.Validity.Duration - p.GetRenewBefore(p.Validity.Duration))
+}
+
+func (p RotationPolicy) validate(path string) (errs *apis.FieldError) {
+	if p.Validity != nil && p.Validity.Duration < minimumRotationValidity {
+		errs = errs.Also(apis.ErrInvalidValue(p.Validity.Duration.String(), path+".validity", "must be at least "+minimumRotationValidity.String()))
+	}
+	if p.RenewBefore != nil {
+		if p.RenewBefore.Duration <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(p.RenewBefore.Duration.String(), path+".renewBefore", "must be positive"))
+		} else if p.Validity != nil && p.RenewBefore.Duration >= p.Validity.Duration {
+			errs = errs.Also(apis.ErrInvalidValue(p.RenewBefore.Duration.String(), path+".renewBefore", "must be less than the validity"))
+		}
+	}
+	return errs
+}
+
+// RotationStatus reports the rotation of material generated by the operator
+type RotationStatus struct {
+	// Name of the Secret holding the material
+	Name string `json:"name"`
+	// LastRotationTime is when the material was last issued
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// NextRotationTime is when the material is rotated next, unset when it
+	// is only rotated on demand
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+}
+
+// SetRotation records the rotation status of the material, replacing the
+// previous status of the same Secret
+func SetRotation(statuses []RotationStatus, status RotationStatus) []RotationStatus {
+	for i := range statuses {
+		if statuses[i].Name == status.Name {
+			statuses[i] = status
+			return statuses
+		}
+	}
+	return append(statuses, status)
+}
+
+// RemoveRotation drops the rotation status of the Secret, once the operator
+// no longer manages it
+func RemoveRotation(statuses []RotationStatus, name string) []RotationStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return append(statuses[:i], statuses[i+1:]...)
+		}
+	}
+	return statuses
+}
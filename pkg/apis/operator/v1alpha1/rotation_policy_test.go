@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRotationPolicy_NextRotation(t *testing.T) {
+	issuedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.Assert(t, RotationPolicy{}.NextRotation(issuedAt).IsZero())
+
+	policy := RotationPolicy{Validity: &metav1.Duration{Duration: 90 * time.Hour}}
+	assert.Equal(t, policy.NextRotation(issuedAt), issuedAt.Add(60*time.Hour))
+
+	policy.RenewBefore = &metav1.Duration{Duration: 10 * time.Hour}
+	assert.Equal(t, policy.NextRotation(issuedAt), issuedAt.Add(80*time.Hour))
+	// renewBefore longer than the validity falls back to the default
+	assert.Equal(t, policy.GetRenewBefore(5*time.Hour), 5*time.Hour/3)
+}
+
+func TestRotationPolicy_Validate(t *testing.T) {
+	duration := func(d time.Duration) *metav1.Duration { return &metav1.Duration{Duration: d} }
+	tests := []struct {
+		name     string
+		rotation ResultsRotation
+		wantErr  string
+	}{
+		{
+			name: "on demand",
+		},
+		{
+			name: "scheduled",
+			rotation: ResultsRotation{
+				TLS:      RotationPolicy{Validity: duration(2160 * time.Hour), RenewBefore: duration(720 * time.Hour)},
+				Database: RotationPolicy{Validity: duration(720 * time.Hour)},
+			},
+		},
+		{
+			name:     "short validity",
+			rotation: ResultsRotation{TLS: RotationPolicy{Validity: duration(time.Minute)}},
+			wantErr:  "invalid value: 1m0s: spec.rotation.tls.validity",
+		},
+		{
+			name:     "negative renew before",
+			rotation: ResultsRotation{Database: RotationPolicy{RenewBefore: duration(-time.Hour)}},
+			wantErr:  "invalid value: -1h0m0s: spec.rotation.database.renewBefore",
+		},
+		{
+			name:     "renew before beyond validity",
+			rotation: ResultsRotation{Database: RotationPolicy{Validity: duration(2 * time.Hour), RenewBefore: duration(2 * time.Hour)}},
+			wantErr:  "invalid value: 2h0m0s: spec.rotation.database.renewBefore",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := &TektonResult{
+				ObjectMeta: metav1.ObjectMeta{Name: ResultResourceName},
+				Spec: TektonResultSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+					Result:     Result{Rotation: test.rotation},
+				},
+			}
+			err := tr.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestSetRotation(t *testing.T) {
+	now := metav1.Now()
+	statuses := SetRotation(nil, RotationStatus{Name: "a"})
+	statuses = SetRotation(statuses, RotationStatus{Name: "b"})
+	statuses = SetRotation(statuses, RotationStatus{Name: "a", LastRotationTime: &now})
+	assert.Equal(t, len(statuses), 2)
+	assert.Equal(t, statuses[0].LastRotationTime, &now)
+
+	statuses = RemoveRotation(statuses, "a")
+	assert.DeepEqual(t, statuses, []RotationStatus{{Name: "b"}})
+	assert.DeepEqual(t, RemoveRotation(statuses, "c"), []RotationStatus{{Name: "b"}})
+}
@@ -39,6 +39,7 @@ var (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
@@ -79,6 +80,21 @@ type Chain struct {
 	// generate signing key
 	GenerateSigningSecret bool `json:"generateSigningSecret,omitempty"`
 
+	// SigningKeyType is the type of the generated signing key, one of
+	// ecdsa-p256 (default), ecdsa-p384, ecdsa-p521, rsa-2048, rsa-3072,
+	// rsa-4096 and ed25519. Changing it rotates the signing key.
+	// +optional
+	SigningKeyType string `json:"signingKeyType,omitempty"`
+
+	// Rotation configures the rotation of the generated signing key
+	// +optional
+	Rotation ChainRotation `json:"rotation,omitempty"`
+
+	// Credentials references the Secrets holding the credentials of Chains,
+	// in place of their inline properties
+	// +optional
+	Credentials ChainCredentials `json:"credentials,omitempty"`
+
 	ChainProperties `json:",inline"`
 	ControllerEnvs  []corev1.EnvVar `json:"controllerEnvs,omitempty"`
 	// options holds additions fields and these fields will be updated on the manifests
@@ -86,6 +102,33 @@ type Chain struct {
 	Options AdditionalOptions `json:"options"`
 }
 
+// ChainRotation configures the rotation of the material generated by the
+// operator for Chains
+type ChainRotation struct {
+	// SigningKeys configures the rotation of the signing key generated when
+	// generateSigningSecret is set
+	// +optional
+	SigningKeys RotationPolicy `json:"signingKeys,omitempty"`
+	// RetiredKeysRetention is how long the public keys of the rotated
+	// signing keys stay published after their rotation, they are kept
+	// when it is unset
+	// +optional
+	RetiredKeysRetention *metav1.Duration `json:"retiredKeysRetention,omitempty"`
+}
+
+// ChainCredentials references keys of Secrets of the target namespace, they
+// are mounted in the Chains controller instead of being set in chains-config
+type ChainCredentials struct {
+	// KMSAuthToken references the token authenticating to the KMS, in place
+	// of signers.kms.auth.token
+	// +optional
+	KMSAuthToken *corev1.SecretKeySelector `json:"kmsAuthToken,omitempty"`
+	// MongoServerURL references the URL of the MongoDB server of the docdb
+	// storage, in place of storage.docdb.mongo-server-url
+	// +optional
+	MongoServerURL *corev1.SecretKeySelector `json:"mongoServerURL,omitempty"`
+}
+
 // ChainProperties defines the field to provide chain configuration
 type ChainProperties struct {
 	// taskrun artifacts config
@@ -178,6 +221,11 @@ type TektonChainStatus struct {
 	// The current installer set name for TektonChain
 	// +optional
 	TektonInstallerSet string `json:"tektonInstallerSet,omitempty"`
+
+	// Rotations reports the rotation of the signing key generated by the
+	// operator
+	// +optional
+	Rotations []RotationStatus `json:"rotations,omitempty"`
 }
 
 // TektonChainList contains a list of TektonChain
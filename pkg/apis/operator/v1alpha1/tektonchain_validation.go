@@ -35,6 +35,7 @@ var (
 	allowedControllerEnvs                           = sets.NewString("MONGO_SERVER_URL")
 	allowedBuildDefinitionType                      = sets.NewString("", "https://tekton.dev/chains/v2/slsa", "https://tekton.dev/chains/v2/slsa-tekton")
 	allowedStorageOCIEncodingFormat                 = sets.NewString("", "dsse", "sigstore-bundle")
+	allowedSigningKeyType                           = sets.NewString("", "ecdsa-p256", "ecdsa-p384", "ecdsa-p521", "rsa-2048", "rsa-3072", "rsa-4096", "ed25519")
 )
 
 func (tc *TektonChain) Validate(ctx context.Context) (errs *apis.FieldError) {
@@ -55,6 +56,9 @@ func (tc *TektonChain) Validate(ctx context.Context) (errs *apis.FieldError) {
 		tc.Spec.ValidateControllerEnv(),
 		tc.Spec.ValidateChainConfig("spec"),
 		tc.Spec.NetworkPolicy.validate("spec.networkPolicy"),
+		tc.Spec.Chain.validateSigningKeys("spec"),
+		tc.Spec.Chain.validateCredentials("spec"),
+		tc.Spec.Options.validateCredentials("spec.options"),
 	)
 }
 
@@ -157,3 +161,47 @@ func (tcs *TektonChainSpec) ValidateChainConfig(path string) (errs *apis.FieldEr
 
 	return errs
 }
+
+func (c Chain) validateSigningKeys(path string) (errs *apis.FieldError) {
+	if !allowedSigningKeyType.Has(c.SigningKeyType) {
+		errs = errs.Also(apis.ErrInvalidValue(c.SigningKeyType, path+".signingKeyType",
+			fmt.Sprintf("supported types are %s", strings.Join(allowedSigningKeyType.List()[1:], ","))))
+	}
+	errs = errs.Also(c.Rotation.SigningKeys.validate(path + ".rotation.signingKeys"))
+	if c.Rotation.RetiredKeysRetention != nil && c.Rotation.RetiredKeysRetention.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(c.Rotation.RetiredKeysRetention.Duration.String(), path+".rotation.retiredKeysRetention", "must be positive"))
+	}
+	return errs
+}
+
+// validateCredentials rejects the credentials set both inline and from a
+// Secret, and warns about the credentials set inline as they are stored in
+// clear text
+func (c Chain) validateCredentials(path string) (errs *apis.FieldError) {
+	if ref := c.Credentials.KMSAuthToken; ref != nil {
+		errs = errs.Also(validateSecretKeySelector(ref, path+".credentials.kmsAuthToken"))
+		if c.KMSAuthToken != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf(path+".credentials.kmsAuthToken", path+".signers.kms.auth.token"))
+		}
+		if c.KMSAuthTokenPath != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf(path+".credentials.kmsAuthToken", path+".signers.kms.auth.token-path"))
+		}
+	} else if c.KMSAuthToken != "" {
+		errs = errs.Also(inlineCredentialWarning(path+".signers.kms.auth.token", path+".credentials.kmsAuthToken"))
+	}
+
+	if ref := c.Credentials.MongoServerURL; ref != nil {
+		errs = errs.Also(validateSecretKeySelector(ref, path+".credentials.mongoServerURL"))
+		if c.StorageDocDBMongoServerURL != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf(path+".credentials.mongoServerURL", path+".storage.docdb.mongo-server-url"))
+		}
+		if c.StorageDocDBMongoServerURLDir != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf(path+".credentials.mongoServerURL", path+".storage.docdb.mongo-server-url-dir"))
+		}
+	} else if c.StorageDocDBMongoServerURL != "" {
+		errs = errs.Also(inlineCredentialWarning(path+".storage.docdb.mongo-server-url", path+".credentials.mongoServerURL"))
+	}
+
+	// e.g. MONGO_SERVER_URL carries the credentials of the MongoDB server
+	return errs.Also(validateEnvCredentials(c.ControllerEnvs, path+".controllerEnvs"))
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"sort"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
 )
@@ -180,3 +184,44 @@ func (tcs *TektonConfigStatus) SetPostUpgradeVersion(appliedUpgradeVersion strin
 	}
 	tcs.Annotations[PostUpgradeVersionKey] = appliedUpgradeVersion
 }
+
+// GetComponentStatus returns the status entry recorded for the given component kind, or nil
+func (tcs *TektonConfigStatus) GetComponentStatus(kind string) *ComponentStatus {
+	for i := range tcs.Components {
+		if tcs.Components[i].Kind == kind {
+			return &tcs.Components[i]
+		}
+	}
+	return nil
+}
+
+// SetComponentStatus adds or replaces the status entry of a component.
+// When no LastTransitionTime is given, it is preserved as long as the Ready status does not change
+func (tcs *TektonConfigStatus) SetComponentStatus(cs ComponentStatus) {
+	existing := tcs.GetComponentStatus(cs.Kind)
+	if cs.LastTransitionTime.Inner.IsZero() {
+		if existing != nil && existing.Ready == cs.Ready {
+			cs.LastTransitionTime = existing.LastTransitionTime
+		} else {
+			cs.LastTransitionTime = apis.VolatileTime{Inner: metav1.NewTime(time.Now())}
+		}
+	}
+	if existing != nil {
+		*existing = cs
+		return
+	}
+	tcs.Components = append(tcs.Components, cs)
+	sort.Slice(tcs.Components, func(i, j int) bool {
+		return tcs.Components[i].Kind < tcs.Components[j].Kind
+	})
+}
+
+// RemoveComponentStatus drops the status entry of a component which is no longer installed
+func (tcs *TektonConfigStatus) RemoveComponentStatus(kind string) {
+	for i := range tcs.Components {
+		if tcs.Components[i].Kind == kind {
+			tcs.Components = append(tcs.Components[:i], tcs.Components[i+1:]...)
+			return
+		}
+	}
+}
@@ -18,9 +18,13 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 	apistest "knative.dev/pkg/apis/testing"
 )
 
@@ -157,3 +161,56 @@ func TestPostUpgradeVersion(t *testing.T) {
 	assert.Equal(t, tc.Status.GetPostUpgradeVersion(), "bar")
 	assert.Equal(t, tc.Status.Annotations[PostUpgradeVersionKey], "bar")
 }
+
+func TestComponentStatus(t *testing.T) {
+	tc := &TektonConfigStatus{}
+
+	// should return nil for an unknown component
+	assert.Assert(t, tc.GetComponentStatus(KindTektonPipeline) == nil)
+
+	tc.SetComponentStatus(ComponentStatus{Kind: KindTektonTrigger, Ready: corev1.ConditionFalse, Message: "installing"})
+	tc.SetComponentStatus(ComponentStatus{Kind: KindTektonPipeline, Ready: corev1.ConditionTrue, Version: "v0.70.0"})
+	assert.Equal(t, len(tc.Components), 2)
+	// entries are kept sorted by kind
+	assert.Equal(t, tc.Components[0].Kind, KindTektonPipeline)
+	assert.Equal(t, tc.Components[1].Kind, KindTektonTrigger)
+
+	trigger := tc.GetComponentStatus(KindTektonTrigger)
+	assert.Assert(t, trigger != nil)
+	assert.Equal(t, trigger.Message, "installing")
+	assert.Assert(t, !trigger.LastTransitionTime.Inner.IsZero())
+
+	// transition time is preserved while the ready status does not change
+	past := apis.VolatileTime{Inner: metav1.NewTime(time.Now().Add(-time.Hour))}
+	trigger.LastTransitionTime = past
+	tc.SetComponentStatus(ComponentStatus{Kind: KindTektonTrigger, Ready: corev1.ConditionFalse, Message: "still installing"})
+	trigger = tc.GetComponentStatus(KindTektonTrigger)
+	assert.Equal(t, trigger.Message, "still installing")
+	assert.Equal(t, trigger.LastTransitionTime, past)
+
+	// and updated once it does
+	tc.SetComponentStatus(ComponentStatus{Kind: KindTektonTrigger, Ready: corev1.ConditionTrue})
+	trigger = tc.GetComponentStatus(KindTektonTrigger)
+	assert.Assert(t, trigger.LastTransitionTime.Inner.After(past.Inner.Time))
+
+	tc.RemoveComponentStatus(KindTektonTrigger)
+	assert.Assert(t, tc.GetComponentStatus(KindTektonTrigger) == nil)
+	assert.Equal(t, len(tc.Components), 1)
+}
+
+func TestTektonConfigComponentStatusInterface(t *testing.T) {
+	tc := &TektonConfigStatus{}
+	tc.InitializeConditions()
+
+	tc.MarkInstallerSetNotReady("waiting for component")
+	apistest.CheckConditionFailed(tc, ComponentsReady, t)
+
+	tc.MarkInstallerSetReady()
+	apistest.CheckConditionSucceeded(tc, ComponentsReady, t)
+
+	tc.MarkPreReconcilerFailed("failed")
+	apistest.CheckConditionFailed(tc, PreInstall, t)
+
+	tc.MarkPostReconcilerFailed("failed")
+	apistest.CheckConditionFailed(tc, PostInstall, t)
+}
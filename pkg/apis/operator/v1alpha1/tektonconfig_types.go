@@ -21,6 +21,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -32,6 +33,7 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
@@ -75,6 +77,9 @@ type Prune struct {
 	// Optional deadline in seconds for starting the job if it misses scheduled time for any reason.
 	// Missed jobs executions will be counted as failed ones.
 	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+	// DryRun reports the runs which would be pruned without deleting them
+	// +optional
+	DryRun bool `json:"dry-run,omitempty"`
 }
 
 func (p Prune) IsEmpty() bool {
@@ -141,6 +146,28 @@ type TektonConfigSpec struct {
 	// Other components (Dashboard) do not yet act on this field.
 	// +optional
 	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// Rollback configures the automatic rollback of components which are not
+	// ready within a deadline after an upgrade
+	// +optional
+	Rollback Rollback `json:"rollback,omitempty"`
+	// Verification configures the smoke test run after an install or an
+	// upgrade, its outcome is reported in the Verified condition
+	// +optional
+	Verification Verification `json:"verification,omitempty"`
+	// TLS selects how the TLS certificates of the Results API and of the
+	// scheduler webhook are issued. This field is propagated to TektonResult
+	// and TektonScheduler, the webhooks of Pipelines, Triggers and Chains and
+	// the metrics endpoints are not covered.
+	// +optional
+	TLS TLSConfig `json:"tls,omitempty"`
+	// ResultsGuard keeps the pruners from deleting the runs not yet stored in
+	// Tekton Results, when TektonResult is enabled
+	// +optional
+	ResultsGuard ResultsGuard `json:"resultsGuard,omitempty"`
+	// PipelineNetworkPolicy generates NetworkPolicies restricting the network
+	// access of the TaskRun pods in the selected namespaces
+	// +optional
+	PipelineNetworkPolicy PipelineNetworkPolicy `json:"pipelineNetworkPolicy,omitempty"`
 }
 
 // PipelinesAsCodeForCurrentPlatform returns the PipelinesAsCode block for the operator build
@@ -168,31 +195,133 @@ type TektonConfigStatus struct {
 	// The current installer set name
 	// +optional
 	TektonInstallerSet map[string]string `json:"tektonInstallerSets,omitempty"`
+
+	// Components holds the observed state of each component CR managed by TektonConfig
+	// +optional
+	// +listType=map
+	// +listMapKey=kind
+	Components []ComponentStatus `json:"components,omitempty"`
+
+	// PrunerJobs reports the last run of the CronJobs of the job-based pruner
+	// +optional
+	// +listType=atomic
+	PrunerJobs []PrunerJobStatus `json:"prunerJobs,omitempty"`
+}
+
+// PrunerJobStatus reports the last run of a CronJob of the job-based pruner
+type PrunerJobStatus struct {
+	// Name of the CronJob
+	Name string `json:"name"`
+	// Schedule of the CronJob
+	Schedule string `json:"schedule"`
+	// LastRun summarizes the last run of the CronJob, only the namespaces
+	// where pruning failed are listed
+	// +optional
+	LastRun *PruneSummary `json:"lastRun,omitempty"`
+}
+
+// PruneSummary summarizes a run of the job-based pruner
+type PruneSummary struct {
+	// CompletionTime is when the run completed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Deleted is the number of runs deleted
+	Deleted int `json:"deleted"`
+	// Kept is the number of runs kept
+	Kept int `json:"kept"`
+	// Failed is the number of runs which failed to be deleted, and of the
+	// resources which failed to be listed
+	Failed int `json:"failed"`
+	// DryRun is true when no run was deleted, the runs which would be
+	// deleted are counted as candidates
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+	// Candidates is the number of runs which would be deleted in dry-run
+	// +optional
+	Candidates int `json:"candidates,omitempty"`
+	// Report is the name of the ConfigMap listing the candidates of each
+	// namespace in dry-run
+	// +optional
+	Report string `json:"report,omitempty"`
+	// NotEvaluated lists the rules which were not evaluated in dry-run, the
+	// candidates may include runs these rules would keep
+	// +optional
+	// +listType=atomic
+	NotEvaluated []string `json:"notEvaluated,omitempty"`
+	// Namespaces summarizes the run in each namespace
+	// +optional
+	// +listType=atomic
+	Namespaces []NamespacePruneSummary `json:"namespaces,omitempty"`
+}
+
+// NamespacePruneSummary summarizes a run of the job-based pruner in a
+// namespace
+type NamespacePruneSummary struct {
+	Namespace string `json:"namespace"`
+	Deleted   int    `json:"deleted"`
+	Kept      int    `json:"kept"`
+	Failed    int    `json:"failed"`
+	// +optional
+	Candidates int `json:"candidates,omitempty"`
+	// Errors holds the first errors met in the namespace
+	// +optional
+	// +listType=atomic
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ComponentStatus is the observed state of a single component CR
+// (TektonPipeline, TektonTrigger, ...) as seen by the TektonConfig reconciler
+type ComponentStatus struct {
+	// Kind of the component CR, e.g. TektonPipeline
+	Kind string `json:"kind"`
+
+	// Name of the component CR
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// The version reported by the component
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Ready is the status of the Ready condition of the component
+	// +optional
+	Ready corev1.ConditionStatus `json:"ready,omitempty"`
+
+	// Reason of the Ready condition of the component
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message of the Ready condition of the component
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// InstallerSets lists the TektonInstallerSets owned by the component
+	// +optional
+	InstallerSets []string `json:"installerSets,omitempty"`
+
+	// LastTransitionTime is the last time the Ready status of the component changed
+	// +optional
+	LastTransitionTime apis.VolatileTime `json:"lastTransitionTime,omitempty"`
 }
 
 func (in *TektonConfigStatus) MarkInstallerSetReady() {
-	// TODO implement me
-	panic("implement me")
+	in.MarkComponentsReady()
 }
 
-func (in *TektonConfigStatus) MarkInstallerSetNotReady(s string) {
-	// TODO implement me
-	panic("implement me")
+func (in *TektonConfigStatus) MarkInstallerSetNotReady(msg string) {
+	in.MarkComponentNotReady(msg)
 }
 
 func (in *TektonConfigStatus) MarkInstallerSetAvailable() {
-	// TODO implement me
-	panic("implement me")
+	in.MarkComponentsReady()
 }
 
-func (in *TektonConfigStatus) MarkPreReconcilerFailed(s string) {
-	// TODO implement me
-	panic("implement me")
+func (in *TektonConfigStatus) MarkPreReconcilerFailed(msg string) {
+	in.MarkPreInstallFailed(msg)
 }
 
-func (in *TektonConfigStatus) MarkPostReconcilerFailed(s string) {
-	// TODO implement me
-	panic("implement me")
+func (in *TektonConfigStatus) MarkPostReconcilerFailed(msg string) {
+	in.MarkPostInstallFailed(msg)
 }
 
 // TektonConfigList contains a list of TektonConfig
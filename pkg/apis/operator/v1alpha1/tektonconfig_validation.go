@@ -138,11 +138,23 @@ func (tc *TektonConfig) Validate(ctx context.Context) (errs *apis.FieldError) {
 
 	errs = errs.Also(tc.Spec.Pipeline.Options.validate("spec.pipeline.options"))
 	errs = errs.Also(tc.Spec.Dashboard.Options.validate("spec.dashboard.options"))
+	errs = errs.Also(tc.Spec.Dashboard.Ingress.validate("spec.dashboard.ingress"))
 	errs = errs.Also(tc.Spec.Chain.Options.validate("spec.chain.options"))
+	errs = errs.Also(tc.Spec.Chain.validateSigningKeys("spec.chain"))
+	errs = errs.Also(tc.Spec.Chain.validateCredentials("spec.chain"))
 	errs = errs.Also(tc.Spec.Trigger.Options.validate("spec.trigger.options"))
 	errs = errs.Also(tc.Spec.Result.Options.validate("spec.result.options"))
 	errs = errs.Also(tc.Spec.Result.Watcher.Validate("spec.result.watcher"))
+	errs = errs.Also(tc.Spec.Result.ResultsAPIProperties.validateRoute("spec.result"))
+	errs = errs.Also(tc.Spec.Result.Rotation.validate("spec.result.rotation"))
+	errs = errs.Also(tc.Spec.Result.validateBackup("spec.result.backup"))
+	errs = errs.Also(tc.Spec.Result.RetentionPolicy.validate("spec.result.retentionPolicy"))
 	errs = errs.Also(tc.Spec.MulticlusterProxyAAE.Options.validate("spec.multiclusterProxyAAE.options"))
+	errs = errs.Also(tc.Spec.Rollback.validate("spec.rollback"))
+	errs = errs.Also(tc.Spec.Verification.validate("spec.verification", tc.Spec.TargetNamespace))
+	errs = errs.Also(tc.Spec.TLS.validate("spec.tls"))
+	errs = errs.Also(tc.Spec.ResultsGuard.validate("spec.resultsGuard"))
+	errs = errs.Also(tc.Spec.PipelineNetworkPolicy.validate("spec.pipelineNetworkPolicy"))
 
 	return errs.Also(tc.Spec.Trigger.TriggersProperties.validate("spec.trigger"))
 }
@@ -18,7 +18,9 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/tektoncd/pruner/pkg/config"
 	"gotest.tools/v3/assert"
@@ -85,6 +87,71 @@ func Test_ValidateTektonConfig_InvalidProfile(t *testing.T) {
 	assert.Equal(t, "invalid value: test: spec.profile", err.Error())
 }
 
+func Test_ValidateTektonConfig_InvalidRollbackDeadline(t *testing.T) {
+
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "namespace",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Pruner:   Prune{Disabled: true},
+			Rollback: Rollback{Deadline: &metav1.Duration{Duration: -time.Minute}},
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.Equal(t, "invalid value: -1m0s: spec.rollback.deadline", err.Error())
+}
+
+func Test_ValidateTektonConfig_InvalidResultsGuardGracePeriod(t *testing.T) {
+
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "namespace",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Pruner:       Prune{Disabled: true},
+			ResultsGuard: ResultsGuard{GracePeriod: &metav1.Duration{Duration: 0}},
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.Equal(t, "invalid value: 0s: spec.resultsGuard.gracePeriod", err.Error())
+}
+
+func Test_ValidateTektonConfig_InvalidPipelineNetworkPolicySelector(t *testing.T) {
+
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "namespace",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Pruner: Prune{Disabled: true},
+			PipelineNetworkPolicy: PipelineNetworkPolicy{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}},
+				},
+			},
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.Assert(t, err != nil)
+	assert.Assert(t, strings.Contains(err.Error(), "spec.pipelineNetworkPolicy.namespaceSelector"))
+}
+
 func Test_ValidateTektonConfig_OpenShiftPlatformsOnKubernetes(t *testing.T) {
 	t.Setenv("PLATFORM", "")
 	tc := &TektonConfig{
@@ -438,3 +505,117 @@ func Test_ValidateTektonConfig_ResultWatcher(t *testing.T) {
 	assert.Assert(t, err != nil)
 	assert.ErrorContains(t, err, "spec.result.watcher.label_selector")
 }
+
+func Test_ValidateTektonConfig_Verification(t *testing.T) {
+	tests := []struct {
+		name         string
+		verification Verification
+		wantErr      string
+	}{
+		{
+			name:         "defaults",
+			verification: Verification{Enabled: true},
+		},
+		{
+			name:         "custom namespace and timeout",
+			verification: Verification{Enabled: true, Namespace: "smoke-test", Timeout: &metav1.Duration{Duration: time.Minute}},
+		},
+		{
+			name:         "target namespace",
+			verification: Verification{Enabled: true, Namespace: "tekton-pipelines"},
+			wantErr:      "spec.verification.namespace",
+		},
+		{
+			name:         "invalid namespace",
+			verification: Verification{Enabled: true, Namespace: "Smoke_Test"},
+			wantErr:      "spec.verification.namespace",
+		},
+		{
+			name:         "negative timeout",
+			verification: Verification{Enabled: true, Timeout: &metav1.Duration{Duration: -time.Minute}},
+			wantErr:      "spec.verification.timeout",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc := &TektonConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "config"},
+				Spec: TektonConfigSpec{
+					CommonSpec:   CommonSpec{TargetNamespace: "tekton-pipelines"},
+					Profile:      "all",
+					Pruner:       Prune{Disabled: true},
+					Verification: test.verification,
+				},
+			}
+			err := tc.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func Test_ValidateTektonConfig_TLS(t *testing.T) {
+	tests := []struct {
+		name    string
+		tls     TLSConfig
+		wantErr string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name: "cert-manager issuer",
+			tls:  TLSConfig{Provider: TLSProviderCertManager, IssuerRef: &IssuerReference{Name: "ca"}},
+		},
+		{
+			name: "cert-manager cluster issuer",
+			tls:  TLSConfig{Provider: TLSProviderCertManager, IssuerRef: &IssuerReference{Name: "ca", Kind: "ClusterIssuer"}},
+		},
+		{
+			name: "external issuer",
+			tls:  TLSConfig{Provider: TLSProviderCertManager, IssuerRef: &IssuerReference{Name: "ca", Kind: "AWSPCAClusterIssuer", Group: "awspca.cert-manager.io"}},
+		},
+		{
+			name:    "unknown provider",
+			tls:     TLSConfig{Provider: "vault"},
+			wantErr: "invalid value: vault: spec.tls.provider",
+		},
+		{
+			name:    "cert-manager without issuer",
+			tls:     TLSConfig{Provider: TLSProviderCertManager},
+			wantErr: "missing field(s): spec.tls.issuerRef.name",
+		},
+		{
+			name:    "unknown issuer kind",
+			tls:     TLSConfig{Provider: TLSProviderCertManager, IssuerRef: &IssuerReference{Name: "ca", Kind: "Secret"}},
+			wantErr: "spec.tls.issuerRef.kind",
+		},
+		{
+			name:    "self-signed with issuer",
+			tls:     TLSConfig{IssuerRef: &IssuerReference{Name: "ca"}},
+			wantErr: "must not set the field(s): spec.tls.issuerRef",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc := &TektonConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "config"},
+				Spec: TektonConfigSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+					Profile:    "all",
+					Pruner:     Prune{Disabled: true},
+					TLS:        test.tls,
+				},
+			}
+			err := tc.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
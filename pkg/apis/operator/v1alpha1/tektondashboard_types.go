@@ -34,6 +34,7 @@ var (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
@@ -100,4 +101,7 @@ type DashboardProperties struct {
 	Readonly bool `json:"readonly"`
 	// +optional
 	ExternalLogs string `json:"external-logs,omitempty"`
+	// Ingress exposes the Dashboard with an Ingress or an HTTPRoute on Kubernetes
+	// +optional
+	Ingress IngressConfig `json:"ingress,omitempty"`
 }
@@ -36,6 +36,8 @@ func (td *TektonDashboard) Validate(ctx context.Context) (errs *apis.FieldError)
 
 	// execute common spec validations
 	errs = errs.Also(td.Spec.CommonSpec.validate("spec"))
+	errs = errs.Also(td.Spec.Ingress.validate("spec.ingress"))
+	errs = errs.Also(td.Spec.Options.validateCredentials("spec.options"))
 
 	return errs
 }
@@ -58,3 +58,71 @@ func Test_ValidateTektonDashboard_OnDelete(t *testing.T) {
 		t.Errorf("ValidateTektonDashboard.Validate() on Delete expected no error, but got one, ValidateTektonDashboard: %v", err)
 	}
 }
+
+func Test_ValidateTektonDashboard_Ingress(t *testing.T) {
+	tests := []struct {
+		name    string
+		ingress IngressConfig
+		wantErr string
+	}{
+		{
+			name:    "disabled",
+			ingress: IngressConfig{Type: "Route"},
+		},
+		{
+			name:    "ingress",
+			ingress: IngressConfig{Enabled: true, Host: "tekton.example.com", ClassName: "nginx", TLSSecret: "tls"},
+		},
+		{
+			name:    "http route",
+			ingress: IngressConfig{Enabled: true, Type: IngressTypeHTTPRoute, Gateway: &GatewayReference{Name: "public"}},
+		},
+		{
+			name:    "unknown type",
+			ingress: IngressConfig{Enabled: true, Type: "Route"},
+			wantErr: "invalid value: Route: spec.ingress.type",
+		},
+		{
+			name:    "invalid host",
+			ingress: IngressConfig{Enabled: true, Host: "Tekton_Dashboard"},
+			wantErr: "spec.ingress.host",
+		},
+		{
+			name:    "relative path",
+			ingress: IngressConfig{Enabled: true, Path: "dashboard"},
+			wantErr: "spec.ingress.path",
+		},
+		{
+			name:    "tls without host",
+			ingress: IngressConfig{Enabled: true, TLSSecret: "tls"},
+			wantErr: "missing field(s): spec.ingress.host",
+		},
+		{
+			name:    "http route without gateway",
+			ingress: IngressConfig{Enabled: true, Type: IngressTypeHTTPRoute},
+			wantErr: "missing field(s): spec.ingress.gateway.name",
+		},
+		{
+			name:    "http route with tls secret",
+			ingress: IngressConfig{Enabled: true, Type: IngressTypeHTTPRoute, Gateway: &GatewayReference{Name: "public"}, TLSSecret: "tls"},
+			wantErr: "must not set the field(s): spec.ingress.tlsSecret",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			td := &TektonDashboard{
+				ObjectMeta: metav1.ObjectMeta{Name: DashboardResourceName},
+				Spec: TektonDashboardSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+					Dashboard:  Dashboard{DashboardProperties: DashboardProperties{Ingress: test.ingress}},
+				},
+			}
+			err := td.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
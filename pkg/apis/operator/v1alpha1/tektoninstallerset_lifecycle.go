@@ -17,8 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
+
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 const (
@@ -33,6 +36,9 @@ const (
 	JobsInstalled        apis.ConditionType = "JobsInstalled"
 )
 
+// MaxDriftHistory is the number of drifts kept in TektonInstallerSet status
+const MaxDriftHistory = 10
+
 var (
 	installerSetCondSet = apis.NewLivingConditionSet(
 		CrdInstalled,
@@ -51,6 +57,16 @@ func (tis *TektonInstallerSet) GetGroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind(KindTektonInstallerSet)
 }
 
+// GetConditionSet implements duckv1.KRShaped
+func (tis *TektonInstallerSet) GetConditionSet() apis.ConditionSet {
+	return installerSetCondSet
+}
+
+// GetStatus implements duckv1.KRShaped
+func (tis *TektonInstallerSet) GetStatus() *duckv1.Status {
+	return &tis.Status.Status
+}
+
 func (tis *TektonInstallerSetStatus) GetCondition(t apis.ConditionType) *apis.Condition {
 	return installerSetCondSet.Manage(tis).GetCondition(t)
 }
@@ -181,3 +197,25 @@ func (tis *TektonInstallerSetStatus) MarkJobsInstallationFailed(msg string) {
 		"Error",
 		"Install failed with message: %s", msg)
 }
+
+// RecordDrift appends a drift to the status, dropping the oldest ones above
+// MaxDriftHistory. A drift which was not reverted is detected again on every
+// reconcile, it is recorded only once and false is returned for the repeats
+func (tis *TektonInstallerSetStatus) RecordDrift(drift ResourceDrift) bool {
+	for i := len(tis.Drift) - 1; i >= 0; i-- {
+		d := tis.Drift[i]
+		if d.APIVersion != drift.APIVersion || d.Kind != drift.Kind ||
+			d.Namespace != drift.Namespace || d.Name != drift.Name {
+			continue
+		}
+		if !d.Reverted && !drift.Reverted && slices.Equal(d.Fields, drift.Fields) {
+			return false
+		}
+		break
+	}
+	tis.Drift = append(tis.Drift, drift)
+	if len(tis.Drift) > MaxDriftHistory {
+		tis.Drift = tis.Drift[len(tis.Drift)-MaxDriftHistory:]
+	}
+	return true
+}
@@ -150,3 +150,53 @@ func TestTektonInstallerSetErrorPath(t *testing.T) {
 		t.Errorf("tt.IsReady() = %v, want false", ready)
 	}
 }
+
+func TestTektonInstallerSetRecordDrift(t *testing.T) {
+	tis := &TektonInstallerSetStatus{}
+	drift := ResourceDrift{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  "tekton-pipelines",
+		Name:       "feature-flags",
+		Fields:     []string{"data.enable-api-fields"},
+	}
+
+	if !tis.RecordDrift(drift) {
+		t.Errorf("expected the drift to be recorded")
+	}
+	// the same drift which was not reverted is recorded once
+	if tis.RecordDrift(drift) {
+		t.Errorf("expected the same drift not to be recorded again")
+	}
+	// another field of the same resource drifted
+	drift.Fields = []string{"data.enable-api-fields", "data.enable-step-actions"}
+	if !tis.RecordDrift(drift) {
+		t.Errorf("expected the drift of another field to be recorded")
+	}
+	if len(tis.Drift) != 2 {
+		t.Errorf("expected 2 drifts, got %d", len(tis.Drift))
+	}
+
+	// reverted drifts are always recorded, the oldest ones are dropped
+	reverted := ResourceDrift{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Namespace:  "tekton-pipelines",
+		Name:       "tekton-pipelines-controller",
+		Fields:     []string{"spec.replicas"},
+		Reverted:   true,
+	}
+	for i := 0; i < MaxDriftHistory; i++ {
+		if !tis.RecordDrift(reverted) {
+			t.Errorf("expected the reverted drift to be recorded")
+		}
+	}
+	if len(tis.Drift) != MaxDriftHistory {
+		t.Errorf("expected %d drifts, got %d", MaxDriftHistory, len(tis.Drift))
+	}
+	for _, d := range tis.Drift {
+		if d.Kind != "Deployment" {
+			t.Errorf("expected the oldest drifts to be dropped, got %v", d)
+		}
+	}
+}
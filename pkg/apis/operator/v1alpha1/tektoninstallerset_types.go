@@ -24,7 +24,7 @@ import (
 
 // TektonInstallerSet is the Schema for the TektonInstallerSet API
 // +genclient
-// +genreconciler:krshapedlogic=false
+// +genreconciler
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
@@ -50,6 +50,27 @@ type TektonInstallerSetSpec struct {
 // TektonInstallerSetStatus defines the observed state of TektonInstallerSet
 type TektonInstallerSetStatus struct {
 	duckv1.Status `json:",inline"`
+
+	// Drift lists the last resources of the installer set which were
+	// changed outside of the operator, the most recent last
+	// +optional
+	Drift []ResourceDrift `json:"drift,omitempty"`
+}
+
+// ResourceDrift describes a change made to a resource of an installer set
+// outside of the operator
+type ResourceDrift struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Fields lists the paths of the fields which differ from the manifest
+	Fields []string `json:"fields"`
+	// Reverted is true when the operator restored the fields from the manifest
+	Reverted bool `json:"reverted"`
+	// DetectedAt is the time the drift was detected
+	DetectedAt metav1.Time `json:"detectedAt"`
 }
 
 // TektonInstallerSetList contains a list of TektonInstallerSet
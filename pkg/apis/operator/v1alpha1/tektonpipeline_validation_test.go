@@ -264,3 +264,52 @@ func Test_ValidateTektonPipeline_OnDelete(t *testing.T) {
 		t.Errorf("ValidateTektonPipeline.Validate() on Delete expected no error, but got one, ValidateTektonPipeline: %v", err)
 	}
 }
+
+func TestValidateTektonPipelineIgnoreDifferences(t *testing.T) {
+	tests := []struct {
+		name              string
+		ignoreDifferences []IgnoreDifference
+		err               string
+	}{
+		{
+			name: "valid",
+			ignoreDifferences: []IgnoreDifference{
+				{Group: "apps", Kind: "Deployment", Name: "tekton-pipelines-*", JSONPointers: []string{"/spec/replicas"}},
+				{Kind: "ConfigMap", JSONPointers: []string{"/metadata/annotations/sidecar.istio.io~1status"}},
+			},
+		},
+		{
+			name:              "missing kind and pointers",
+			ignoreDifferences: []IgnoreDifference{{Name: "tekton-pipelines-controller"}},
+			err:               "missing field(s): spec.ignoreDifferences[0].jsonPointers, spec.ignoreDifferences[0].kind",
+		},
+		{
+			name:              "invalid pointer",
+			ignoreDifferences: []IgnoreDifference{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas", "spec.template"}}},
+			err:               "invalid value: spec.template: spec.ignoreDifferences[0].jsonPointers[1]",
+		},
+		{
+			name:              "invalid name pattern",
+			ignoreDifferences: []IgnoreDifference{{Group: "apps", Kind: "Deployment", Name: "[", JSONPointers: []string{"/spec/replicas"}}},
+			err:               "invalid value: [: spec.ignoreDifferences[0].name",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tp := &TektonPipeline{
+				ObjectMeta: metav1.ObjectMeta{Name: "pipeline"},
+				Spec: TektonPipelineSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "namespace"},
+				},
+			}
+			tp.Spec.Options.IgnoreDifferences = test.ignoreDifferences
+			err := tp.Validate(context.TODO())
+			if test.err == "" {
+				assert.Equal(t, err.Error(), "")
+			} else {
+				assert.Equal(t, err.Error(), test.err)
+			}
+		})
+	}
+}
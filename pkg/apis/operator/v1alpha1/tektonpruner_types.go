@@ -35,6 +35,7 @@ var (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
@@ -58,6 +59,11 @@ type Pruner struct {
 	// options holds additions fields and these fields will be updated on the manifests
 	// +optional
 	Options AdditionalOptions `json:"options"`
+
+	// DryRun stops the pruner controller, the operator reports the runs which
+	// the global config would prune instead
+	// +optional
+	DryRun bool `json:"dry-run,omitempty"`
 }
 
 // TektonPrunerList contains a list of TektonPruner
@@ -92,6 +98,10 @@ type TektonPrunerStatus struct {
 	// The current installer set name for TektonPruner
 	// +optional
 	TektonInstallerSet string `json:"tektonInstallerSet,omitempty"`
+
+	// DryRun summarizes the last evaluation of the global config in dry-run
+	// +optional
+	DryRun *PruneSummary `json:"dryRun,omitempty"`
 }
 
 // GetSpec implements TektonComponent
@@ -34,6 +34,7 @@ var (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
@@ -65,6 +66,9 @@ type TektonResultSpec struct {
 	// NetworkPolicy configures NetworkPolicy creation for TektonResult workloads.
 	// +optional
 	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// TLS selects how the certificate of the Results API is issued
+	// +optional
+	TLS TLSConfig `json:"tls,omitempty"`
 }
 
 type LokiStackProperties struct {
@@ -88,6 +92,75 @@ type Result struct {
 	// Watcher holds configuration for the Tekton Results Watcher controller.
 	// +optional
 	Watcher ResultsWatcherProperties `json:"watcher,omitempty"`
+	// Rotation configures the rotation of the certificate and the database
+	// password generated by the operator
+	// +optional
+	Rotation ResultsRotation `json:"rotation,omitempty"`
+	// Backup configures scheduled backups of the bundled database
+	// +optional
+	Backup ResultsBackup `json:"backup,omitempty"`
+	// RetentionPolicy configures the retention policy agent deleting the
+	// records older than their retention from the database
+	// +optional
+	RetentionPolicy ResultsRetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// ResultsRetentionPolicy configures the retention policy agent of Results,
+// the defaults of Results apply to the fields which are unset
+type ResultsRetentionPolicy struct {
+	// MaxAge is how long the records are kept
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+	// RunAt is when the agent deletes the expired records, in the cron format
+	// +optional
+	RunAt string `json:"runAt,omitempty"`
+	// Namespaces overrides how long the records of namespaces are kept
+	// +optional
+	// +listType=atomic
+	Namespaces []ResultsNamespaceRetention `json:"namespaces,omitempty"`
+}
+
+// ResultsNamespaceRetention overrides the retention of the records of
+// namespaces
+type ResultsNamespaceRetention struct {
+	// Names of the namespaces
+	Names []string `json:"names"`
+	// MaxAge is how long the records of the namespaces are kept
+	MaxAge metav1.Duration `json:"maxAge"`
+}
+
+// ResultsBackup configures the CronJob dumping the bundled database to a
+// PersistentVolumeClaim or to an S3 compatible object storage
+type ResultsBackup struct {
+	// Schedule of the backups in the cron format, backups are disabled when
+	// it is unset
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+	// Retention is the number of backups kept, 7 by default
+	// +optional
+	Retention *uint `json:"retention,omitempty"`
+	// PVC is the name of the PersistentVolumeClaim of the target namespace
+	// the backups are written to
+	// +optional
+	PVC string `json:"pvc,omitempty"`
+	// SecretName is the name of the Secret of the target namespace holding
+	// the S3 compatible object storage the backups are uploaded to, with the
+	// S3_ keys of the logs storage secret
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// ResultsRotation configures the rotation of the material generated by the
+// operator for Results
+type ResultsRotation struct {
+	// TLS configures the rotation of the self-signed certificate of the
+	// Results API
+	// +optional
+	TLS RotationPolicy `json:"tls,omitempty"`
+	// Database configures the rotation of the password of the bundled
+	// database
+	// +optional
+	Database RotationPolicy `json:"database,omitempty"`
 }
 
 // ResultsAPIProperties defines the fields which are configurable for
@@ -141,6 +214,38 @@ type ResultsAPIProperties struct {
 	RoutePath    string `json:"route_path,omitempty"`
 	// +optional
 	RouteTLSTermination string `json:"route_tls_termination,omitempty"`
+	// RouteType is the resource exposing the Results API on Kubernetes,
+	// Ingress (default), HTTPRoute or GRPCRoute
+	// +optional
+	RouteType string `json:"route_type,omitempty"`
+	// RouteClassName is the ingressClassName of the Ingress
+	// +optional
+	RouteClassName string `json:"route_class_name,omitempty"`
+	// RouteGateway the route is attached to, required for the Gateway API routes
+	// +optional
+	RouteGateway *GatewayReference `json:"route_gateway,omitempty"`
+	// RouteTLSSecret holds the certificate of the Ingress host, defaults to
+	// the TLS secret of the Results API
+	// +optional
+	RouteTLSSecret string `json:"route_tls_secret,omitempty"`
+	// RouteAnnotations of the Ingress or the route
+	// +optional
+	RouteAnnotations map[string]string `json:"route_annotations,omitempty"`
+}
+
+// IngressConfig returns the route properties as the configuration of the
+// Ingress or the Gateway API route exposing the Results API on Kubernetes
+func (p ResultsAPIProperties) IngressConfig() IngressConfig {
+	return IngressConfig{
+		Enabled:     p.RouteEnabled != nil && *p.RouteEnabled,
+		Type:        p.RouteType,
+		Host:        p.RouteHost,
+		Path:        p.RoutePath,
+		ClassName:   p.RouteClassName,
+		Gateway:     p.RouteGateway,
+		TLSSecret:   p.RouteTLSSecret,
+		Annotations: p.RouteAnnotations,
+	}
 }
 
 // TektonResultStatus defines the observed state of TektonResult
@@ -154,6 +259,16 @@ type TektonResultStatus struct {
 	// The current installer set name for TektonResult
 	// +optional
 	TektonInstallerSet string `json:"tektonInstallerSet,omitempty"`
+
+	// Rotations reports the rotation of the certificate and the database
+	// password generated by the operator
+	// +optional
+	Rotations []RotationStatus `json:"rotations,omitempty"`
+
+	// LastBackupTime is when the last successful backup of the bundled
+	// database completed
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
 }
 
 func (trs *TektonResultStatus) MarkPreReconcilerFailed(msg string) {
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/robfig/cron"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 )
 
@@ -39,6 +41,7 @@ func (tp *TektonResult) Validate(ctx context.Context) (errs *apis.FieldError) {
 		return errs.Also(apis.ErrInvalidValue(tp.GetName(), errMsg))
 	}
 	errs = errs.Also(tp.Spec.validate("spec"))
+	errs = errs.Also(tp.Spec.Options.validateCredentials("spec.options"))
 	return errs
 }
 
@@ -62,5 +65,125 @@ func (trs *TektonResultSpec) validate(path string) (errs *apis.FieldError) {
 
 	errs = errs.Also(trs.NetworkPolicy.validate(fmt.Sprintf("%s.networkPolicy", path)))
 
+	errs = errs.Also(trs.ResultsAPIProperties.validateRoute(path))
+
+	errs = errs.Also(trs.TLS.validate(fmt.Sprintf("%s.tls", path)))
+
+	errs = errs.Also(trs.Rotation.validate(fmt.Sprintf("%s.rotation", path)))
+
+	errs = errs.Also(trs.Result.validateBackup(fmt.Sprintf("%s.backup", path)))
+	errs = errs.Also(trs.RetentionPolicy.validate(fmt.Sprintf("%s.retentionPolicy", path)))
+
+	return errs
+}
+
+// validateRoute validates the route properties used to expose the Results
+// API on Kubernetes, OpenShift creates a Route and ignores the other fields
+func (p ResultsAPIProperties) validateRoute(path string) (errs *apis.FieldError) {
+	cfg := p.IngressConfig()
+	if !cfg.Enabled || IsOpenShiftPlatform() {
+		return nil
+	}
+	types := []string{IngressTypeIngress, IngressTypeHTTPRoute, IngressTypeGRPCRoute}
+	if !isIngressType(cfg.GetType(), types) {
+		errs = errs.Also(apis.ErrInvalidValue(p.RouteType, path+".route_type", "must be one of "+strings.Join(types, ", ")))
+	}
+	if p.RouteHost != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(strings.TrimPrefix(p.RouteHost, "*.")) {
+			errs = errs.Also(apis.ErrInvalidValue(p.RouteHost, path+".route_host", msg))
+		}
+	}
+	if !strings.HasPrefix(cfg.GetPath(), "/") {
+		errs = errs.Also(apis.ErrInvalidValue(p.RoutePath, path+".route_path", "must be an absolute path"))
+	}
+	switch cfg.GetType() {
+	case IngressTypeIngress:
+		if p.RouteGateway != nil {
+			errs = errs.Also(apis.ErrDisallowedFields(path + ".route_gateway"))
+		}
+		if p.RouteTLSSecret != "" && p.RouteHost == "" {
+			errs = errs.Also(apis.ErrMissingField(path + ".route_host"))
+		}
+		return errs
+	case IngressTypeGRPCRoute:
+		// gRPC requests are matched on the service and the method
+		if p.RoutePath != "" {
+			errs = errs.Also(apis.ErrDisallowedFields(path + ".route_path"))
+		}
+	}
+	if p.RouteGateway == nil || p.RouteGateway.Name == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".route_gateway.name"))
+	}
+	if p.RouteClassName != "" {
+		errs = errs.Also(apis.ErrDisallowedFields(path + ".route_class_name"))
+	}
+	if p.RouteTLSSecret != "" {
+		errs = errs.Also(apis.ErrDisallowedFields(path + ".route_tls_secret"))
+	}
+	return errs
+}
+
+func (r ResultsRotation) validate(path string) (errs *apis.FieldError) {
+	return errs.Also(
+		r.TLS.validate(path+".tls"),
+		r.Database.validate(path+".database"),
+	)
+}
+
+func (r Result) validateBackup(path string) (errs *apis.FieldError) {
+	b := r.Backup
+	if b.Schedule == "" {
+		if b.PVC != "" || b.SecretName != "" || b.Retention != nil {
+			errs = errs.Also(apis.ErrMissingField(path + ".schedule"))
+		}
+		return errs
+	}
+	if r.IsExternalDB {
+		errs = errs.Also(apis.ErrGeneric("backups are only supported for the bundled database", path+".schedule"))
+	}
+	if _, err := cron.ParseStandard(b.Schedule); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(b.Schedule, path+".schedule", err.Error()))
+	}
+	if b.Retention != nil && *b.Retention == 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*b.Retention, path+".retention", "must be at least 1"))
+	}
+	switch {
+	case b.PVC == "" && b.SecretName == "":
+		errs = errs.Also(apis.ErrMissingOneOf(path+".pvc", path+".secretName"))
+	case b.PVC != "" && b.SecretName != "":
+		errs = errs.Also(apis.ErrMultipleOneOf(path+".pvc", path+".secretName"))
+	}
+	return errs
+}
+
+func (r ResultsRetentionPolicy) validate(path string) (errs *apis.FieldError) {
+	if r.MaxAge != nil && r.MaxAge.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(r.MaxAge.Duration.String(), path+".maxAge", "must be positive"))
+	}
+	if r.RunAt != "" {
+		if _, err := cron.ParseStandard(r.RunAt); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(r.RunAt, path+".runAt", err.Error()))
+		}
+	}
+	seen := map[string]bool{}
+	for i, override := range r.Namespaces {
+		overridePath := fmt.Sprintf("%s.namespaces[%d]", path, i)
+		if len(override.Names) == 0 {
+			errs = errs.Also(apis.ErrMissingField(overridePath + ".names"))
+		}
+		for j, name := range override.Names {
+			namePath := fmt.Sprintf("%s.names[%d]", overridePath, j)
+			for _, msg := range validation.IsDNS1123Label(name) {
+				errs = errs.Also(apis.ErrInvalidValue(name, namePath, msg))
+			}
+			if seen[name] {
+				errs = errs.Also(apis.ErrInvalidValue(name, namePath, "namespace already overridden"))
+			}
+			seen[name] = true
+		}
+		if override.MaxAge.Duration <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(override.MaxAge.Duration.String(), overridePath+".maxAge", "must be positive"))
+		}
+	}
 	return errs
 }
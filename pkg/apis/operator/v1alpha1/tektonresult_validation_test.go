@@ -19,9 +19,11 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
 )
 
 func TestTektonResult_Validate(t *testing.T) {
@@ -37,6 +39,223 @@ func TestTektonResult_Validate(t *testing.T) {
 	assert.Equal(t, "invalid value: wrong-name: metadata.name, Only one instance of TektonResult is allowed by name, result", err.Error())
 }
 
+func TestTektonResult_ValidateRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		props   ResultsAPIProperties
+		wantErr string
+	}{
+		{
+			name:  "disabled",
+			props: ResultsAPIProperties{RouteEnabled: ptr.Bool(false), RouteType: "Route"},
+		},
+		{
+			name:  "ingress",
+			props: ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteHost: "results.example.com", RouteClassName: "nginx", RouteTLSSecret: "tls"},
+		},
+		{
+			name:  "grpc route",
+			props: ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteType: IngressTypeGRPCRoute, RouteGateway: &GatewayReference{Name: "public"}},
+		},
+		{
+			name:    "unknown type",
+			props:   ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteType: "Route"},
+			wantErr: "invalid value: Route: spec.route_type",
+		},
+		{
+			name:    "invalid host",
+			props:   ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteHost: "Tekton_Results"},
+			wantErr: "spec.route_host",
+		},
+		{
+			name:    "tls without host",
+			props:   ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteTLSSecret: "tls"},
+			wantErr: "missing field(s): spec.route_host",
+		},
+		{
+			name:    "ingress with gateway",
+			props:   ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteGateway: &GatewayReference{Name: "public"}},
+			wantErr: "must not set the field(s): spec.route_gateway",
+		},
+		{
+			name:    "grpc route without gateway",
+			props:   ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteType: IngressTypeGRPCRoute},
+			wantErr: "missing field(s): spec.route_gateway.name",
+		},
+		{
+			name:    "grpc route with path",
+			props:   ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteType: IngressTypeGRPCRoute, RouteGateway: &GatewayReference{Name: "public"}, RoutePath: "/api"},
+			wantErr: "must not set the field(s): spec.route_path",
+		},
+		{
+			name:    "http route with class name",
+			props:   ResultsAPIProperties{RouteEnabled: ptr.Bool(true), RouteType: IngressTypeHTTPRoute, RouteGateway: &GatewayReference{Name: "public"}, RouteClassName: "nginx"},
+			wantErr: "must not set the field(s): spec.route_class_name",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := &TektonResult{
+				ObjectMeta: metav1.ObjectMeta{Name: ResultResourceName},
+				Spec: TektonResultSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+					Result:     Result{ResultsAPIProperties: test.props},
+				},
+			}
+			err := tr.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestTektonResult_ValidateBackup(t *testing.T) {
+	zero := uint(0)
+	tests := []struct {
+		name       string
+		backup     ResultsBackup
+		externalDB bool
+		wantErr    string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:   "pvc",
+			backup: ResultsBackup{Schedule: "0 2 * * *", PVC: "results-backups"},
+		},
+		{
+			name:   "object storage",
+			backup: ResultsBackup{Schedule: "@daily", SecretName: "backup-storage"},
+		},
+		{
+			name:    "target without schedule",
+			backup:  ResultsBackup{PVC: "results-backups"},
+			wantErr: "missing field(s): spec.backup.schedule",
+		},
+		{
+			name:    "invalid schedule",
+			backup:  ResultsBackup{Schedule: "every day", PVC: "results-backups"},
+			wantErr: "invalid value: every day: spec.backup.schedule",
+		},
+		{
+			name:    "no target",
+			backup:  ResultsBackup{Schedule: "@daily"},
+			wantErr: "expected exactly one, got neither: spec.backup.pvc, spec.backup.secretName",
+		},
+		{
+			name:    "both targets",
+			backup:  ResultsBackup{Schedule: "@daily", PVC: "results-backups", SecretName: "backup-storage"},
+			wantErr: "expected exactly one, got both: spec.backup.pvc, spec.backup.secretName",
+		},
+		{
+			name:    "no retention",
+			backup:  ResultsBackup{Schedule: "@daily", PVC: "results-backups", Retention: &zero},
+			wantErr: "invalid value: 0: spec.backup.retention",
+		},
+		{
+			name:       "external database",
+			backup:     ResultsBackup{Schedule: "@daily", PVC: "results-backups"},
+			externalDB: true,
+			wantErr:    "backups are only supported for the bundled database: spec.backup.schedule",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := &TektonResult{
+				ObjectMeta: metav1.ObjectMeta{Name: ResultResourceName},
+				Spec: TektonResultSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+					Result: Result{
+						ResultsAPIProperties: ResultsAPIProperties{IsExternalDB: test.externalDB},
+						Backup:               test.backup,
+					},
+				},
+			}
+			err := tr.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestTektonResult_ValidateRetentionPolicy(t *testing.T) {
+	day := metav1.Duration{Duration: 24 * time.Hour}
+	tests := []struct {
+		name    string
+		policy  ResultsRetentionPolicy
+		wantErr string
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "policy",
+			policy: ResultsRetentionPolicy{
+				MaxAge:     &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				RunAt:      "@weekly",
+				Namespaces: []ResultsNamespaceRetention{{Names: []string{"prod"}, MaxAge: day}},
+			},
+		},
+		{
+			name:    "negative max age",
+			policy:  ResultsRetentionPolicy{MaxAge: &metav1.Duration{Duration: -time.Hour}},
+			wantErr: "invalid value: -1h0m0s: spec.retentionPolicy.maxAge",
+		},
+		{
+			name:    "invalid run at",
+			policy:  ResultsRetentionPolicy{RunAt: "7 7 * *"},
+			wantErr: "invalid value: 7 7 * *: spec.retentionPolicy.runAt",
+		},
+		{
+			name:    "override without namespace",
+			policy:  ResultsRetentionPolicy{Namespaces: []ResultsNamespaceRetention{{MaxAge: day}}},
+			wantErr: "missing field(s): spec.retentionPolicy.namespaces[0].names",
+		},
+		{
+			name:    "invalid namespace",
+			policy:  ResultsRetentionPolicy{Namespaces: []ResultsNamespaceRetention{{Names: []string{"Prod"}, MaxAge: day}}},
+			wantErr: "invalid value: Prod: spec.retentionPolicy.namespaces[0].names[0]",
+		},
+		{
+			name: "namespace overridden twice",
+			policy: ResultsRetentionPolicy{Namespaces: []ResultsNamespaceRetention{
+				{Names: []string{"prod"}, MaxAge: day},
+				{Names: []string{"dev", "prod"}, MaxAge: day},
+			}},
+			wantErr: "invalid value: prod: spec.retentionPolicy.namespaces[1].names[1]",
+		},
+		{
+			name:    "override without max age",
+			policy:  ResultsRetentionPolicy{Namespaces: []ResultsNamespaceRetention{{Names: []string{"prod"}}}},
+			wantErr: "invalid value: 0s: spec.retentionPolicy.namespaces[0].maxAge",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := &TektonResult{
+				ObjectMeta: metav1.ObjectMeta{Name: ResultResourceName},
+				Spec: TektonResultSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+					Result:     Result{RetentionPolicy: test.policy},
+				},
+			}
+			err := tr.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
 func TestTektonResultWatcherPerformancePropertiesValidate(t *testing.T) {
 	tr := &TektonResult{
 		ObjectMeta: metav1.ObjectMeta{
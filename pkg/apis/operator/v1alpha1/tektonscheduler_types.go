@@ -35,6 +35,7 @@ var (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
@@ -87,6 +88,9 @@ type TektonSchedulerSpec struct {
 	Scheduler  `json:",inline"`
 	// +optional
 	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// TLS selects the issuer of the certificate of the scheduler webhook
+	// +optional
+	TLS TLSConfig `json:"tls,omitempty"`
 }
 
 // TektonSchedulerStatus defines the observed state of TektonScheduler
@@ -37,6 +37,7 @@ func (ts *TektonScheduler) Validate(ctx context.Context) (errs *apis.FieldError)
 	// execute common spec validations
 	errs = errs.Also(ts.Spec.MultiClusterConfig.validate())
 	errs = errs.Also(ts.Spec.NetworkPolicy.validate("spec.networkPolicy"))
+	errs = errs.Also(ts.Spec.TLS.validate("spec.tls"))
 	return errs
 }
 
@@ -37,6 +37,7 @@ func (tr *TektonTrigger) Validate(ctx context.Context) (errs *apis.FieldError) {
 	// execute common spec validations
 	errs = errs.Also(tr.Spec.CommonSpec.validate("spec"))
 	errs = errs.Also(tr.Spec.NetworkPolicy.validate("spec.networkPolicy"))
+	errs = errs.Also(tr.Spec.Options.validateCredentials("spec.options"))
 
 	return errs.Also(tr.Spec.TriggersProperties.validate("spec"))
 }
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// TLSProviderSelfSigned lets the operator and the components generate
+	// their own certificates
+	TLSProviderSelfSigned = "selfSigned"
+	// TLSProviderCertManager issues the certificates with cert-manager
+	TLSProviderCertManager = "certManager"
+
+	// CertManagerGroup is the API group of the cert-manager issuers
+	CertManagerGroup = "cert-manager.io"
+)
+
+// TLSConfig selects how the TLS certificates of the components are issued
+type TLSConfig struct {
+	// Provider of the certificates, selfSigned (default) or certManager
+	// +optional
+	Provider string `json:"provider,omitempty"`
+	// IssuerRef is the cert-manager Issuer or ClusterIssuer issuing the
+	// certificates, required with the certManager provider
+	// +optional
+	IssuerRef *IssuerReference `json:"issuerRef,omitempty"`
+}
+
+// IssuerReference identifies a cert-manager issuer
+type IssuerReference struct {
+	Name string `json:"name"`
+	// Kind of the issuer, Issuer (default) or ClusterIssuer. An Issuer
+	// must be in the target namespace
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Group of the issuer, defaults to cert-manager.io for external issuers
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// GetProvider returns the configured provider or the default one
+func (c TLSConfig) GetProvider() string {
+	if c.Provider == "" {
+		return TLSProviderSelfSigned
+	}
+	return c.Provider
+}
+
+// IsCertManager tells whether the certificates are issued by cert-manager
+func (c TLSConfig) IsCertManager() bool {
+	return c.GetProvider() == TLSProviderCertManager
+}
+
+func (c TLSConfig) validate(path string) (errs *apis.FieldError) {
+	switch c.GetProvider() {
+	case TLSProviderSelfSigned:
+		if c.IssuerRef != nil {
+			errs = errs.Also(apis.ErrDisallowedFields(path + ".issuerRef"))
+		}
+	case TLSProviderCertManager:
+		if c.IssuerRef == nil || c.IssuerRef.Name == "" {
+			errs = errs.Also(apis.ErrMissingField(path + ".issuerRef.name"))
+		} else if c.IssuerRef.Kind != "" && c.IssuerRef.Kind != "Issuer" && c.IssuerRef.Kind != "ClusterIssuer" &&
+			(c.IssuerRef.Group == "" || c.IssuerRef.Group == CertManagerGroup) {
+			errs = errs.Also(apis.ErrInvalidValue(c.IssuerRef.Kind, path+".issuerRef.kind", "must be Issuer or ClusterIssuer"))
+		}
+	default:
+		types := []string{TLSProviderSelfSigned, TLSProviderCertManager}
+		errs = errs.Also(apis.ErrInvalidValue(c.Provider, path+".provider", "must be one of "+strings.Join(types, ", ")))
+	}
+	return errs
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// Verified is a Condition reporting the outcome of the smoke test run
+	// after an install or an upgrade. It is informational and does not
+	// affect the readiness
+	Verified apis.ConditionType = "Verified"
+
+	// VerificationSucceededReason is the reason of the Verified condition
+	// when all the checks passed
+	VerificationSucceededReason = "VerificationSucceeded"
+	// VerificationFailedReason is the reason of the Verified condition when
+	// a check failed or did not complete within the timeout
+	VerificationFailedReason = "VerificationFailed"
+	// VerificationInProgressReason is the reason of the Verified condition
+	// while the checks are running
+	VerificationInProgressReason = "VerificationInProgress"
+
+	// DefaultVerificationNamespace is the scratch namespace of the checks
+	DefaultVerificationNamespace = "tekton-verification"
+	// DefaultVerificationTimeout is the time given to the checks to complete
+	DefaultVerificationTimeout = 5 * time.Minute
+)
+
+// verificationCondSet manages the Verified condition, which is not part of
+// the dependents of the TektonConfig condition set
+var verificationCondSet = apis.NewLivingConditionSet()
+
+// Verification configures the smoke test run once the components are ready
+// after an install or an upgrade
+type Verification struct {
+	// Enabled runs a TaskRun in a scratch namespace after an install or an
+	// upgrade and reports its outcome in the Verified condition
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Namespace created for the checks and deleted once they are complete,
+	// defaults to tekton-verification
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Image of the step of the TaskRun, it must provide /bin/sh.
+	// Defaults to the tkn image set in the IMAGE_JOB_VERIFICATION environment
+	// variable of the operator
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Timeout within which the checks must complete, defaults to 5m
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Triggers also sends a request to an EventListener and waits for the
+	// TaskRun it creates
+	// +optional
+	Triggers bool `json:"triggers,omitempty"`
+	// Results also checks that the TaskRun is stored by Tekton Results
+	// +optional
+	Results bool `json:"results,omitempty"`
+}
+
+// GetNamespace returns the configured namespace or the default one
+func (v Verification) GetNamespace() string {
+	if v.Namespace == "" {
+		return DefaultVerificationNamespace
+	}
+	return v.Namespace
+}
+
+// GetTimeout returns the configured timeout or the default one
+func (v Verification) GetTimeout() time.Duration {
+	if v.Timeout == nil {
+		return DefaultVerificationTimeout
+	}
+	return v.Timeout.Duration
+}
+
+func (v Verification) validate(path, targetNamespace string) (errs *apis.FieldError) {
+	if v.Namespace != "" {
+		for _, msg := range validation.IsDNS1123Label(v.Namespace) {
+			errs = errs.Also(apis.ErrInvalidValue(v.Namespace, path+".namespace", msg))
+		}
+		// the namespace is deleted after the checks
+		if v.Namespace == targetNamespace || v.Namespace == "default" || v.Namespace == "kube-system" {
+			errs = errs.Also(apis.ErrInvalidValue(v.Namespace, path+".namespace", "the verification namespace is deleted after the checks"))
+		}
+	}
+	if v.Timeout != nil && v.Timeout.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(v.Timeout.Duration.String(), path+".timeout"))
+	}
+	return errs
+}
+
+// MarkVerified sets the Verified condition once all the checks passed
+func (tcs *TektonConfigStatus) MarkVerified(msg string) {
+	verificationCondSet.Manage(tcs).SetCondition(apis.Condition{
+		Type:     Verified,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   VerificationSucceededReason,
+		Message:  msg,
+	})
+}
+
+// MarkVerificationFailed sets the Verified condition to false when a check failed
+func (tcs *TektonConfigStatus) MarkVerificationFailed(msg string) {
+	verificationCondSet.Manage(tcs).SetCondition(apis.Condition{
+		Type:     Verified,
+		Status:   corev1.ConditionFalse,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   VerificationFailedReason,
+		Message:  msg,
+	})
+}
+
+// MarkVerificationInProgress sets the Verified condition to unknown while
+// the checks are running
+func (tcs *TektonConfigStatus) MarkVerificationInProgress(msg string) {
+	verificationCondSet.Manage(tcs).SetCondition(apis.Condition{
+		Type:     Verified,
+		Status:   corev1.ConditionUnknown,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   VerificationInProgressReason,
+		Message:  msg,
+	})
+}
+
+// ClearVerified removes the Verified condition and the verified version
+func (tcs *TektonConfigStatus) ClearVerified() {
+	_ = verificationCondSet.Manage(tcs).ClearCondition(Verified)
+	delete(tcs.Annotations, VerifiedVersionKey)
+}
+
+// GetVerifiedVersion returns the operator version of the last completed verification
+func (tcs *TektonConfigStatus) GetVerifiedVersion() string {
+	return tcs.Annotations[VerifiedVersionKey]
+}
+
+// SetVerifiedVersion records the operator version of a completed verification
+func (tcs *TektonConfigStatus) SetVerifiedVersion(version string) {
+	if tcs.Annotations == nil {
+		tcs.Annotations = map[string]string{}
+	}
+	tcs.Annotations[VerifiedVersionKey] = version
+}
@@ -75,6 +75,13 @@ func (in *AdditionalOptions) DeepCopyInto(out *AdditionalOptions) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.IgnoreDifferences != nil {
+		in, out := &in.IgnoreDifferences, &out.IgnoreDifferences
+		*out = make([]IgnoreDifference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -145,6 +152,8 @@ func (in *Addon) DeepCopy() *Addon {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chain) DeepCopyInto(out *Chain) {
 	*out = *in
+	in.Rotation.DeepCopyInto(&out.Rotation)
+	in.Credentials.DeepCopyInto(&out.Credentials)
 	in.ChainProperties.DeepCopyInto(&out.ChainProperties)
 	if in.ControllerEnvs != nil {
 		in, out := &in.ControllerEnvs, &out.ControllerEnvs
@@ -167,6 +176,32 @@ func (in *Chain) DeepCopy() *Chain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainCredentials) DeepCopyInto(out *ChainCredentials) {
+	*out = *in
+	if in.KMSAuthToken != nil {
+		in, out := &in.KMSAuthToken, &out.KMSAuthToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MongoServerURL != nil {
+		in, out := &in.MongoServerURL, &out.MongoServerURL
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainCredentials.
+func (in *ChainCredentials) DeepCopy() *ChainCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChainProperties) DeepCopyInto(out *ChainProperties) {
 	*out = *in
@@ -209,6 +244,28 @@ func (in *ChainProperties) DeepCopy() *ChainProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainRotation) DeepCopyInto(out *ChainRotation) {
+	*out = *in
+	in.SigningKeys.DeepCopyInto(&out.SigningKeys)
+	if in.RetiredKeysRetention != nil {
+		in, out := &in.RetiredKeysRetention, &out.RetiredKeysRetention
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainRotation.
+func (in *ChainRotation) DeepCopy() *ChainRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 	*out = *in
@@ -225,6 +282,28 @@ func (in *CommonSpec) DeepCopy() *CommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.InstallerSets != nil {
+		in, out := &in.InstallerSets, &out.InstallerSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Config) DeepCopyInto(out *Config) {
 	*out = *in
@@ -258,7 +337,7 @@ func (in *Config) DeepCopy() *Config {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Dashboard) DeepCopyInto(out *Dashboard) {
 	*out = *in
-	out.DashboardProperties = in.DashboardProperties
+	in.DashboardProperties.DeepCopyInto(&out.DashboardProperties)
 	in.Options.DeepCopyInto(&out.Options)
 	return
 }
@@ -276,6 +355,7 @@ func (in *Dashboard) DeepCopy() *Dashboard {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardProperties) DeepCopyInto(out *DashboardProperties) {
 	*out = *in
+	in.Ingress.DeepCopyInto(&out.Ingress)
 	return
 }
 
@@ -320,6 +400,22 @@ func (in *DeploymentPerformanceArgs) DeepCopy() *DeploymentPerformanceArgs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayReference) DeepCopyInto(out *GatewayReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayReference.
+func (in *GatewayReference) DeepCopy() *GatewayReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Hub) DeepCopyInto(out *Hub) {
 	*out = *in
@@ -342,6 +438,71 @@ func (in *Hub) DeepCopy() *Hub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnoreDifference) DeepCopyInto(out *IgnoreDifference) {
+	*out = *in
+	if in.JSONPointers != nil {
+		in, out := &in.JSONPointers, &out.JSONPointers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnoreDifference.
+func (in *IgnoreDifference) DeepCopy() *IgnoreDifference {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnoreDifference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressConfig) DeepCopyInto(out *IngressConfig) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(GatewayReference)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressConfig.
+func (in *IngressConfig) DeepCopy() *IngressConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerReference) DeepCopyInto(out *IssuerReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerReference.
+func (in *IssuerReference) DeepCopy() *IssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 	*out = *in
@@ -556,6 +717,27 @@ func (in *NamespaceMetadata) DeepCopy() *NamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacePruneSummary) DeepCopyInto(out *NamespacePruneSummary) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacePruneSummary.
+func (in *NamespacePruneSummary) DeepCopy() *NamespacePruneSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacePruneSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyConfig) DeepCopyInto(out *NetworkPolicyConfig) {
 	*out = *in
@@ -928,6 +1110,34 @@ func (in *PipelineMetricsProperties) DeepCopy() *PipelineMetricsProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineNetworkPolicy) DeepCopyInto(out *PipelineNetworkPolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineNetworkPolicy.
+func (in *PipelineNetworkPolicy) DeepCopy() *PipelineNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineProperties) DeepCopyInto(out *PipelineProperties) {
 	*out = *in
@@ -1105,6 +1315,38 @@ func (in *Prune) DeepCopy() *Prune {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PruneSummary) DeepCopyInto(out *PruneSummary) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NotEvaluated != nil {
+		in, out := &in.NotEvaluated, &out.NotEvaluated
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespacePruneSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PruneSummary.
+func (in *PruneSummary) DeepCopy() *PruneSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(PruneSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Pruner) DeepCopyInto(out *Pruner) {
 	*out = *in
@@ -1128,6 +1370,27 @@ func (in *Pruner) DeepCopy() *Pruner {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrunerJobStatus) DeepCopyInto(out *PrunerJobStatus) {
+	*out = *in
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
+		*out = new(PruneSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrunerJobStatus.
+func (in *PrunerJobStatus) DeepCopy() *PrunerJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrunerJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resolvers) DeepCopyInto(out *Resolvers) {
 	*out = *in
@@ -1209,6 +1472,28 @@ func (in *ResolversConfig) DeepCopy() *ResolversConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDrift) DeepCopyInto(out *ResourceDrift) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDrift.
+func (in *ResourceDrift) DeepCopy() *ResourceDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Result) DeepCopyInto(out *Result) {
 	*out = *in
@@ -1217,6 +1502,9 @@ func (in *Result) DeepCopyInto(out *Result) {
 	in.Options.DeepCopyInto(&out.Options)
 	in.Performance.DeepCopyInto(&out.Performance)
 	in.Watcher.DeepCopyInto(&out.Watcher)
+	in.Rotation.DeepCopyInto(&out.Rotation)
+	in.Backup.DeepCopyInto(&out.Backup)
+	in.RetentionPolicy.DeepCopyInto(&out.RetentionPolicy)
 	return
 }
 
@@ -1293,6 +1581,18 @@ func (in *ResultsAPIProperties) DeepCopyInto(out *ResultsAPIProperties) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RouteGateway != nil {
+		in, out := &in.RouteGateway, &out.RouteGateway
+		*out = new(GatewayReference)
+		**out = **in
+	}
+	if in.RouteAnnotations != nil {
+		in, out := &in.RouteAnnotations, &out.RouteAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1306,6 +1606,116 @@ func (in *ResultsAPIProperties) DeepCopy() *ResultsAPIProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsBackup) DeepCopyInto(out *ResultsBackup) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsBackup.
+func (in *ResultsBackup) DeepCopy() *ResultsBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsGuard) DeepCopyInto(out *ResultsGuard) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsGuard.
+func (in *ResultsGuard) DeepCopy() *ResultsGuard {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsGuard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsNamespaceRetention) DeepCopyInto(out *ResultsNamespaceRetention) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.MaxAge = in.MaxAge
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsNamespaceRetention.
+func (in *ResultsNamespaceRetention) DeepCopy() *ResultsNamespaceRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsNamespaceRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsRetentionPolicy) DeepCopyInto(out *ResultsRetentionPolicy) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]ResultsNamespaceRetention, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsRetentionPolicy.
+func (in *ResultsRetentionPolicy) DeepCopy() *ResultsRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsRotation) DeepCopyInto(out *ResultsRotation) {
+	*out = *in
+	in.TLS.DeepCopyInto(&out.TLS)
+	in.Database.DeepCopyInto(&out.Database)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsRotation.
+func (in *ResultsRotation) DeepCopy() *ResultsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResultsWatcherProperties) DeepCopyInto(out *ResultsWatcherProperties) {
 	*out = *in
@@ -1397,6 +1807,77 @@ func (in *ResultsWatcherProperties) DeepCopy() *ResultsWatcherProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollback) DeepCopyInto(out *Rollback) {
+	*out = *in
+	if in.Deadline != nil {
+		in, out := &in.Deadline, &out.Deadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollback.
+func (in *Rollback) DeepCopy() *Rollback {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotationPolicy) DeepCopyInto(out *RotationPolicy) {
+	*out = *in
+	if in.Validity != nil {
+		in, out := &in.Validity, &out.Validity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationPolicy.
+func (in *RotationPolicy) DeepCopy() *RotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotationStatus) DeepCopyInto(out *RotationStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationStatus.
+func (in *RotationStatus) DeepCopy() *RotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SCC) DeepCopyInto(out *SCC) {
 	*out = *in
@@ -1569,6 +2050,27 @@ func (in *SyncerServiceStatus) DeepCopy() *SyncerServiceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(IssuerReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
+func (in *TLSConfig) DeepCopy() *TLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TektonAddon) DeepCopyInto(out *TektonAddon) {
 	*out = *in
@@ -1758,6 +2260,13 @@ func (in *TektonChainSpec) DeepCopy() *TektonChainSpec {
 func (in *TektonChainStatus) DeepCopyInto(out *TektonChainStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Rotations != nil {
+		in, out := &in.Rotations, &out.Rotations
+		*out = make([]RotationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1860,6 +2369,11 @@ func (in *TektonConfigSpec) DeepCopyInto(out *TektonConfigSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.Rollback.DeepCopyInto(&out.Rollback)
+	in.Verification.DeepCopyInto(&out.Verification)
+	in.TLS.DeepCopyInto(&out.TLS)
+	in.ResultsGuard.DeepCopyInto(&out.ResultsGuard)
+	in.PipelineNetworkPolicy.DeepCopyInto(&out.PipelineNetworkPolicy)
 	return
 }
 
@@ -1884,6 +2398,20 @@ func (in *TektonConfigStatus) DeepCopyInto(out *TektonConfigStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrunerJobs != nil {
+		in, out := &in.PrunerJobs, &out.PrunerJobs
+		*out = make([]PrunerJobStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2082,6 +2610,13 @@ func (in *TektonInstallerSetSpec) DeepCopy() *TektonInstallerSetSpec {
 func (in *TektonInstallerSetStatus) DeepCopyInto(out *TektonInstallerSetStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]ResourceDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2392,6 +2927,11 @@ func (in *TektonPrunerSpec) DeepCopy() *TektonPrunerSpec {
 func (in *TektonPrunerStatus) DeepCopyInto(out *TektonPrunerStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(PruneSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2473,6 +3013,7 @@ func (in *TektonResultSpec) DeepCopyInto(out *TektonResultSpec) {
 	in.Result.DeepCopyInto(&out.Result)
 	in.Config.DeepCopyInto(&out.Config)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.TLS.DeepCopyInto(&out.TLS)
 	return
 }
 
@@ -2490,6 +3031,17 @@ func (in *TektonResultSpec) DeepCopy() *TektonResultSpec {
 func (in *TektonResultStatus) DeepCopyInto(out *TektonResultStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Rotations != nil {
+		in, out := &in.Rotations, &out.Rotations
+		*out = make([]RotationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2570,6 +3122,7 @@ func (in *TektonSchedulerSpec) DeepCopyInto(out *TektonSchedulerSpec) {
 	out.CommonSpec = in.CommonSpec
 	in.Scheduler.DeepCopyInto(&out.Scheduler)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.TLS.DeepCopyInto(&out.TLS)
 	return
 }
 
@@ -2754,6 +3307,27 @@ func (in *TriggersProperties) DeepCopy() *TriggersProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Verification) DeepCopyInto(out *Verification) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Verification.
+func (in *Verification) DeepCopy() *Verification {
+	if in == nil {
+		return nil
+	}
+	out := new(Verification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfigurationOptions) DeepCopyInto(out *WebhookConfigurationOptions) {
 	*out = *in
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+// V1alpha1FieldsAnnotation holds the v1alpha1 fields which have no
+// v1beta1 counterpart, so that they survive a round trip through v1beta1
+const V1alpha1FieldsAnnotation = "operator.tekton.dev/v1alpha1-fields"
+
+// v1alpha1Fields are the v1alpha1 values stored in V1alpha1FieldsAnnotation
+type v1alpha1Fields struct {
+	// deprecated pipeline fields, not in use anymore
+	EnableTektonOciBundles     *bool  `json:"enable-tekton-oci-bundles,omitempty"`
+	VerificationMode           string `json:"verification-mode,omitempty"`
+	ScopeWhenExpressionsToTask *bool  `json:"scope-when-expressions-to-task,omitempty"`
+	DisableAffinityAssistant   *bool  `json:"disable-affinity-assistant,omitempty"`
+
+	// the original YAML of the pipeline pod templates, kept to preserve
+	// the formatting and values which do not parse
+	DefaultPodTemplate                  string `json:"default-pod-template,omitempty"`
+	DefaultAffinityAssistantPodTemplate string `json:"default-affinity-assistant-pod-template,omitempty"`
+
+	// chains values which are not a boolean
+	ArtifactsPipelineRunEnableDeepInspection string `json:"artifacts.pipelinerun.enable-deep-inspection,omitempty"`
+}
+
+// getV1alpha1Fields reads the v1alpha1 fields stored on the object
+func getV1alpha1Fields(meta metav1.ObjectMeta) (v1alpha1Fields, error) {
+	fields := v1alpha1Fields{}
+	value, ok := meta.Annotations[V1alpha1FieldsAnnotation]
+	if !ok {
+		return fields, nil
+	}
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return fields, fmt.Errorf("failed to parse %s annotation: %w", V1alpha1FieldsAnnotation, err)
+	}
+	return fields, nil
+}
+
+// setV1alpha1Fields stores the v1alpha1 fields on the object, the annotation
+// is removed when there is nothing to store
+func setV1alpha1Fields(meta *metav1.ObjectMeta, fields v1alpha1Fields) error {
+	if fields == (v1alpha1Fields{}) {
+		removeV1alpha1Fields(meta)
+		return nil
+	}
+	value, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	annotations := make(map[string]string, len(meta.Annotations)+1)
+	for k, v := range meta.Annotations {
+		annotations[k] = v
+	}
+	annotations[V1alpha1FieldsAnnotation] = string(value)
+	meta.Annotations = annotations
+	return nil
+}
+
+// removeV1alpha1Fields drops the annotation without mutating the annotations
+// map, which may be shared with the source object
+func removeV1alpha1Fields(meta *metav1.ObjectMeta) {
+	if _, ok := meta.Annotations[V1alpha1FieldsAnnotation]; !ok {
+		return
+	}
+	annotations := make(map[string]string, len(meta.Annotations))
+	for k, v := range meta.Annotations {
+		if k != V1alpha1FieldsAnnotation {
+			annotations[k] = v
+		}
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	meta.Annotations = annotations
+}
+
+// paramsToMap converts v1alpha1 params, if a name is repeated the last value wins
+func paramsToMap(params []v1alpha1.Param) map[string]string {
+	if params == nil {
+		return nil
+	}
+	return v1alpha1.ParseParams(params)
+}
+
+// mapToParams converts a params map to v1alpha1 params sorted by name
+func mapToParams(params map[string]string) []v1alpha1.Param {
+	if params == nil {
+		return nil
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]v1alpha1.Param, 0, len(names))
+	for _, name := range names {
+		out = append(out, v1alpha1.Param{Name: name, Value: params[name]})
+	}
+	return out
+}
+
+// yamlToObject parses the YAML of a v1alpha1 string field, the raw value is
+// also returned when rendering the object back would not give the same string
+func yamlToObject[T any](raw string) (*T, string) {
+	if raw == "" {
+		return nil, ""
+	}
+	out := new(T)
+	if err := yaml.Unmarshal([]byte(raw), out); err != nil {
+		return nil, raw
+	}
+	if rendered, err := yaml.Marshal(out); err == nil && string(rendered) == raw {
+		return out, ""
+	}
+	return out, raw
+}
+
+// objectToYAML renders an object to a v1alpha1 string field, the stored raw
+// value is used when it still describes the same object
+func objectToYAML[T any](obj *T, raw string) (string, error) {
+	if raw != "" {
+		parsed, _ := yamlToObject[T](raw)
+		if equality.Semantic.DeepEqual(parsed, obj) {
+			return raw, nil
+		}
+	}
+	if obj == nil {
+		return "", nil
+	}
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// boolValueToBool converts a v1alpha1 BoolValue, the raw value is returned
+// when it is not exactly "true" or "false"
+func boolValueToBool(value v1alpha1.BoolValue) (*bool, string) {
+	if value == "" {
+		return nil, ""
+	}
+	switch value {
+	case "true":
+		return ptr.Bool(true), ""
+	case "false":
+		return ptr.Bool(false), ""
+	}
+	return nil, string(value)
+}
+
+// boolToBoolValue converts a bool to a v1alpha1 BoolValue
+func boolToBoolValue(value *bool, raw string) v1alpha1.BoolValue {
+	if value == nil {
+		return v1alpha1.BoolValue(raw)
+	}
+	return v1alpha1.BoolValue(strconv.FormatBool(*value))
+}
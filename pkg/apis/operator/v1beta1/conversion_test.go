@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func uintPtr(u uint) *uint {
+	return &u
+}
+
+// roundTripFromV1alpha1 converts a v1alpha1 object to v1beta1 and back
+func roundTripFromV1alpha1(t *testing.T, in, out apis.Convertible, via apis.Convertible) {
+	t.Helper()
+	ctx := context.Background()
+	if err := via.ConvertFrom(ctx, in); err != nil {
+		t.Fatalf("ConvertFrom() = %v", err)
+	}
+	if err := via.ConvertTo(ctx, out); err != nil {
+		t.Fatalf("ConvertTo() = %v", err)
+	}
+	if d := cmp.Diff(in, out); d != "" {
+		t.Errorf("v1alpha1 round trip lost fields %s", diff.PrintWantGot(d))
+	}
+}
+
+// roundTripFromV1beta1 converts a v1beta1 object to v1alpha1 and back
+func roundTripFromV1beta1(t *testing.T, in, out apis.Convertible, via apis.Convertible) {
+	t.Helper()
+	ctx := context.Background()
+	if err := in.ConvertTo(ctx, via); err != nil {
+		t.Fatalf("ConvertTo() = %v", err)
+	}
+	if err := out.ConvertFrom(ctx, via); err != nil {
+		t.Fatalf("ConvertFrom() = %v", err)
+	}
+	if d := cmp.Diff(in, out); d != "" {
+		t.Errorf("v1beta1 round trip lost fields %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestParamsConversion(t *testing.T) {
+	if got := paramsToMap(nil); got != nil {
+		t.Errorf("paramsToMap(nil) = %v, want nil", got)
+	}
+	if got := mapToParams(nil); got != nil {
+		t.Errorf("mapToParams(nil) = %v, want nil", got)
+	}
+
+	params := []v1alpha1.Param{{Name: "b", Value: "2"}, {Name: "a", Value: "1"}, {Name: "b", Value: "3"}}
+	want := []v1alpha1.Param{{Name: "a", Value: "1"}, {Name: "b", Value: "3"}}
+	if d := cmp.Diff(want, mapToParams(paramsToMap(params))); d != "" {
+		t.Errorf("params are not sorted and deduplicated %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestYAMLConversion(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		raw     string
+		wantObj *pod.Template
+		wantRaw string
+	}{{
+		name: "empty",
+	}, {
+		name:    "canonical",
+		raw:     "nodeSelector:\n  a: b\n",
+		wantObj: &pod.Template{NodeSelector: map[string]string{"a": "b"}},
+	}, {
+		name:    "formatted",
+		raw:     "nodeSelector: {a: b}",
+		wantObj: &pod.Template{NodeSelector: map[string]string{"a": "b"}},
+		wantRaw: "nodeSelector: {a: b}",
+	}, {
+		name:    "invalid",
+		raw:     "nodeSelector: [",
+		wantRaw: "nodeSelector: [",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, raw := yamlToObject[pod.Template](tc.raw)
+			if d := cmp.Diff(tc.wantObj, obj); d != "" {
+				t.Errorf("yamlToObject() object %s", diff.PrintWantGot(d))
+			}
+			if raw != tc.wantRaw {
+				t.Errorf("yamlToObject() raw = %q, want %q", raw, tc.wantRaw)
+			}
+			got, err := objectToYAML(obj, raw)
+			if err != nil {
+				t.Fatalf("objectToYAML() = %v", err)
+			}
+			if got != tc.raw {
+				t.Errorf("objectToYAML() = %q, want %q", got, tc.raw)
+			}
+		})
+	}
+}
+
+func TestYAMLConversionChangedObject(t *testing.T) {
+	// the stored YAML is dropped once the v1beta1 object is edited
+	got, err := objectToYAML(&pod.Template{NodeSelector: map[string]string{"a": "c"}}, "nodeSelector: {a: b}")
+	if err != nil {
+		t.Fatalf("objectToYAML() = %v", err)
+	}
+	if want := "nodeSelector:\n  a: c\n"; got != want {
+		t.Errorf("objectToYAML() = %q, want %q", got, want)
+	}
+}
+
+func TestV1alpha1FieldsAnnotation(t *testing.T) {
+	meta := metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar"}}
+	if err := setV1alpha1Fields(&meta, v1alpha1Fields{VerificationMode: "enforce"}); err != nil {
+		t.Fatalf("setV1alpha1Fields() = %v", err)
+	}
+	fields, err := getV1alpha1Fields(meta)
+	if err != nil {
+		t.Fatalf("getV1alpha1Fields() = %v", err)
+	}
+	if fields.VerificationMode != "enforce" {
+		t.Errorf("VerificationMode = %q, want enforce", fields.VerificationMode)
+	}
+
+	shared := meta.Annotations
+	removeV1alpha1Fields(&meta)
+	if d := cmp.Diff(map[string]string{"foo": "bar"}, meta.Annotations); d != "" {
+		t.Errorf("annotation not removed %s", diff.PrintWantGot(d))
+	}
+	if _, ok := shared[V1alpha1FieldsAnnotation]; !ok {
+		t.Error("removeV1alpha1Fields() mutated the source annotations")
+	}
+
+	meta.Annotations[V1alpha1FieldsAnnotation] = "{"
+	if _, err := getV1alpha1Fields(meta); err == nil {
+		t.Error("getV1alpha1Fields() expected an error for an invalid annotation")
+	}
+}
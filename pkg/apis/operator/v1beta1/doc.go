@@ -14,17 +14,8 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package tektonscheduler
-
-import "go.uber.org/zap"
-
-type Recorder struct {
-}
-
-func NewRecorder() (*Recorder, error) {
-	return &Recorder{}, nil
-}
-
-func (m *Recorder) LogMetrics(status, version string, logger *zap.SugaredLogger) {
-	// TODO
-}
+// Package v1beta1 contains API Schema definitions for the operator v1beta1 API group.
+// Objects are stored as v1alpha1, v1beta1 is served through the conversion webhook.
+// +k8s:deepcopy-gen=package,register
+// +groupName=operator.tekton.dev
+package v1beta1
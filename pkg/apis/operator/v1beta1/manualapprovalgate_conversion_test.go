@@ -14,16 +14,22 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package tektonmulticlusterproxyaae
+package v1beta1
 
-import "go.uber.org/zap"
+import (
+	"testing"
 
-type Recorder struct{}
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
-func NewRecorder() (*Recorder, error) {
-	return &Recorder{}, nil
-}
+func TestManualApprovalGateConversion(t *testing.T) {
+	in := &v1alpha1.ManualApprovalGate{
+		ObjectMeta: metav1.ObjectMeta{Name: "manual-approval-gate"},
+		Spec: v1alpha1.ManualApprovalGateSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+		},
+	}
 
-func (m *Recorder) LogMetrics(status, version string, logger *zap.SugaredLogger) {
-	// TODO
+	roundTripFromV1alpha1(t, in, &v1alpha1.ManualApprovalGate{}, &ManualApprovalGate{})
 }
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOpenShiftPipelinesAsCodeConversion(t *testing.T) {
+	in := &v1alpha1.OpenShiftPipelinesAsCode{
+		ObjectMeta: metav1.ObjectMeta{Name: "pipelines-as-code"},
+		Spec: v1alpha1.OpenShiftPipelinesAsCodeSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "openshift-pipelines"},
+			PACSettings: v1alpha1.PACSettings{
+				Settings: map[string]string{"application-name": "Pipelines as Code CI"},
+			},
+		},
+	}
+
+	roundTripFromV1alpha1(t, in, &v1alpha1.OpenShiftPipelinesAsCode{}, &OpenShiftPipelinesAsCode{})
+}
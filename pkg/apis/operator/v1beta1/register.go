@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// SchemaVersion is the version of the API.
+	SchemaVersion = "v1beta1"
+)
+
+// Kind takes an unqualified kind and returns back a Group qualified GroupKind
+func Kind(kind string) schema.GroupKind {
+	return SchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+// addKnownTypes adds the set of types defined in this package to the supplied
+// scheme.
+func addKnownTypes(s *runtime.Scheme) error {
+	s.AddKnownTypes(SchemeGroupVersion,
+		&TektonConfig{},
+		&TektonConfigList{},
+		&TektonPipeline{},
+		&TektonPipelineList{},
+		&TektonTrigger{},
+		&TektonTriggerList{},
+		&TektonChain{},
+		&TektonChainList{},
+		&TektonResult{},
+		&TektonResultList{},
+		&TektonDashboard{},
+		&TektonDashboardList{},
+		&TektonAddon{},
+		&TektonAddonList{},
+		&TektonPruner{},
+		&TektonPrunerList{},
+		&TektonScheduler{},
+		&TektonSchedulerList{},
+		&TektonMulticlusterProxyAAE{},
+		&TektonMulticlusterProxyAAEList{},
+		&ManualApprovalGate{},
+		&ManualApprovalGateList{},
+		&OpenShiftPipelinesAsCode{},
+		&OpenShiftPipelinesAsCodeList{},
+		&SyncerService{},
+		&SyncerServiceList{},
+	)
+	metav1.AddToGroupVersion(s, SchemeGroupVersion)
+	return nil
+}
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: v1alpha1.GroupName, Version: SchemaVersion}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+
+	// AddToScheme adds the API's types to the Scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
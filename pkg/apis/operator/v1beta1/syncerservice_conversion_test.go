@@ -14,17 +14,22 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package syncerservice
+package v1beta1
 
-import "go.uber.org/zap"
+import (
+	"testing"
 
-type Recorder struct {
-}
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
-func NewRecorder() (*Recorder, error) {
-	return &Recorder{}, nil
-}
+func TestSyncerServiceConversion(t *testing.T) {
+	in := &v1alpha1.SyncerService{
+		ObjectMeta: metav1.ObjectMeta{Name: "syncer-service"},
+		Spec: v1alpha1.SyncerServiceSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-kueue"},
+		},
+	}
 
-func (m *Recorder) LogMetrics(status, version string, logger *zap.SugaredLogger) {
-	// TODO
+	roundTripFromV1alpha1(t, in, &v1alpha1.SyncerService{}, &SyncerService{})
 }
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonAddon)(nil)
+
+// ConvertTo implements apis.Convertible
+func (ta *TektonAddon) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonAddon:
+		sink.ObjectMeta = ta.ObjectMeta
+		sink.Spec.CommonSpec = ta.Spec.CommonSpec
+		sink.Spec.Config = ta.Spec.Config
+		sink.Spec.Addon = ta.Spec.Addon.convertTo()
+		sink.Status = ta.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (ta *TektonAddon) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonAddon:
+		ta.ObjectMeta = source.ObjectMeta
+		ta.Spec.CommonSpec = source.Spec.CommonSpec
+		ta.Spec.Config = source.Spec.Config
+		ta.Spec.Addon.convertFrom(source.Spec.Addon)
+		ta.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (a *Addon) convertFrom(source v1alpha1.Addon) {
+	a.Params = paramsToMap(source.Params)
+	a.EnablePAC = source.EnablePAC
+}
+
+func (a *Addon) convertTo() v1alpha1.Addon {
+	return v1alpha1.Addon{
+		Params:    mapToParams(a.Params),
+		EnablePAC: a.EnablePAC,
+	}
+}
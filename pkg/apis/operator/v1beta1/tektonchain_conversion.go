@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonChain)(nil)
+
+// ConvertTo implements apis.Convertible
+func (tc *TektonChain) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonChain:
+		fields, err := getV1alpha1Fields(tc.ObjectMeta)
+		if err != nil {
+			return err
+		}
+		sink.ObjectMeta = tc.ObjectMeta
+		removeV1alpha1Fields(&sink.ObjectMeta)
+		sink.Spec.CommonSpec = tc.Spec.CommonSpec
+		sink.Spec.Config = tc.Spec.Config
+		sink.Spec.NetworkPolicy = tc.Spec.NetworkPolicy
+		sink.Spec.Chain = tc.Spec.Chain.convertTo(fields)
+		sink.Status = tc.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (tc *TektonChain) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonChain:
+		fields := v1alpha1Fields{}
+		tc.ObjectMeta = source.ObjectMeta
+		tc.Spec.CommonSpec = source.Spec.CommonSpec
+		tc.Spec.Config = source.Spec.Config
+		tc.Spec.NetworkPolicy = source.Spec.NetworkPolicy
+		tc.Spec.Chain.convertFrom(source.Spec.Chain, &fields)
+		tc.Status = source.Status
+		return setV1alpha1Fields(&tc.ObjectMeta, fields)
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (c *Chain) convertFrom(source v1alpha1.Chain, fields *v1alpha1Fields) {
+	in := source.ChainProperties
+
+	c.Disabled = source.Disabled
+	c.GenerateSigningSecret = source.GenerateSigningSecret
+	c.SigningKeyType = source.SigningKeyType
+	c.Rotation = source.Rotation
+	c.Artifacts = ChainArtifacts{
+		TaskRun: ChainArtifact{
+			Format:  in.ArtifactsTaskRunFormat,
+			Storage: in.ArtifactsTaskRunStorage,
+			Signer:  in.ArtifactsTaskRunSigner,
+		},
+		PipelineRun: ChainPipelineRunArtifact{
+			ChainArtifact: ChainArtifact{
+				Format:  in.ArtifactsPipelineRunFormat,
+				Storage: in.ArtifactsPipelineRunStorage,
+				Signer:  in.ArtifactsPipelineRunSigner,
+			},
+		},
+		OCI: ChainArtifact{
+			Format:  in.ArtifactsOCIFormat,
+			Storage: in.ArtifactsOCIStorage,
+			Signer:  in.ArtifactsOCISigner,
+		},
+	}
+	c.Artifacts.PipelineRun.EnableDeepInspection, fields.ArtifactsPipelineRunEnableDeepInspection = boolValueToBool(in.ArtifactsPipelineRunEnableDeepInspection)
+	c.Storage = ChainStorage{
+		GCS: ChainGCSStorage{Bucket: in.StorageGCSBucket},
+		OCI: ChainOCIStorage{
+			Repository:         in.StorageOCIRepository,
+			RepositoryInsecure: in.StorageOCIRepositoryInsecure,
+			EncodingFormat:     in.StorageOCIEncodingFormat,
+		},
+		DocDB: ChainDocDBStorage{
+			URL:                     in.StorageDocDBURL,
+			MongoServerURL:          in.StorageDocDBMongoServerURL,
+			MongoServerURLDir:       in.StorageDocDBMongoServerURLDir,
+			MongoServerURLSecretRef: source.Credentials.MongoServerURL,
+		},
+		Grafeas: ChainGrafeasStorage{
+			ProjectID: in.StorageGrafeasProjectID,
+			NoteID:    in.StorageGrafeasNoteID,
+			NoteHint:  in.StorageGrafeasNoteHint,
+		},
+	}
+	c.Builder = ChainBuilder{ID: in.BuilderID}
+	c.BuildDefinition = ChainBuildDefinition{BuildType: in.BuildDefinitionBuildType}
+	c.Signers = ChainSigners{
+		X509: ChainX509Signer{
+			Fulcio: ChainFulcio{
+				Enabled:  in.X509SignerFulcioEnabled,
+				Address:  in.X509SignerFulcioAddr,
+				Issuer:   in.X509SignerFulcioOIDCIssuer,
+				Provider: in.X509SignerFulcioProvider,
+			},
+			IdentityTokenFile: in.X509SignerIdentityTokenFile,
+			TUFMirrorURL:      in.X509SignerTUFMirrorURL,
+		},
+		KMS: ChainKMSSigner{
+			KMSRef: in.KMSRef,
+			Auth: ChainKMSAuth{
+				Address:        in.KMSAuthAddress,
+				Token:          in.KMSAuthToken,
+				TokenPath:      in.KMSAuthTokenPath,
+				OIDC:           ChainKMSOIDCAuth{Path: in.KMSAuthOIDCPath, Role: in.KMSAuthOIDCRole},
+				Spire:          ChainKMSSpireAuth{Sock: in.KMSAuthSpireSock, Audience: in.KMSAuthSpireAudience},
+				TokenSecretRef: source.Credentials.KMSAuthToken,
+			},
+		},
+	}
+	c.Transparency = ChainTransparency{
+		Enabled: string(in.TransparencyConfigEnabled),
+		URL:     in.TransparencyConfigURL,
+	}
+	c.Performance = in.Performance
+	c.ControllerEnvs = source.ControllerEnvs
+	c.Options = source.Options
+}
+
+func (c *Chain) convertTo(fields v1alpha1Fields) v1alpha1.Chain {
+	return v1alpha1.Chain{
+		Disabled:              c.Disabled,
+		GenerateSigningSecret: c.GenerateSigningSecret,
+		SigningKeyType:        c.SigningKeyType,
+		Rotation:              c.Rotation,
+		Credentials: v1alpha1.ChainCredentials{
+			KMSAuthToken:   c.Signers.KMS.Auth.TokenSecretRef,
+			MongoServerURL: c.Storage.DocDB.MongoServerURLSecretRef,
+		},
+		ChainProperties: v1alpha1.ChainProperties{
+			ArtifactsTaskRunFormat:                   c.Artifacts.TaskRun.Format,
+			ArtifactsTaskRunStorage:                  c.Artifacts.TaskRun.Storage,
+			ArtifactsTaskRunSigner:                   c.Artifacts.TaskRun.Signer,
+			ArtifactsPipelineRunFormat:               c.Artifacts.PipelineRun.Format,
+			ArtifactsPipelineRunStorage:              c.Artifacts.PipelineRun.Storage,
+			ArtifactsPipelineRunSigner:               c.Artifacts.PipelineRun.Signer,
+			ArtifactsPipelineRunEnableDeepInspection: boolToBoolValue(c.Artifacts.PipelineRun.EnableDeepInspection, fields.ArtifactsPipelineRunEnableDeepInspection),
+			ArtifactsOCIFormat:                       c.Artifacts.OCI.Format,
+			ArtifactsOCIStorage:                      c.Artifacts.OCI.Storage,
+			ArtifactsOCISigner:                       c.Artifacts.OCI.Signer,
+			StorageGCSBucket:                         c.Storage.GCS.Bucket,
+			StorageOCIRepository:                     c.Storage.OCI.Repository,
+			StorageOCIRepositoryInsecure:             c.Storage.OCI.RepositoryInsecure,
+			StorageOCIEncodingFormat:                 c.Storage.OCI.EncodingFormat,
+			StorageDocDBURL:                          c.Storage.DocDB.URL,
+			StorageDocDBMongoServerURL:               c.Storage.DocDB.MongoServerURL,
+			StorageDocDBMongoServerURLDir:            c.Storage.DocDB.MongoServerURLDir,
+			StorageGrafeasProjectID:                  c.Storage.Grafeas.ProjectID,
+			StorageGrafeasNoteID:                     c.Storage.Grafeas.NoteID,
+			StorageGrafeasNoteHint:                   c.Storage.Grafeas.NoteHint,
+			BuilderID:                                c.Builder.ID,
+			BuildDefinitionBuildType:                 c.BuildDefinition.BuildType,
+			X509SignerFulcioEnabled:                  c.Signers.X509.Fulcio.Enabled,
+			X509SignerFulcioAddr:                     c.Signers.X509.Fulcio.Address,
+			X509SignerFulcioOIDCIssuer:               c.Signers.X509.Fulcio.Issuer,
+			X509SignerFulcioProvider:                 c.Signers.X509.Fulcio.Provider,
+			X509SignerIdentityTokenFile:              c.Signers.X509.IdentityTokenFile,
+			X509SignerTUFMirrorURL:                   c.Signers.X509.TUFMirrorURL,
+			KMSRef:                                   c.Signers.KMS.KMSRef,
+			KMSAuthAddress:                           c.Signers.KMS.Auth.Address,
+			KMSAuthToken:                             c.Signers.KMS.Auth.Token,
+			KMSAuthTokenPath:                         c.Signers.KMS.Auth.TokenPath,
+			KMSAuthOIDCPath:                          c.Signers.KMS.Auth.OIDC.Path,
+			KMSAuthOIDCRole:                          c.Signers.KMS.Auth.OIDC.Role,
+			KMSAuthSpireSock:                         c.Signers.KMS.Auth.Spire.Sock,
+			KMSAuthSpireAudience:                     c.Signers.KMS.Auth.Spire.Audience,
+			TransparencyConfigEnabled:                v1alpha1.BoolValue(c.Transparency.Enabled),
+			TransparencyConfigURL:                    c.Transparency.URL,
+			Performance:                              c.Performance,
+		},
+		ControllerEnvs: c.ControllerEnvs,
+		Options:        c.Options,
+	}
+}
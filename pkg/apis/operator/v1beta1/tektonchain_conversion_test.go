@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func v1alpha1Chain() v1alpha1.Chain {
+	return v1alpha1.Chain{
+		Disabled:              false,
+		GenerateSigningSecret: true,
+		SigningKeyType:        "ed25519",
+		Credentials: v1alpha1.ChainCredentials{
+			KMSAuthToken: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "kms"},
+				Key:                  "token",
+			},
+		},
+		ChainProperties: v1alpha1.ChainProperties{
+			ArtifactsTaskRunFormat:                   "in-toto",
+			ArtifactsTaskRunStorage:                  ptr.String("oci"),
+			ArtifactsTaskRunSigner:                   "x509",
+			ArtifactsPipelineRunFormat:               "slsa/v1",
+			ArtifactsPipelineRunStorage:              ptr.String("tekton"),
+			ArtifactsPipelineRunSigner:               "kms",
+			ArtifactsPipelineRunEnableDeepInspection: "true",
+			ArtifactsOCIFormat:                       "simplesigning",
+			ArtifactsOCIStorage:                      ptr.String(""),
+			ArtifactsOCISigner:                       "x509",
+			StorageGCSBucket:                         "bucket",
+			StorageOCIRepository:                     "registry/repo",
+			StorageOCIRepositoryInsecure:             ptr.Bool(true),
+			StorageOCIEncodingFormat:                 "dsse",
+			StorageDocDBURL:                          "mongo://db",
+			StorageDocDBMongoServerURL:               "mongodb://server",
+			StorageDocDBMongoServerURLDir:            "/mnt/url",
+			StorageGrafeasProjectID:                  "project",
+			StorageGrafeasNoteID:                     "note",
+			StorageGrafeasNoteHint:                   "hint",
+			BuilderID:                                "https://tekton.dev/chains/v2",
+			BuildDefinitionBuildType:                 "https://tekton.dev/chains/v2/slsa-tekton",
+			X509SignerFulcioEnabled:                  ptr.Bool(true),
+			X509SignerFulcioAddr:                     "https://fulcio",
+			X509SignerFulcioOIDCIssuer:               "https://issuer",
+			X509SignerFulcioProvider:                 "spiffe",
+			X509SignerIdentityTokenFile:              "/var/token",
+			X509SignerTUFMirrorURL:                   "https://tuf",
+			KMSRef:                                   "hashivault://key",
+			KMSAuthAddress:                           "https://vault",
+			KMSAuthToken:                             "token",
+			KMSAuthTokenPath:                         "/var/vault/token",
+			KMSAuthOIDCPath:                          "jwt",
+			KMSAuthOIDCRole:                          "chains",
+			KMSAuthSpireSock:                         "unix:///spire.sock",
+			KMSAuthSpireAudience:                     "chains",
+			TransparencyConfigEnabled:                "manual",
+			TransparencyConfigURL:                    "https://rekor",
+		},
+		ControllerEnvs: []corev1.EnvVar{{Name: "KUBERNETES_MIN_VERSION", Value: "v1.28.0"}},
+		Options: v1alpha1.AdditionalOptions{
+			Disabled: ptr.Bool(false),
+		},
+	}
+}
+
+func TestTektonChainConversion(t *testing.T) {
+	in := &v1alpha1.TektonChain{
+		ObjectMeta: metav1.ObjectMeta{Name: "chain"},
+		Spec: v1alpha1.TektonChainSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Chain:      v1alpha1Chain(),
+		},
+	}
+
+	roundTripFromV1alpha1(t, in, &v1alpha1.TektonChain{}, &TektonChain{})
+}
+
+func TestTektonChainConversionDeepInspection(t *testing.T) {
+	for _, value := range []v1alpha1.BoolValue{"", "true", "false", "True", "yes"} {
+		in := &v1alpha1.TektonChain{
+			ObjectMeta: metav1.ObjectMeta{Name: "chain"},
+			Spec: v1alpha1.TektonChainSpec{
+				Chain: v1alpha1.Chain{
+					ChainProperties: v1alpha1.ChainProperties{
+						ArtifactsPipelineRunEnableDeepInspection: value,
+					},
+				},
+			},
+		}
+		roundTripFromV1alpha1(t, in, &v1alpha1.TektonChain{}, &TektonChain{})
+	}
+}
+
+func TestTektonChainConversionFromV1beta1(t *testing.T) {
+	in := &TektonChain{
+		ObjectMeta: metav1.ObjectMeta{Name: "chain"},
+		Spec: TektonChainSpec{
+			Chain: Chain{
+				Artifacts: ChainArtifacts{
+					TaskRun: ChainArtifact{Format: "in-toto", Storage: ptr.String("oci")},
+					PipelineRun: ChainPipelineRunArtifact{
+						ChainArtifact:        ChainArtifact{Format: "slsa/v1"},
+						EnableDeepInspection: ptr.Bool(true),
+					},
+				},
+				Signers: ChainSigners{
+					X509: ChainX509Signer{Fulcio: ChainFulcio{Enabled: ptr.Bool(true)}},
+					KMS:  ChainKMSSigner{KMSRef: "gcpkms://key", Auth: ChainKMSAuth{OIDC: ChainKMSOIDCAuth{Role: "chains"}}},
+				},
+				Transparency: ChainTransparency{Enabled: "true", URL: "https://rekor"},
+			},
+		},
+	}
+
+	roundTripFromV1beta1(t, in, &TektonChain{}, &v1alpha1.TektonChain{})
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonChain is the Schema for the tektonchain API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
+type TektonChain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TektonChainSpec            `json:"spec,omitempty"`
+	Status v1alpha1.TektonChainStatus `json:"status,omitempty"`
+}
+
+// TektonChainSpec defines the desired state of TektonChain
+type TektonChainSpec struct {
+	v1alpha1.CommonSpec `json:",inline"`
+	Chain               `json:",inline"`
+	// Config holds the configuration for resources created by TektonChain
+	// +optional
+	Config v1alpha1.Config `json:"config,omitempty"`
+	// NetworkPolicy configures NetworkPolicy creation for TektonChain workloads.
+	// +optional
+	NetworkPolicy v1alpha1.NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+}
+
+// Chain defines the fields to customize the Chains component
+type Chain struct {
+	// enable or disable chains feature
+	Disabled bool `json:"disabled"`
+	// generate signing key
+	// +optional
+	GenerateSigningSecret bool `json:"generateSigningSecret,omitempty"`
+	// SigningKeyType is the type of the generated signing key
+	// +optional
+	SigningKeyType string `json:"signingKeyType,omitempty"`
+	// Rotation configures the rotation of the generated signing key
+	// +optional
+	Rotation v1alpha1.ChainRotation `json:"rotation,omitempty"`
+	// Artifacts configures how each kind of artifact is formatted, stored and signed
+	// +optional
+	Artifacts ChainArtifacts `json:"artifacts,omitempty"`
+	// Storage configures the storage backends
+	// +optional
+	Storage ChainStorage `json:"storage,omitempty"`
+	// Builder configures the builder recorded in provenance
+	// +optional
+	Builder ChainBuilder `json:"builder,omitempty"`
+	// BuildDefinition configures the build definition recorded in provenance
+	// +optional
+	BuildDefinition ChainBuildDefinition `json:"buildDefinition,omitempty"`
+	// Signers configures the x509 and kms signers
+	// +optional
+	Signers ChainSigners `json:"signers,omitempty"`
+	// Transparency configures the transparency log
+	// +optional
+	Transparency ChainTransparency `json:"transparency,omitempty"`
+	// +optional
+	Performance v1alpha1.PerformanceProperties `json:"performance,omitempty"`
+	// +optional
+	ControllerEnvs []corev1.EnvVar `json:"controllerEnvs,omitempty"`
+	// options holds additions fields and these fields will be updated on the manifests
+	// +optional
+	Options v1alpha1.AdditionalOptions `json:"options"`
+}
+
+// ChainArtifacts holds the per artifact type configuration
+type ChainArtifacts struct {
+	// +optional
+	TaskRun ChainArtifact `json:"taskRun,omitempty"`
+	// +optional
+	PipelineRun ChainPipelineRunArtifact `json:"pipelineRun,omitempty"`
+	// +optional
+	OCI ChainArtifact `json:"oci,omitempty"`
+}
+
+// ChainArtifact configures the format, storage and signer of an artifact type
+type ChainArtifact struct {
+	Format  string  `json:"format,omitempty"`
+	Storage *string `json:"storage,omitempty"`
+	Signer  string  `json:"signer,omitempty"`
+}
+
+// ChainPipelineRunArtifact configures PipelineRun artifacts
+type ChainPipelineRunArtifact struct {
+	ChainArtifact        `json:",inline"`
+	EnableDeepInspection *bool `json:"enableDeepInspection,omitempty"`
+}
+
+// ChainStorage holds the configuration of the storage backends
+type ChainStorage struct {
+	// +optional
+	GCS ChainGCSStorage `json:"gcs,omitempty"`
+	// +optional
+	OCI ChainOCIStorage `json:"oci,omitempty"`
+	// +optional
+	DocDB ChainDocDBStorage `json:"docdb,omitempty"`
+	// +optional
+	Grafeas ChainGrafeasStorage `json:"grafeas,omitempty"`
+}
+
+// ChainGCSStorage configures the GCS storage backend
+type ChainGCSStorage struct {
+	Bucket string `json:"bucket,omitempty"`
+}
+
+// ChainOCIStorage configures the OCI storage backend
+type ChainOCIStorage struct {
+	Repository         string `json:"repository,omitempty"`
+	RepositoryInsecure *bool  `json:"repositoryInsecure,omitempty"`
+	EncodingFormat     string `json:"encodingFormat,omitempty"`
+}
+
+// ChainDocDBStorage configures the DocDB storage backend
+type ChainDocDBStorage struct {
+	URL               string `json:"url,omitempty"`
+	MongoServerURL    string `json:"mongoServerURL,omitempty"`
+	MongoServerURLDir string `json:"mongoServerURLDir,omitempty"`
+	// MongoServerURLSecretRef references the URL of the MongoDB server in a
+	// Secret of the target namespace, in place of mongoServerURL
+	// +optional
+	MongoServerURLSecretRef *corev1.SecretKeySelector `json:"mongoServerURLSecretRef,omitempty"`
+}
+
+// ChainGrafeasStorage configures the Grafeas storage backend
+type ChainGrafeasStorage struct {
+	ProjectID string `json:"projectID,omitempty"`
+	NoteID    string `json:"noteID,omitempty"`
+	NoteHint  string `json:"noteHint,omitempty"`
+}
+
+// ChainBuilder configures the builder recorded in provenance
+type ChainBuilder struct {
+	ID string `json:"id,omitempty"`
+}
+
+// ChainBuildDefinition configures the build definition recorded in provenance
+type ChainBuildDefinition struct {
+	BuildType string `json:"buildType,omitempty"`
+}
+
+// ChainSigners holds the configuration of the signers
+type ChainSigners struct {
+	// +optional
+	X509 ChainX509Signer `json:"x509,omitempty"`
+	// +optional
+	KMS ChainKMSSigner `json:"kms,omitempty"`
+}
+
+// ChainX509Signer configures the x509 signer
+type ChainX509Signer struct {
+	// +optional
+	Fulcio ChainFulcio `json:"fulcio,omitempty"`
+	// +optional
+	IdentityTokenFile string `json:"identityTokenFile,omitempty"`
+	// +optional
+	TUFMirrorURL string `json:"tufMirrorURL,omitempty"`
+}
+
+// ChainFulcio configures keyless signing with Fulcio
+type ChainFulcio struct {
+	Enabled  *bool  `json:"enabled,omitempty"`
+	Address  string `json:"address,omitempty"`
+	Issuer   string `json:"issuer,omitempty"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// ChainKMSSigner configures the kms signer
+type ChainKMSSigner struct {
+	KMSRef string `json:"kmsRef,omitempty"`
+	// +optional
+	Auth ChainKMSAuth `json:"auth,omitempty"`
+}
+
+// ChainKMSAuth configures the authentication to the kms
+type ChainKMSAuth struct {
+	Address   string `json:"address,omitempty"`
+	Token     string `json:"token,omitempty"`
+	TokenPath string `json:"tokenPath,omitempty"`
+	// TokenSecretRef references the token in a Secret of the target
+	// namespace, in place of token
+	// +optional
+	TokenSecretRef *corev1.SecretKeySelector `json:"tokenSecretRef,omitempty"`
+	// +optional
+	OIDC ChainKMSOIDCAuth `json:"oidc,omitempty"`
+	// +optional
+	Spire ChainKMSSpireAuth `json:"spire,omitempty"`
+}
+
+// ChainKMSOIDCAuth configures OIDC authentication to the kms
+type ChainKMSOIDCAuth struct {
+	Path string `json:"path,omitempty"`
+	Role string `json:"role,omitempty"`
+}
+
+// ChainKMSSpireAuth configures Spire authentication to the kms
+type ChainKMSSpireAuth struct {
+	Sock     string `json:"sock,omitempty"`
+	Audience string `json:"audience,omitempty"`
+}
+
+// ChainTransparency configures the transparency log
+type ChainTransparency struct {
+	// Enabled is one of "true", "false" or "manual"
+	// +optional
+	Enabled string `json:"enabled,omitempty"`
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// TektonChainList contains a list of TektonChain
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonChainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonChain `json:"items"`
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonConfig)(nil)
+
+// ConvertTo implements apis.Convertible
+func (tc *TektonConfig) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonConfig:
+		fields, err := getV1alpha1Fields(tc.ObjectMeta)
+		if err != nil {
+			return err
+		}
+		sink.ObjectMeta = tc.ObjectMeta
+		removeV1alpha1Fields(&sink.ObjectMeta)
+		in := tc.Spec
+		sink.Spec = v1alpha1.TektonConfigSpec{
+			Profile:      in.Profile,
+			Config:       in.Config,
+			Pruner:       in.Pruner,
+			TektonPruner: in.TektonPruner.convertTo(),
+			Scheduler:    in.Scheduler.convertTo(),
+			CommonSpec:   in.CommonSpec,
+			Addon:        in.Addon.convertTo(),
+			Hub: v1alpha1.Hub{
+				Params:  mapToParams(in.Hub.Params),
+				Options: in.Hub.Options,
+			},
+			Trigger:                 in.Trigger.convertTo(),
+			Chain:                   in.Chain.convertTo(fields),
+			Result:                  in.Result.convertTo(),
+			Dashboard:               in.Dashboard.convertTo(),
+			MulticlusterProxyAAE:    in.MulticlusterProxyAAE,
+			Params:                  mapToParams(in.Params),
+			Platforms:               in.Platforms,
+			TargetNamespaceMetadata: in.TargetNamespaceMetadata,
+			NetworkPolicy:           in.NetworkPolicy,
+			Rollback:                in.Rollback,
+			Verification:            in.Verification,
+			TLS:                     in.TLS,
+			ResultsGuard:            in.ResultsGuard,
+			PipelineNetworkPolicy:   in.PipelineNetworkPolicy,
+		}
+		if sink.Spec.Pipeline, err = in.Pipeline.convertTo(fields); err != nil {
+			return err
+		}
+		sink.Status = tc.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (tc *TektonConfig) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonConfig:
+		fields := v1alpha1Fields{}
+		tc.ObjectMeta = source.ObjectMeta
+		in := source.Spec
+		tc.Spec = TektonConfigSpec{
+			Profile:    in.Profile,
+			Config:     in.Config,
+			Pruner:     in.Pruner,
+			CommonSpec: in.CommonSpec,
+			Hub: Hub{
+				Params:  paramsToMap(in.Hub.Params),
+				Options: in.Hub.Options,
+			},
+			MulticlusterProxyAAE:    in.MulticlusterProxyAAE,
+			Params:                  paramsToMap(in.Params),
+			Platforms:               in.Platforms,
+			TargetNamespaceMetadata: in.TargetNamespaceMetadata,
+			NetworkPolicy:           in.NetworkPolicy,
+			Rollback:                in.Rollback,
+			Verification:            in.Verification,
+			TLS:                     in.TLS,
+			ResultsGuard:            in.ResultsGuard,
+			PipelineNetworkPolicy:   in.PipelineNetworkPolicy,
+		}
+		tc.Spec.TektonPruner.convertFrom(in.TektonPruner)
+		tc.Spec.Scheduler.convertFrom(in.Scheduler)
+		tc.Spec.Addon.convertFrom(in.Addon)
+		tc.Spec.Pipeline.convertFrom(in.Pipeline, &fields)
+		tc.Spec.Trigger.convertFrom(in.Trigger)
+		tc.Spec.Chain.convertFrom(in.Chain, &fields)
+		tc.Spec.Result.convertFrom(in.Result)
+		tc.Spec.Dashboard.convertFrom(in.Dashboard)
+		tc.Status = source.Status
+		return setV1alpha1Fields(&tc.ObjectMeta, fields)
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestTektonConfigConversion(t *testing.T) {
+	in := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: v1alpha1.TektonConfigSpec{
+			Profile: v1alpha1.ProfileAll,
+			Config: v1alpha1.Config{
+				Tolerations: []corev1.Toleration{{Key: "tekton", Operator: corev1.TolerationOpExists}},
+			},
+			Pruner: v1alpha1.Prune{
+				Resources: []string{"taskrun", "pipelinerun"},
+				Keep:      uintPtr(3),
+				Schedule:  "0 8 * * *",
+			},
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Addon: v1alpha1.Addon{
+				Params:    []v1alpha1.Param{{Name: "clusterTasks", Value: "false"}, {Name: "pipelineTemplates", Value: "true"}},
+				EnablePAC: ptr.Bool(false),
+			},
+			Hub: v1alpha1.Hub{
+				Params: []v1alpha1.Param{{Name: "enable-devconsole-integration", Value: "true"}},
+			},
+			Pipeline: v1alpha1Pipeline(),
+			Trigger: v1alpha1.Trigger{
+				TriggersProperties: v1alpha1.TriggersProperties{EnableApiFields: "stable"},
+			},
+			Chain:  v1alpha1Chain(),
+			Result: v1alpha1Result(),
+			Dashboard: v1alpha1.Dashboard{
+				DashboardProperties: v1alpha1.DashboardProperties{Readonly: true},
+			},
+			Params: []v1alpha1.Param{{Name: "createRbacResource", Value: "false"}},
+			TargetNamespaceMetadata: &v1alpha1.NamespaceMetadata{
+				Labels: map[string]string{"foo": "bar"},
+			},
+		},
+		Status: v1alpha1.TektonConfigStatus{
+			Version: "v0.80.0",
+			Components: []v1alpha1.ComponentStatus{{
+				Kind:  v1alpha1.KindTektonPipeline,
+				Ready: corev1.ConditionTrue,
+			}},
+		},
+	}
+
+	roundTripFromV1alpha1(t, in, &v1alpha1.TektonConfig{}, &TektonConfig{})
+}
+
+func TestTektonConfigConversionFromV1beta1(t *testing.T) {
+	in := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			Profile:    v1alpha1.ProfileBasic,
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Addon:      Addon{Params: map[string]string{"pipelineTemplates": "true"}},
+			Pipeline: Pipeline{
+				FeatureFlags: PipelineFeatureFlags{EnableAPIFields: "beta"},
+				Params:       map[string]string{"enableMetrics": "true"},
+			},
+			Chain: Chain{
+				Artifacts: ChainArtifacts{TaskRun: ChainArtifact{Format: "in-toto"}},
+			},
+			Result: Result{
+				Database: ResultsDatabase{External: true},
+			},
+			Params: map[string]string{"createRbacResource": "false"},
+		},
+	}
+
+	roundTripFromV1beta1(t, in, &TektonConfig{}, &v1alpha1.TektonConfig{})
+}
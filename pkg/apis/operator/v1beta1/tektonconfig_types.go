@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonConfig is the Schema for the TektonConfigs API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
+type TektonConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TektonConfigSpec            `json:"spec,omitempty"`
+	Status v1alpha1.TektonConfigStatus `json:"status,omitempty"`
+}
+
+// TektonConfigSpec defines the desired state of TektonConfig
+type TektonConfigSpec struct {
+	Profile string `json:"profile,omitempty"`
+	// Config holds the configuration for resources created by TektonConfig
+	// +optional
+	Config v1alpha1.Config `json:"config,omitempty"`
+	// Pruner holds the prune config
+	// +optional
+	Pruner v1alpha1.Prune `json:"pruner,omitempty"`
+	// TektonPruner holds the event based pruner config
+	// +optional
+	TektonPruner Pruner `json:"tektonPruner,omitempty"`
+	// To enable Pipeline Scheduling on Single Cluster or Multiple Clusters
+	// +optional
+	Scheduler           Scheduler `json:"scheduler,omitempty"`
+	v1alpha1.CommonSpec `json:",inline"`
+	// Addon holds the addons config
+	// +optional
+	Addon Addon `json:"addon,omitempty"`
+	// Hub holds the hub config
+	// +optional
+	Hub Hub `json:"hub,omitempty"`
+	// Pipeline holds the customizable option for pipeline component
+	// +optional
+	Pipeline Pipeline `json:"pipeline,omitempty"`
+	// Trigger holds the customizable option for triggers component
+	// +optional
+	Trigger Trigger `json:"trigger,omitempty"`
+	// Chain holds the customizable option for chains component
+	// +optional
+	Chain Chain `json:"chain,omitempty"`
+	// Result holds the customize option for results component
+	// +optional
+	Result Result `json:"result,omitempty"`
+	// Dashboard holds the customizable options for dashboards component
+	// +optional
+	Dashboard Dashboard `json:"dashboard,omitempty"`
+	// MulticlusterProxyAAE holds the customizable options for the multicluster-proxy-aae component
+	// +optional
+	MulticlusterProxyAAE v1alpha1.MulticlusterProxyAAEOptions `json:"multiclusterProxyAAE,omitempty"`
+	// Params are passed for all platforms
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+	// Platforms allows configuring platform specific configurations
+	// +optional
+	Platforms v1alpha1.Platforms `json:"platforms,omitempty"`
+	// holds target namespace metadata
+	// +optional
+	TargetNamespaceMetadata *v1alpha1.NamespaceMetadata `json:"targetNamespaceMetadata,omitempty"`
+	// NetworkPolicy configures NetworkPolicy resources for the operand namespace.
+	// +optional
+	NetworkPolicy v1alpha1.NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// Rollback configures the automatic rollback of components which are not
+	// ready within a deadline after an upgrade
+	// +optional
+	Rollback v1alpha1.Rollback `json:"rollback,omitempty"`
+	// Verification configures the smoke test run after an install or an
+	// upgrade, its outcome is reported in the Verified condition
+	// +optional
+	Verification v1alpha1.Verification `json:"verification,omitempty"`
+	// TLS selects how the TLS certificates of the Results API and of the
+	// scheduler webhook are issued
+	// +optional
+	TLS v1alpha1.TLSConfig `json:"tls,omitempty"`
+	// ResultsGuard keeps the pruners from deleting the runs not yet stored in
+	// Tekton Results, when TektonResult is enabled
+	// +optional
+	ResultsGuard v1alpha1.ResultsGuard `json:"resultsGuard,omitempty"`
+	// PipelineNetworkPolicy generates NetworkPolicies restricting the network
+	// access of the TaskRun pods in the selected namespaces
+	// +optional
+	PipelineNetworkPolicy v1alpha1.PipelineNetworkPolicy `json:"pipelineNetworkPolicy,omitempty"`
+}
+
+// Addon defines the fields to customize the addons
+type Addon struct {
+	// Params are passed for Addon customization
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+	// Deprecated, will be removed in further release
+	// EnablePAC field defines whether to install PAC
+	// +optional
+	EnablePAC *bool `json:"enablePipelinesAsCode,omitempty"`
+}
+
+// Hub defines the fields to customize Hub
+type Hub struct {
+	// Params are passed for Hub customization
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+	// options holds additions fields and these fields will be updated on the manifests
+	// +optional
+	Options v1alpha1.AdditionalOptions `json:"options,omitempty"`
+}
+
+// TektonConfigList contains a list of TektonConfig
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonConfig `json:"items"`
+}
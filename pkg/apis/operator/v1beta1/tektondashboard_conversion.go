@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonDashboard)(nil)
+
+// ConvertTo implements apis.Convertible
+func (td *TektonDashboard) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonDashboard:
+		sink.ObjectMeta = td.ObjectMeta
+		sink.Spec.CommonSpec = td.Spec.CommonSpec
+		sink.Spec.Config = td.Spec.Config
+		sink.Spec.Dashboard = td.Spec.Dashboard.convertTo()
+		sink.Status = td.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (td *TektonDashboard) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonDashboard:
+		td.ObjectMeta = source.ObjectMeta
+		td.Spec.CommonSpec = source.Spec.CommonSpec
+		td.Spec.Config = source.Spec.Config
+		td.Spec.Dashboard.convertFrom(source.Spec.Dashboard)
+		td.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (d *Dashboard) convertFrom(source v1alpha1.Dashboard) {
+	d.Readonly = source.Readonly
+	d.ExternalLogs = source.ExternalLogs
+	d.Ingress = source.Ingress
+	d.Options = source.Options
+}
+
+func (d *Dashboard) convertTo() v1alpha1.Dashboard {
+	return v1alpha1.Dashboard{
+		DashboardProperties: v1alpha1.DashboardProperties{
+			Readonly:     d.Readonly,
+			ExternalLogs: d.ExternalLogs,
+			Ingress:      d.Ingress,
+		},
+		Options: d.Options,
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonDashboard is the Schema for the tektondashboards API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
+type TektonDashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TektonDashboardSpec            `json:"spec,omitempty"`
+	Status v1alpha1.TektonDashboardStatus `json:"status,omitempty"`
+}
+
+// TektonDashboardSpec defines the desired state of TektonDashboard
+type TektonDashboardSpec struct {
+	v1alpha1.CommonSpec `json:",inline"`
+	Dashboard           `json:",inline"`
+	// Config holds the configuration for resources created by TektonDashboard
+	// +optional
+	Config v1alpha1.Config `json:"config,omitempty"`
+}
+
+// Dashboard defines the fields to customize the Dashboard component
+type Dashboard struct {
+	// Readonly when set to true configures the Tekton dashboard in read-only mode
+	Readonly bool `json:"readonly"`
+	// +optional
+	ExternalLogs string `json:"externalLogs,omitempty"`
+	// Ingress exposes the Dashboard with an Ingress or an HTTPRoute on Kubernetes
+	// +optional
+	Ingress v1alpha1.IngressConfig `json:"ingress,omitempty"`
+	// options holds additions fields and these fields will be updated on the manifests
+	// +optional
+	Options v1alpha1.AdditionalOptions `json:"options"`
+}
+
+// TektonDashboardList contains a list of TektonDashboard
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonDashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonDashboard `json:"items"`
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonPipeline)(nil)
+
+// ConvertTo implements apis.Convertible
+func (tp *TektonPipeline) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonPipeline:
+		fields, err := getV1alpha1Fields(tp.ObjectMeta)
+		if err != nil {
+			return err
+		}
+		sink.ObjectMeta = tp.ObjectMeta
+		removeV1alpha1Fields(&sink.ObjectMeta)
+		sink.Spec.CommonSpec = tp.Spec.CommonSpec
+		sink.Spec.Config = tp.Spec.Config
+		sink.Spec.NetworkPolicy = tp.Spec.NetworkPolicy
+		if sink.Spec.Pipeline, err = tp.Spec.Pipeline.convertTo(fields); err != nil {
+			return err
+		}
+		sink.Status = tp.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (tp *TektonPipeline) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonPipeline:
+		fields := v1alpha1Fields{}
+		tp.ObjectMeta = source.ObjectMeta
+		tp.Spec.CommonSpec = source.Spec.CommonSpec
+		tp.Spec.Config = source.Spec.Config
+		tp.Spec.NetworkPolicy = source.Spec.NetworkPolicy
+		tp.Spec.Pipeline.convertFrom(source.Spec.Pipeline, &fields)
+		tp.Status = source.Status
+		return setV1alpha1Fields(&tp.ObjectMeta, fields)
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (p *Pipeline) convertFrom(source v1alpha1.Pipeline, fields *v1alpha1Fields) {
+	in := source.PipelineProperties
+
+	p.FeatureFlags = PipelineFeatureFlags{
+		DisableCredsInit:                         in.DisableCredsInit,
+		AwaitSidecarReadiness:                    in.AwaitSidecarReadiness,
+		RunningInEnvironmentWithInjectedSidecars: in.RunningInEnvironmentWithInjectedSidecars,
+		RequireGitSSHSecretKnownHosts:            in.RequireGitSshSecretKnownHosts,
+		EnableCustomTasks:                        in.EnableCustomTasks,
+		EnableAPIFields:                          in.EnableApiFields,
+		EmbeddedStatus:                           in.EmbeddedStatus,
+		SendCloudEventsForRuns:                   in.SendCloudEventsForRuns,
+		VerificationNoMatchPolicy:                in.VerificationNoMatchPolicy,
+		EnableProvenanceInStatus:                 in.EnableProvenanceInStatus,
+		EnforceNonfalsifiability:                 in.EnforceNonfalsifiability,
+		KeepPodOnCancel:                          in.EnableKeepPodOnCancel,
+		ResultsFrom:                              in.ResultExtractionMethod,
+		MaxResultSize:                            in.MaxResultSize,
+		SetSecurityContext:                       in.SetSecurityContext,
+		Coschedule:                               in.Coschedule,
+		EnableCELInWhenExpression:                in.EnableCELInWhenExpression,
+		EnableStepActions:                        in.EnableStepActions,
+		EnableParamEnum:                          in.EnableParamEnum,
+		DisableInlineSpec:                        in.DisableInlineSpec,
+	}
+	fields.EnableTektonOciBundles = in.EnableTektonOciBundles
+	fields.VerificationMode = in.VerificationMode
+	fields.ScopeWhenExpressionsToTask = in.ScopeWhenExpressionsToTask
+	fields.DisableAffinityAssistant = in.DisableAffinityAssistant
+
+	p.Defaults = PipelineDefaults{
+		TimeoutMinutes:             in.DefaultTimeoutMinutes,
+		ServiceAccount:             in.DefaultServiceAccount,
+		ManagedByLabelValue:        in.DefaultManagedByLabelValue,
+		CloudEventsSink:            in.DefaultCloudEventsSink,
+		TaskRunWorkspaceBinding:    in.DefaultTaskRunWorkspaceBinding,
+		MaxMatrixCombinationsCount: in.DefaultMaxMatrixCombinationsCount,
+		ForbiddenEnv:               in.DefaultForbiddenEnv,
+		ResolverType:               in.DefaultResolverType,
+	}
+	p.Defaults.PodTemplate, fields.DefaultPodTemplate = yamlToObject[pod.Template](in.DefaultPodTemplate)
+	p.Defaults.AffinityAssistantPodTemplate, fields.DefaultAffinityAssistantPodTemplate = yamlToObject[pod.AffinityAssistantTemplate](in.DefaultAffinityAssistantPodTemplate)
+
+	p.Metrics = PipelineMetrics{
+		TaskRunLevel:            in.MetricsTaskrunLevel,
+		TaskRunDurationType:     in.MetricsTaskrunDurationType,
+		PipelineRunLevel:        in.MetricsPipelinerunLevel,
+		PipelineRunDurationType: in.MetricsPipelinerunDurationType,
+		CountWithReason:         in.CountWithReason,
+	}
+	p.Tracing = PipelineTracing{
+		Enabled:           in.Enabled,
+		Endpoint:          in.Endpoint,
+		CredentialsSecret: in.CredentialsSecret,
+	}
+	p.Resolvers = PipelineResolvers{
+		Bundles: PipelineResolver{Enabled: in.EnableBundlesResolver, Config: in.BundlesResolverConfig},
+		Hub:     PipelineResolver{Enabled: in.EnableHubResolver, Config: in.HubResolverConfig},
+		Git:     PipelineResolver{Enabled: in.EnableGitResolver, Config: in.GitResolverConfig},
+		Cluster: PipelineResolver{Enabled: in.EnableClusterResolver, Config: in.ClusterResolverConfig},
+	}
+	p.Performance = in.Performance
+	p.Params = paramsToMap(source.Params)
+	p.Options = source.Options
+}
+
+func (p *Pipeline) convertTo(fields v1alpha1Fields) (v1alpha1.Pipeline, error) {
+	podTemplate, err := objectToYAML(p.Defaults.PodTemplate, fields.DefaultPodTemplate)
+	if err != nil {
+		return v1alpha1.Pipeline{}, err
+	}
+	affinityAssistantPodTemplate, err := objectToYAML(p.Defaults.AffinityAssistantPodTemplate, fields.DefaultAffinityAssistantPodTemplate)
+	if err != nil {
+		return v1alpha1.Pipeline{}, err
+	}
+
+	return v1alpha1.Pipeline{
+		PipelineProperties: v1alpha1.PipelineProperties{
+			DisableCredsInit:                         p.FeatureFlags.DisableCredsInit,
+			AwaitSidecarReadiness:                    p.FeatureFlags.AwaitSidecarReadiness,
+			RunningInEnvironmentWithInjectedSidecars: p.FeatureFlags.RunningInEnvironmentWithInjectedSidecars,
+			RequireGitSshSecretKnownHosts:            p.FeatureFlags.RequireGitSSHSecretKnownHosts,
+			EnableCustomTasks:                        p.FeatureFlags.EnableCustomTasks,
+			EnableApiFields:                          p.FeatureFlags.EnableAPIFields,
+			EmbeddedStatus:                           p.FeatureFlags.EmbeddedStatus,
+			SendCloudEventsForRuns:                   p.FeatureFlags.SendCloudEventsForRuns,
+			EnableTektonOciBundles:                   fields.EnableTektonOciBundles,
+			VerificationMode:                         fields.VerificationMode,
+			VerificationNoMatchPolicy:                p.FeatureFlags.VerificationNoMatchPolicy,
+			EnableProvenanceInStatus:                 p.FeatureFlags.EnableProvenanceInStatus,
+			ScopeWhenExpressionsToTask:               fields.ScopeWhenExpressionsToTask,
+			DisableAffinityAssistant:                 fields.DisableAffinityAssistant,
+			EnforceNonfalsifiability:                 p.FeatureFlags.EnforceNonfalsifiability,
+			EnableKeepPodOnCancel:                    p.FeatureFlags.KeepPodOnCancel,
+			ResultExtractionMethod:                   p.FeatureFlags.ResultsFrom,
+			MaxResultSize:                            p.FeatureFlags.MaxResultSize,
+			SetSecurityContext:                       p.FeatureFlags.SetSecurityContext,
+			Coschedule:                               p.FeatureFlags.Coschedule,
+			EnableCELInWhenExpression:                p.FeatureFlags.EnableCELInWhenExpression,
+			EnableStepActions:                        p.FeatureFlags.EnableStepActions,
+			EnableParamEnum:                          p.FeatureFlags.EnableParamEnum,
+			DisableInlineSpec:                        p.FeatureFlags.DisableInlineSpec,
+			PipelineMetricsProperties: v1alpha1.PipelineMetricsProperties{
+				MetricsTaskrunLevel:            p.Metrics.TaskRunLevel,
+				MetricsTaskrunDurationType:     p.Metrics.TaskRunDurationType,
+				MetricsPipelinerunLevel:        p.Metrics.PipelineRunLevel,
+				MetricsPipelinerunDurationType: p.Metrics.PipelineRunDurationType,
+				CountWithReason:                p.Metrics.CountWithReason,
+			},
+			TracingProperties: v1alpha1.TracingProperties{
+				Enabled:           p.Tracing.Enabled,
+				Endpoint:          p.Tracing.Endpoint,
+				CredentialsSecret: p.Tracing.CredentialsSecret,
+			},
+			OptionalPipelineProperties: v1alpha1.OptionalPipelineProperties{
+				DefaultTimeoutMinutes:               p.Defaults.TimeoutMinutes,
+				DefaultServiceAccount:               p.Defaults.ServiceAccount,
+				DefaultManagedByLabelValue:          p.Defaults.ManagedByLabelValue,
+				DefaultPodTemplate:                  podTemplate,
+				DefaultCloudEventsSink:              p.Defaults.CloudEventsSink,
+				DefaultAffinityAssistantPodTemplate: affinityAssistantPodTemplate,
+				DefaultTaskRunWorkspaceBinding:      p.Defaults.TaskRunWorkspaceBinding,
+				DefaultMaxMatrixCombinationsCount:   p.Defaults.MaxMatrixCombinationsCount,
+				DefaultForbiddenEnv:                 p.Defaults.ForbiddenEnv,
+				DefaultResolverType:                 p.Defaults.ResolverType,
+			},
+			Resolvers: v1alpha1.Resolvers{
+				EnableBundlesResolver: p.Resolvers.Bundles.Enabled,
+				EnableHubResolver:     p.Resolvers.Hub.Enabled,
+				EnableGitResolver:     p.Resolvers.Git.Enabled,
+				EnableClusterResolver: p.Resolvers.Cluster.Enabled,
+				ResolversConfig: v1alpha1.ResolversConfig{
+					BundlesResolverConfig: p.Resolvers.Bundles.Config,
+					HubResolverConfig:     p.Resolvers.Hub.Config,
+					GitResolverConfig:     p.Resolvers.Git.Config,
+					ClusterResolverConfig: p.Resolvers.Cluster.Config,
+				},
+			},
+			Performance: p.Performance,
+		},
+		Params:  mapToParams(p.Params),
+		Options: p.Options,
+	}, nil
+}
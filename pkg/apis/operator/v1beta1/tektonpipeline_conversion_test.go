@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func v1alpha1Pipeline() v1alpha1.Pipeline {
+	return v1alpha1.Pipeline{
+		PipelineProperties: v1alpha1.PipelineProperties{
+			DisableCredsInit:                         ptr.Bool(true),
+			AwaitSidecarReadiness:                    ptr.Bool(false),
+			RunningInEnvironmentWithInjectedSidecars: ptr.Bool(true),
+			RequireGitSshSecretKnownHosts:            ptr.Bool(false),
+			EnableCustomTasks:                        ptr.Bool(true),
+			EnableApiFields:                          "beta",
+			EmbeddedStatus:                           "minimal",
+			SendCloudEventsForRuns:                   ptr.Bool(false),
+			EnableTektonOciBundles:                   ptr.Bool(true),
+			VerificationMode:                         "enforce",
+			VerificationNoMatchPolicy:                "ignore",
+			EnableProvenanceInStatus:                 ptr.Bool(true),
+			ScopeWhenExpressionsToTask:               ptr.Bool(false),
+			DisableAffinityAssistant:                 ptr.Bool(true),
+			EnforceNonfalsifiability:                 "spire",
+			EnableKeepPodOnCancel:                    ptr.Bool(true),
+			ResultExtractionMethod:                   "sidecar-logs",
+			MaxResultSize:                            ptr.Int32(8192),
+			SetSecurityContext:                       ptr.Bool(true),
+			Coschedule:                               "pipelineruns",
+			EnableCELInWhenExpression:                ptr.Bool(true),
+			EnableStepActions:                        ptr.Bool(true),
+			EnableParamEnum:                          ptr.Bool(true),
+			DisableInlineSpec:                        "taskrun",
+			PipelineMetricsProperties: v1alpha1.PipelineMetricsProperties{
+				MetricsTaskrunLevel:            "task",
+				MetricsTaskrunDurationType:     "histogram",
+				MetricsPipelinerunLevel:        "pipeline",
+				MetricsPipelinerunDurationType: "lastvalue",
+				CountWithReason:                ptr.Bool(true),
+			},
+			TracingProperties: v1alpha1.TracingProperties{
+				Enabled:           ptr.Bool(true),
+				Endpoint:          "http://jaeger:14268/api/traces",
+				CredentialsSecret: "tracing-creds",
+			},
+			OptionalPipelineProperties: v1alpha1.OptionalPipelineProperties{
+				DefaultTimeoutMinutes:               uintPtr(30),
+				DefaultServiceAccount:               "pipeline",
+				DefaultManagedByLabelValue:          "tekton",
+				DefaultPodTemplate:                  "nodeSelector: {kubernetes.io/os: linux}",
+				DefaultCloudEventsSink:              "http://sink",
+				DefaultAffinityAssistantPodTemplate: "nodeSelector:\n  disktype: ssd\n",
+				DefaultTaskRunWorkspaceBinding:      "emptyDir: {}",
+				DefaultMaxMatrixCombinationsCount:   "256",
+				DefaultForbiddenEnv:                 "TEKTON_SECRET",
+				DefaultResolverType:                 "git",
+			},
+			Resolvers: v1alpha1.Resolvers{
+				EnableBundlesResolver: ptr.Bool(true),
+				EnableHubResolver:     ptr.Bool(false),
+				EnableGitResolver:     ptr.Bool(true),
+				EnableClusterResolver: ptr.Bool(true),
+				ResolversConfig: v1alpha1.ResolversConfig{
+					BundlesResolverConfig: map[string]string{"default-kind": "task"},
+					HubResolverConfig:     map[string]string{"default-tekton-hub-catalog": "tekton"},
+					GitResolverConfig:     map[string]string{"fetch-timeout": "1m"},
+					ClusterResolverConfig: map[string]string{"default-namespace": "default"},
+				},
+			},
+			Performance: v1alpha1.PerformanceProperties{
+				PerformanceLeaderElectionConfig: v1alpha1.PerformanceLeaderElectionConfig{
+					Buckets: uintPtr(2),
+				},
+			},
+		},
+		Params: []v1alpha1.Param{
+			{Name: "enableMetrics", Value: "true"},
+			{Name: "webhook-enabled", Value: "false"},
+		},
+		Options: v1alpha1.AdditionalOptions{
+			Disabled: ptr.Bool(false),
+		},
+	}
+}
+
+func TestTektonPipelineConversion(t *testing.T) {
+	in := &v1alpha1.TektonPipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pipeline",
+			Annotations: map[string]string{"foo": "bar"},
+		},
+		Spec: v1alpha1.TektonPipelineSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pipeline:   v1alpha1Pipeline(),
+			Config: v1alpha1.Config{
+				NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+			},
+			NetworkPolicy: v1alpha1.NetworkPolicyConfig{Disabled: true},
+		},
+		Status: v1alpha1.TektonPipelineStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}},
+			},
+			Version: "v1.0.0",
+		},
+	}
+
+	roundTripFromV1alpha1(t, in, &v1alpha1.TektonPipeline{}, &TektonPipeline{})
+}
+
+func TestTektonPipelineConversionFromV1beta1(t *testing.T) {
+	in := &TektonPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "pipeline"},
+		Spec: TektonPipelineSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pipeline: Pipeline{
+				FeatureFlags: PipelineFeatureFlags{
+					EnableAPIFields: "beta",
+					ResultsFrom:     "termination-message",
+				},
+				Defaults: PipelineDefaults{
+					PodTemplate: &pod.Template{
+						NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+						Tolerations:  []corev1.Toleration{{Key: "tekton", Operator: corev1.TolerationOpExists}},
+					},
+					AffinityAssistantPodTemplate: &pod.AffinityAssistantTemplate{
+						NodeSelector: map[string]string{"disktype": "ssd"},
+					},
+				},
+				Resolvers: PipelineResolvers{
+					Git: PipelineResolver{Enabled: ptr.Bool(true), Config: map[string]string{"fetch-timeout": "1m"}},
+				},
+				Params: map[string]string{"enableMetrics": "true"},
+			},
+		},
+	}
+
+	roundTripFromV1beta1(t, in, &TektonPipeline{}, &v1alpha1.TektonPipeline{})
+}
+
+func TestTektonPipelineConversionV1alpha1Fields(t *testing.T) {
+	in := &v1alpha1.TektonPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "pipeline"},
+		Spec: v1alpha1.TektonPipelineSpec{
+			Pipeline: v1alpha1.Pipeline{
+				PipelineProperties: v1alpha1.PipelineProperties{
+					EnableTektonOciBundles: ptr.Bool(true),
+					OptionalPipelineProperties: v1alpha1.OptionalPipelineProperties{
+						DefaultPodTemplate: "nodeSelector: [",
+					},
+				},
+			},
+		},
+	}
+	got := &TektonPipeline{}
+	if err := got.ConvertFrom(context.Background(), in); err != nil {
+		t.Fatalf("ConvertFrom() = %v", err)
+	}
+	if got.Spec.Defaults.PodTemplate != nil {
+		t.Errorf("PodTemplate = %v, want nil for invalid YAML", got.Spec.Defaults.PodTemplate)
+	}
+	want := `{"enable-tekton-oci-bundles":true,"default-pod-template":"nodeSelector: ["}`
+	if annotation := got.Annotations[V1alpha1FieldsAnnotation]; annotation != want {
+		t.Errorf("annotation = %s, want %s", annotation, want)
+	}
+
+	got.Annotations[V1alpha1FieldsAnnotation] = "{"
+	if err := got.ConvertTo(context.Background(), &v1alpha1.TektonPipeline{}); err == nil {
+		t.Error("ConvertTo() expected an error for an invalid annotation")
+	}
+}
+
+func TestTektonPipelineConversionBadType(t *testing.T) {
+	good, bad := &TektonPipeline{}, &TektonTrigger{}
+
+	if err := good.ConvertTo(context.Background(), bad); err == nil {
+		t.Errorf("ConvertTo() = %#v, wanted error", bad)
+	}
+
+	if err := good.ConvertFrom(context.Background(), bad); err == nil {
+		t.Errorf("ConvertFrom() = %#v, wanted error", good)
+	}
+}
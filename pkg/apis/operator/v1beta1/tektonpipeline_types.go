@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonPipeline is the Schema for the tektonpipelines API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
+type TektonPipeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TektonPipelineSpec            `json:"spec,omitempty"`
+	Status v1alpha1.TektonPipelineStatus `json:"status,omitempty"`
+}
+
+// TektonPipelineSpec defines the desired state of TektonPipeline
+type TektonPipelineSpec struct {
+	v1alpha1.CommonSpec `json:",inline"`
+	Pipeline            `json:",inline"`
+	// Config holds the configuration for resources created by TektonPipeline
+	// +optional
+	Config v1alpha1.Config `json:"config,omitempty"`
+	// NetworkPolicy configures NetworkPolicy creation for the proxy-webhook
+	// workload deployed by TektonPipeline.
+	// +optional
+	NetworkPolicy v1alpha1.NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+}
+
+// Pipeline defines the fields to customize the Pipeline component
+type Pipeline struct {
+	// FeatureFlags holds the settings rendered into the feature-flags ConfigMap
+	// +optional
+	FeatureFlags PipelineFeatureFlags `json:"featureFlags,omitempty"`
+	// Defaults holds the settings rendered into the config-defaults ConfigMap
+	// +optional
+	Defaults PipelineDefaults `json:"defaults,omitempty"`
+	// Metrics holds the settings rendered into the config-observability ConfigMap
+	// +optional
+	Metrics PipelineMetrics `json:"metrics,omitempty"`
+	// Tracing holds the settings rendered into the config-tracing ConfigMap
+	// +optional
+	Tracing PipelineTracing `json:"tracing,omitempty"`
+	// Resolvers enables and configures the built-in remote resolvers
+	// +optional
+	Resolvers PipelineResolvers `json:"resolvers,omitempty"`
+	// +optional
+	Performance v1alpha1.PerformanceProperties `json:"performance,omitempty"`
+	// The params to customize different components of Pipelines
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+	// options holds additions fields and these fields will be updated on the manifests
+	// +optional
+	Options v1alpha1.AdditionalOptions `json:"options"`
+}
+
+// PipelineFeatureFlags defines the feature flags of the Pipeline component
+type PipelineFeatureFlags struct {
+	DisableCredsInit                         *bool  `json:"disableCredsInit,omitempty"`
+	AwaitSidecarReadiness                    *bool  `json:"awaitSidecarReadiness,omitempty"`
+	RunningInEnvironmentWithInjectedSidecars *bool  `json:"runningInEnvironmentWithInjectedSidecars,omitempty"`
+	RequireGitSSHSecretKnownHosts            *bool  `json:"requireGitSSHSecretKnownHosts,omitempty"`
+	EnableCustomTasks                        *bool  `json:"enableCustomTasks,omitempty"`
+	EnableAPIFields                          string `json:"enableAPIFields,omitempty"`
+	EmbeddedStatus                           string `json:"embeddedStatus,omitempty"`
+	// Deprecated: only affects CustomRuns, see send-cloudevents-for-runs in v1alpha1
+	SendCloudEventsForRuns    *bool  `json:"sendCloudEventsForRuns,omitempty"`
+	VerificationNoMatchPolicy string `json:"verificationNoMatchPolicy,omitempty"`
+	EnableProvenanceInStatus  *bool  `json:"enableProvenanceInStatus,omitempty"`
+	EnforceNonfalsifiability  string `json:"enforceNonfalsifiability,omitempty"`
+	KeepPodOnCancel           *bool  `json:"keepPodOnCancel,omitempty"`
+	ResultsFrom               string `json:"resultsFrom,omitempty"`
+	MaxResultSize             *int32 `json:"maxResultSize,omitempty"`
+	SetSecurityContext        *bool  `json:"setSecurityContext,omitempty"`
+	Coschedule                string `json:"coschedule,omitempty"`
+	EnableCELInWhenExpression *bool  `json:"enableCELInWhenExpression,omitempty"`
+	EnableStepActions         *bool  `json:"enableStepActions,omitempty"`
+	EnableParamEnum           *bool  `json:"enableParamEnum,omitempty"`
+	DisableInlineSpec         string `json:"disableInlineSpec,omitempty"`
+}
+
+// PipelineDefaults defines the defaults applied by the Pipeline component
+type PipelineDefaults struct {
+	TimeoutMinutes      *uint  `json:"timeoutMinutes,omitempty"`
+	ServiceAccount      string `json:"serviceAccount,omitempty"`
+	ManagedByLabelValue string `json:"managedByLabelValue,omitempty"`
+	// PodTemplate is the default pod template of TaskRuns
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	PodTemplate     *pod.Template `json:"podTemplate,omitempty"`
+	CloudEventsSink string        `json:"cloudEventsSink,omitempty"`
+	// AffinityAssistantPodTemplate is the default pod template of affinity assistants
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	AffinityAssistantPodTemplate *pod.AffinityAssistantTemplate `json:"affinityAssistantPodTemplate,omitempty"`
+	TaskRunWorkspaceBinding      string                         `json:"taskRunWorkspaceBinding,omitempty"`
+	MaxMatrixCombinationsCount   string                         `json:"maxMatrixCombinationsCount,omitempty"`
+	ForbiddenEnv                 string                         `json:"forbiddenEnv,omitempty"`
+	ResolverType                 string                         `json:"resolverType,omitempty"`
+}
+
+// PipelineMetrics defines the metrics settings of the Pipeline component
+type PipelineMetrics struct {
+	TaskRunLevel            string `json:"taskRunLevel,omitempty"`
+	TaskRunDurationType     string `json:"taskRunDurationType,omitempty"`
+	PipelineRunLevel        string `json:"pipelineRunLevel,omitempty"`
+	PipelineRunDurationType string `json:"pipelineRunDurationType,omitempty"`
+	CountWithReason         *bool  `json:"countWithReason,omitempty"`
+}
+
+// PipelineTracing defines the tracing settings of the Pipeline component
+type PipelineTracing struct {
+	// Enabled controls whether tracing is enabled or not
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Endpoint is the URL for the OpenTelemetry trace collector
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// CredentialsSecret is the name of the secret containing credentials for the tracing endpoint
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// PipelineResolvers defines the resolvers settings of the Pipeline component
+type PipelineResolvers struct {
+	Bundles PipelineResolver `json:"bundles,omitempty"`
+	Hub     PipelineResolver `json:"hub,omitempty"`
+	Git     PipelineResolver `json:"git,omitempty"`
+	Cluster PipelineResolver `json:"cluster,omitempty"`
+}
+
+// PipelineResolver enables and configures a single resolver
+type PipelineResolver struct {
+	Enabled *bool             `json:"enabled,omitempty"`
+	Config  map[string]string `json:"config,omitempty"`
+}
+
+// TektonPipelineList contains a list of TektonPipeline
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonPipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonPipeline `json:"items"`
+}
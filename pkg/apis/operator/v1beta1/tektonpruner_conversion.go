@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonPruner)(nil)
+
+// ConvertTo implements apis.Convertible
+func (tp *TektonPruner) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonPruner:
+		sink.ObjectMeta = tp.ObjectMeta
+		sink.Spec.CommonSpec = tp.Spec.CommonSpec
+		sink.Spec.Config = tp.Spec.Config
+		sink.Spec.NetworkPolicy = tp.Spec.NetworkPolicy
+		sink.Spec.Pruner = tp.Spec.Pruner.convertTo()
+		sink.Status = tp.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (tp *TektonPruner) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonPruner:
+		tp.ObjectMeta = source.ObjectMeta
+		tp.Spec.CommonSpec = source.Spec.CommonSpec
+		tp.Spec.Config = source.Spec.Config
+		tp.Spec.NetworkPolicy = source.Spec.NetworkPolicy
+		tp.Spec.Pruner.convertFrom(source.Spec.Pruner)
+		tp.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (p *Pruner) convertFrom(source v1alpha1.Pruner) {
+	p.Disabled = source.Disabled
+	p.GlobalConfig = source.GlobalConfig
+	p.Options = source.Options
+	p.DryRun = source.DryRun
+}
+
+func (p *Pruner) convertTo() v1alpha1.Pruner {
+	return v1alpha1.Pruner{
+		Disabled:           p.Disabled,
+		TektonPrunerConfig: v1alpha1.TektonPrunerConfig{GlobalConfig: p.GlobalConfig},
+		Options:            p.Options,
+		DryRun:             p.DryRun,
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pruner/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestTektonPrunerConversion(t *testing.T) {
+	in := &v1alpha1.TektonPruner{
+		ObjectMeta: metav1.ObjectMeta{Name: "pruner"},
+		Spec: v1alpha1.TektonPrunerSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner: v1alpha1.Pruner{
+				Disabled: ptr.To(false),
+				TektonPrunerConfig: v1alpha1.TektonPrunerConfig{
+					GlobalConfig: &config.GlobalConfig{
+						PrunerConfig: config.PrunerConfig{
+							TTLSecondsAfterFinished: ptr.To(int32(3600)),
+							HistoryLimit:            ptr.To(int32(10)),
+						},
+					},
+				},
+				DryRun: true,
+			},
+		},
+	}
+
+	roundTripFromV1alpha1(t, in, &v1alpha1.TektonPruner{}, &TektonPruner{})
+}
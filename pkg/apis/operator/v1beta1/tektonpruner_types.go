@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pruner/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonPruner is the Schema for the tektonpruners API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
+type TektonPruner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TektonPrunerSpec            `json:"spec,omitempty"`
+	Status v1alpha1.TektonPrunerStatus `json:"status,omitempty"`
+}
+
+// TektonPrunerSpec defines the desired state of TektonPruner
+type TektonPrunerSpec struct {
+	v1alpha1.CommonSpec `json:",inline"`
+	Pruner              `json:",inline"`
+	// Config holds the configuration for resources created by TektonPruner
+	// +optional
+	Config v1alpha1.Config `json:"config,omitempty"`
+	// NetworkPolicy configures NetworkPolicy creation for the controller
+	// and webhook workloads deployed by TektonPruner.
+	// +optional
+	NetworkPolicy v1alpha1.NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+}
+
+// Pruner defines the fields to customize the event based pruner
+type Pruner struct {
+	// enable or disable TektonPruner Component
+	Disabled     *bool `json:"disabled"`
+	PrunerConfig `json:",inline"`
+	// options holds additions fields and these fields will be updated on the manifests
+	// +optional
+	Options v1alpha1.AdditionalOptions `json:"options"`
+	// DryRun stops the pruner controller, the operator reports the runs which
+	// the global config would prune instead
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// PrunerConfig holds the global config of the event based pruner
+type PrunerConfig struct {
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	GlobalConfig *config.GlobalConfig `json:"globalConfig,omitempty"`
+}
+
+func (in *PrunerConfig) DeepCopyInto(out *PrunerConfig) {
+	*out = *in
+	if in.GlobalConfig != nil {
+		out.GlobalConfig = new(config.GlobalConfig)
+		*out.GlobalConfig = *in.GlobalConfig
+	}
+}
+
+// TektonPrunerList contains a list of TektonPruner
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonPrunerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonPruner `json:"items"`
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonResult)(nil)
+
+// ConvertTo implements apis.Convertible
+func (tr *TektonResult) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonResult:
+		sink.ObjectMeta = tr.ObjectMeta
+		sink.Spec.CommonSpec = tr.Spec.CommonSpec
+		sink.Spec.Config = tr.Spec.Config
+		sink.Spec.NetworkPolicy = tr.Spec.NetworkPolicy
+		sink.Spec.TLS = tr.Spec.TLS
+		sink.Spec.Result = tr.Spec.Result.convertTo()
+		sink.Status = tr.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (tr *TektonResult) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonResult:
+		tr.ObjectMeta = source.ObjectMeta
+		tr.Spec.CommonSpec = source.Spec.CommonSpec
+		tr.Spec.Config = source.Spec.Config
+		tr.Spec.NetworkPolicy = source.Spec.NetworkPolicy
+		tr.Spec.TLS = source.Spec.TLS
+		tr.Spec.Result.convertFrom(source.Spec.Result)
+		tr.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (r *Result) convertFrom(source v1alpha1.Result) {
+	in := source.ResultsAPIProperties
+
+	r.Disabled = source.Disabled
+	r.Database = ResultsDatabase{
+		External:            in.IsExternalDB,
+		Host:                in.DBHost,
+		Port:                in.DBPort,
+		Name:                in.DBName,
+		SSLMode:             in.DBSSLMode,
+		SSLRootCert:         in.DBSSLRootCert,
+		EnableAutoMigration: in.DBEnableAutoMigration,
+		SecretName:          in.DBSecretName,
+		SecretUserKey:       in.DBSecretUserKey,
+		SecretPasswordKey:   in.DBSecretPasswordKey,
+	}
+	r.Server = ResultsServer{
+		Port:                in.ServerPort,
+		LogLevel:            in.LogLevel,
+		TLSHostnameOverride: in.TLSHostnameOverride,
+		Auth:                ResultsAuth{Disable: in.AuthDisable, Impersonate: in.AuthImpersonate},
+		Prometheus:          ResultsPrometheus{Port: in.PrometheusPort, Histogram: in.PrometheusHistogram},
+	}
+	r.Logs = ResultsLogs{
+		API:                 in.LogsAPI,
+		Type:                in.LogsType,
+		BufferSize:          in.LogsBufferSize,
+		Path:                in.LogsPath,
+		PVCName:             in.LoggingPVCName,
+		GCSBucketName:       in.GcsBucketName,
+		StorageEmulatorHost: in.StorageEmulatorHost,
+		S3SecretName:        in.SecretName,
+		GCSCredsSecretName:  in.GCSCredsSecretName,
+		GCSCredsSecretKey:   in.GCSCredsSecretKey,
+	}
+	r.LoggingPlugin = ResultsLoggingPlugin{
+		TLSVerificationDisable: in.LoggingPluginTLSVerificationDisable,
+		ProxyPath:              in.LoggingPluginProxyPath,
+		APIURL:                 in.LoggingPluginAPIURL,
+		TokenPath:              in.LoggingPluginTokenPath,
+		NamespaceKey:           in.LoggingPluginNamespaceKey,
+		StaticLabels:           in.LoggingPluginStaticLabels,
+		CACert:                 in.LoggingPluginCACert,
+		ForwarderDelayDuration: in.LoggingPluginForwarderDelayDuration,
+		QueryLimit:             in.LoggingPluginQueryLimit,
+		QueryParams:            in.LoggingPluginQueryParams,
+		MultipartRegex:         in.LoggingPluginMultipartRegex,
+	}
+	r.Route = ResultsRoute{
+		Enabled:        in.RouteEnabled,
+		Host:           in.RouteHost,
+		Path:           in.RoutePath,
+		TLSTermination: in.RouteTLSTermination,
+		Type:           in.RouteType,
+		ClassName:      in.RouteClassName,
+		Gateway:        in.RouteGateway,
+		TLSSecret:      in.RouteTLSSecret,
+		Annotations:    in.RouteAnnotations,
+	}
+	r.LokiStack = ResultsLokiStack{
+		Name:      source.LokiStackName,
+		Namespace: source.LokiStackNamespace,
+	}
+	r.Watcher = source.Watcher
+	r.Rotation = source.Rotation
+	r.Backup = source.Backup
+	r.RetentionPolicy = source.RetentionPolicy
+	r.Performance = source.Performance
+	r.Options = source.Options
+}
+
+func (r *Result) convertTo() v1alpha1.Result {
+	return v1alpha1.Result{
+		Disabled: r.Disabled,
+		ResultsAPIProperties: v1alpha1.ResultsAPIProperties{
+			DBHost:                              r.Database.Host,
+			DBPort:                              r.Database.Port,
+			DBName:                              r.Database.Name,
+			DBSSLMode:                           r.Database.SSLMode,
+			DBSSLRootCert:                       r.Database.SSLRootCert,
+			DBEnableAutoMigration:               r.Database.EnableAutoMigration,
+			DBSecretName:                        r.Database.SecretName,
+			DBSecretUserKey:                     r.Database.SecretUserKey,
+			DBSecretPasswordKey:                 r.Database.SecretPasswordKey,
+			ServerPort:                          r.Server.Port,
+			PrometheusPort:                      r.Server.Prometheus.Port,
+			PrometheusHistogram:                 r.Server.Prometheus.Histogram,
+			LogLevel:                            r.Server.LogLevel,
+			LogsAPI:                             r.Logs.API,
+			LogsType:                            r.Logs.Type,
+			LogsBufferSize:                      r.Logs.BufferSize,
+			LogsPath:                            r.Logs.Path,
+			TLSHostnameOverride:                 r.Server.TLSHostnameOverride,
+			AuthDisable:                         r.Server.Auth.Disable,
+			AuthImpersonate:                     r.Server.Auth.Impersonate,
+			LoggingPVCName:                      r.Logs.PVCName,
+			GcsBucketName:                       r.Logs.GCSBucketName,
+			StorageEmulatorHost:                 r.Logs.StorageEmulatorHost,
+			SecretName:                          r.Logs.S3SecretName,
+			GCSCredsSecretName:                  r.Logs.GCSCredsSecretName,
+			GCSCredsSecretKey:                   r.Logs.GCSCredsSecretKey,
+			IsExternalDB:                        r.Database.External,
+			LoggingPluginTLSVerificationDisable: r.LoggingPlugin.TLSVerificationDisable,
+			LoggingPluginProxyPath:              r.LoggingPlugin.ProxyPath,
+			LoggingPluginAPIURL:                 r.LoggingPlugin.APIURL,
+			LoggingPluginTokenPath:              r.LoggingPlugin.TokenPath,
+			LoggingPluginNamespaceKey:           r.LoggingPlugin.NamespaceKey,
+			LoggingPluginStaticLabels:           r.LoggingPlugin.StaticLabels,
+			LoggingPluginCACert:                 r.LoggingPlugin.CACert,
+			LoggingPluginForwarderDelayDuration: r.LoggingPlugin.ForwarderDelayDuration,
+			LoggingPluginQueryLimit:             r.LoggingPlugin.QueryLimit,
+			LoggingPluginQueryParams:            r.LoggingPlugin.QueryParams,
+			LoggingPluginMultipartRegex:         r.LoggingPlugin.MultipartRegex,
+			RouteEnabled:                        r.Route.Enabled,
+			RouteHost:                           r.Route.Host,
+			RoutePath:                           r.Route.Path,
+			RouteTLSTermination:                 r.Route.TLSTermination,
+			RouteType:                           r.Route.Type,
+			RouteClassName:                      r.Route.ClassName,
+			RouteGateway:                        r.Route.Gateway,
+			RouteTLSSecret:                      r.Route.TLSSecret,
+			RouteAnnotations:                    r.Route.Annotations,
+		},
+		LokiStackProperties: v1alpha1.LokiStackProperties{
+			LokiStackName:      r.LokiStack.Name,
+			LokiStackNamespace: r.LokiStack.Namespace,
+		},
+		Options:         r.Options,
+		Performance:     r.Performance,
+		Watcher:         r.Watcher,
+		Rotation:        r.Rotation,
+		Backup:          r.Backup,
+		RetentionPolicy: r.RetentionPolicy,
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func v1alpha1Result() v1alpha1.Result {
+	return v1alpha1.Result{
+		Disabled: false,
+		ResultsAPIProperties: v1alpha1.ResultsAPIProperties{
+			DBHost:                              "postgres",
+			DBPort:                              ptr.Int64(5432),
+			DBName:                              "tekton-results",
+			DBSSLMode:                           "verify-full",
+			DBSSLRootCert:                       "/etc/tls/db/ca.crt",
+			DBEnableAutoMigration:               ptr.Bool(true),
+			DBSecretName:                        "db-secret",
+			DBSecretUserKey:                     "user",
+			DBSecretPasswordKey:                 "password",
+			ServerPort:                          ptr.Int64(8080),
+			PrometheusPort:                      ptr.Int64(9090),
+			PrometheusHistogram:                 ptr.Bool(true),
+			LogLevel:                            "debug",
+			LogsAPI:                             ptr.Bool(true),
+			LogsType:                            "File",
+			LogsBufferSize:                      ptr.Int64(32768),
+			LogsPath:                            "/logs",
+			TLSHostnameOverride:                 "results.example.com",
+			AuthDisable:                         ptr.Bool(false),
+			AuthImpersonate:                     ptr.Bool(true),
+			LoggingPVCName:                      "logs-pvc",
+			GcsBucketName:                       "gcs-bucket",
+			StorageEmulatorHost:                 "localhost:9000",
+			SecretName:                          "s3-secret",
+			GCSCredsSecretName:                  "gcs-secret",
+			GCSCredsSecretKey:                   "creds.json",
+			IsExternalDB:                        true,
+			LoggingPluginTLSVerificationDisable: true,
+			LoggingPluginProxyPath:              "/api/logs/v1/application",
+			LoggingPluginAPIURL:                 "https://loki",
+			LoggingPluginTokenPath:              "/var/token",
+			LoggingPluginNamespaceKey:           "kubernetes_namespace_name",
+			LoggingPluginStaticLabels:           "log_type=application",
+			LoggingPluginCACert:                 "ca",
+			LoggingPluginForwarderDelayDuration: uintPtr(10),
+			LoggingPluginQueryLimit:             uintPtr(1000),
+			LoggingPluginQueryParams:            "direction=forward",
+			LoggingPluginMultipartRegex:         "-%s",
+			RouteEnabled:                        ptr.Bool(true),
+			RouteHost:                           "results.example.com",
+			RoutePath:                           "/",
+			RouteTLSTermination:                 "edge",
+			RouteType:                           v1alpha1.IngressTypeGRPCRoute,
+			RouteGateway:                        &v1alpha1.GatewayReference{Name: "public", Namespace: "gateways"},
+			RouteAnnotations:                    map[string]string{"team": "ci"},
+		},
+		LokiStackProperties: v1alpha1.LokiStackProperties{
+			LokiStackName:      "logging-loki",
+			LokiStackNamespace: "openshift-logging",
+		},
+		Options: v1alpha1.AdditionalOptions{
+			Disabled: ptr.Bool(false),
+		},
+	}
+}
+
+func TestTektonResultConversion(t *testing.T) {
+	in := &v1alpha1.TektonResult{
+		ObjectMeta: metav1.ObjectMeta{Name: "result"},
+		Spec: v1alpha1.TektonResultSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Result:     v1alpha1Result(),
+		},
+	}
+
+	roundTripFromV1alpha1(t, in, &v1alpha1.TektonResult{}, &TektonResult{})
+}
+
+func TestTektonResultConversionFromV1beta1(t *testing.T) {
+	in := &TektonResult{
+		ObjectMeta: metav1.ObjectMeta{Name: "result"},
+		Spec: TektonResultSpec{
+			Result: Result{
+				Database: ResultsDatabase{External: true, Host: "postgres", Port: ptr.Int64(5432)},
+				Server:   ResultsServer{Auth: ResultsAuth{Disable: ptr.Bool(true)}},
+				Logs:     ResultsLogs{API: ptr.Bool(true), Type: "S3", S3SecretName: "s3-secret"},
+				Route:    ResultsRoute{Enabled: ptr.Bool(true), Host: "results.example.com"},
+			},
+		},
+	}
+
+	roundTripFromV1beta1(t, in, &TektonResult{}, &v1alpha1.TektonResult{})
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonResult is the Schema for the tektonresults API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
+type TektonResult struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TektonResultSpec            `json:"spec,omitempty"`
+	Status v1alpha1.TektonResultStatus `json:"status,omitempty"`
+}
+
+// TektonResultSpec defines the desired state of TektonResult
+type TektonResultSpec struct {
+	v1alpha1.CommonSpec `json:",inline"`
+	Result              `json:",inline"`
+	// Config holds the configuration for resources created by TektonResult
+	// +optional
+	Config v1alpha1.Config `json:"config,omitempty"`
+	// NetworkPolicy configures NetworkPolicy creation for TektonResult workloads.
+	// +optional
+	NetworkPolicy v1alpha1.NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// TLS selects how the certificate of the Results API is issued
+	// +optional
+	TLS v1alpha1.TLSConfig `json:"tls,omitempty"`
+}
+
+// Result defines the fields to customize the Results component
+type Result struct {
+	// enable or disable Result Component
+	Disabled bool `json:"disabled"`
+	// Database configures the database used by the Results API
+	// +optional
+	Database ResultsDatabase `json:"database,omitempty"`
+	// Server configures the Results API server
+	// +optional
+	Server ResultsServer `json:"server,omitempty"`
+	// Logs configures the storage of TaskRun logs
+	// +optional
+	Logs ResultsLogs `json:"logs,omitempty"`
+	// LoggingPlugin configures the logging plugin which forwards logs to an external backend
+	// +optional
+	LoggingPlugin ResultsLoggingPlugin `json:"loggingPlugin,omitempty"`
+	// Route configures the exposure of the Results API
+	// +optional
+	Route ResultsRoute `json:"route,omitempty"`
+	// LokiStack configures the LokiStack used as logs backend
+	// +optional
+	LokiStack ResultsLokiStack `json:"lokiStack,omitempty"`
+	// Watcher holds configuration for the Tekton Results Watcher controller.
+	// +optional
+	Watcher v1alpha1.ResultsWatcherProperties `json:"watcher,omitempty"`
+	// Rotation configures the rotation of the certificate and the database
+	// password generated by the operator
+	// +optional
+	Rotation v1alpha1.ResultsRotation `json:"rotation,omitempty"`
+	// Backup configures scheduled backups of the bundled database
+	// +optional
+	Backup v1alpha1.ResultsBackup `json:"backup,omitempty"`
+	// RetentionPolicy configures the retention policy agent deleting the
+	// records older than their retention from the database
+	// +optional
+	RetentionPolicy v1alpha1.ResultsRetentionPolicy `json:"retentionPolicy,omitempty"`
+	// +optional
+	Performance v1alpha1.PerformanceProperties `json:"performance,omitempty"`
+	// Options holds additions fields and these fields will be updated on the manifests
+	// +optional
+	Options v1alpha1.AdditionalOptions `json:"options"`
+}
+
+// ResultsDatabase configures the database of the Results API
+type ResultsDatabase struct {
+	// External is true when an external database is used instead of the bundled one
+	External            bool   `json:"external"`
+	Host                string `json:"host,omitempty"`
+	Port                *int64 `json:"port,omitempty"`
+	Name                string `json:"name,omitempty"`
+	SSLMode             string `json:"sslMode,omitempty"`
+	SSLRootCert         string `json:"sslRootCert,omitempty"`
+	EnableAutoMigration *bool  `json:"enableAutoMigration,omitempty"`
+	SecretName          string `json:"secretName,omitempty"`
+	SecretUserKey       string `json:"secretUserKey,omitempty"`
+	SecretPasswordKey   string `json:"secretPasswordKey,omitempty"`
+}
+
+// ResultsServer configures the Results API server
+type ResultsServer struct {
+	Port                *int64 `json:"port,omitempty"`
+	LogLevel            string `json:"logLevel,omitempty"`
+	TLSHostnameOverride string `json:"tlsHostnameOverride,omitempty"`
+	// +optional
+	Auth ResultsAuth `json:"auth,omitempty"`
+	// +optional
+	Prometheus ResultsPrometheus `json:"prometheus,omitempty"`
+}
+
+// ResultsAuth configures the authentication of the Results API
+type ResultsAuth struct {
+	Disable     *bool `json:"disable,omitempty"`
+	Impersonate *bool `json:"impersonate,omitempty"`
+}
+
+// ResultsPrometheus configures the metrics of the Results API
+type ResultsPrometheus struct {
+	Port      *int64 `json:"port,omitempty"`
+	Histogram *bool  `json:"histogram,omitempty"`
+}
+
+// ResultsLogs configures the storage of TaskRun logs
+type ResultsLogs struct {
+	API                 *bool  `json:"api,omitempty"`
+	Type                string `json:"type,omitempty"`
+	BufferSize          *int64 `json:"bufferSize,omitempty"`
+	Path                string `json:"path,omitempty"`
+	PVCName             string `json:"pvcName,omitempty"`
+	GCSBucketName       string `json:"gcsBucketName,omitempty"`
+	StorageEmulatorHost string `json:"storageEmulatorHost,omitempty"`
+	// S3SecretName is the name of the secret holding the S3 credentials
+	// passed as environment variables to the Results API
+	// +optional
+	S3SecretName       string `json:"s3SecretName,omitempty"`
+	GCSCredsSecretName string `json:"gcsCredsSecretName,omitempty"`
+	GCSCredsSecretKey  string `json:"gcsCredsSecretKey,omitempty"`
+}
+
+// ResultsLoggingPlugin configures the Results logging plugin
+type ResultsLoggingPlugin struct {
+	TLSVerificationDisable bool   `json:"tlsVerificationDisable,omitempty"`
+	ProxyPath              string `json:"proxyPath,omitempty"`
+	APIURL                 string `json:"apiURL,omitempty"`
+	TokenPath              string `json:"tokenPath,omitempty"`
+	NamespaceKey           string `json:"namespaceKey,omitempty"`
+	StaticLabels           string `json:"staticLabels,omitempty"`
+	CACert                 string `json:"caCert,omitempty"`
+	ForwarderDelayDuration *uint  `json:"forwarderDelayDuration,omitempty"`
+	QueryLimit             *uint  `json:"queryLimit,omitempty"`
+	QueryParams            string `json:"queryParams,omitempty"`
+	MultipartRegex         string `json:"multipartRegex,omitempty"`
+}
+
+// ResultsRoute configures the exposure of the Results API service
+type ResultsRoute struct {
+	Enabled        *bool  `json:"enabled,omitempty"`
+	Host           string `json:"host,omitempty"`
+	Path           string `json:"path,omitempty"`
+	TLSTermination string `json:"tlsTermination,omitempty"`
+	// Type, ClassName, Gateway, TLSSecret and Annotations configure the
+	// Ingress or the Gateway API route created on Kubernetes
+	Type        string                     `json:"type,omitempty"`
+	ClassName   string                     `json:"className,omitempty"`
+	Gateway     *v1alpha1.GatewayReference `json:"gateway,omitempty"`
+	TLSSecret   string                     `json:"tlsSecret,omitempty"`
+	Annotations map[string]string          `json:"annotations,omitempty"`
+}
+
+// ResultsLokiStack configures the LokiStack logs backend
+type ResultsLokiStack struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// TektonResultList contains a list of TektonResult
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonResultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonResult `json:"items"`
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonScheduler)(nil)
+
+// ConvertTo implements apis.Convertible
+func (ts *TektonScheduler) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonScheduler:
+		sink.ObjectMeta = ts.ObjectMeta
+		sink.Spec.CommonSpec = ts.Spec.CommonSpec
+		sink.Spec.NetworkPolicy = ts.Spec.NetworkPolicy
+		sink.Spec.TLS = ts.Spec.TLS
+		sink.Spec.Scheduler = ts.Spec.Scheduler.convertTo()
+		sink.Status = ts.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (ts *TektonScheduler) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonScheduler:
+		ts.ObjectMeta = source.ObjectMeta
+		ts.Spec.CommonSpec = source.Spec.CommonSpec
+		ts.Spec.NetworkPolicy = source.Spec.NetworkPolicy
+		ts.Spec.TLS = source.Spec.TLS
+		ts.Spec.Scheduler.convertFrom(source.Spec.Scheduler)
+		ts.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (s *Scheduler) convertFrom(source v1alpha1.Scheduler) {
+	s.Disabled = source.Disabled
+	s.KueueConfig = source.SchedulerConfig.Config
+	s.MultiCluster = MultiCluster{
+		Disabled: source.MultiClusterDisabled,
+		Role:     source.MultiClusterRole,
+	}
+	s.Options = source.Options
+}
+
+func (s *Scheduler) convertTo() v1alpha1.Scheduler {
+	return v1alpha1.Scheduler{
+		Disabled:        s.Disabled,
+		SchedulerConfig: v1alpha1.SchedulerConfig{Config: s.KueueConfig},
+		MultiClusterConfig: v1alpha1.MultiClusterConfig{
+			MultiClusterDisabled: s.MultiCluster.Disabled,
+			MultiClusterRole:     s.MultiCluster.Role,
+		},
+		Options: s.Options,
+	}
+}
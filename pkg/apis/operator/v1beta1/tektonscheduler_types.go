@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/konflux-ci/tekton-kueue/pkg/config"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonScheduler is the Schema for the tektonschedulers API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
+type TektonScheduler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TektonSchedulerSpec            `json:"spec,omitempty"`
+	Status v1alpha1.TektonSchedulerStatus `json:"status,omitempty"`
+}
+
+// TektonSchedulerSpec defines the desired state of TektonScheduler
+type TektonSchedulerSpec struct {
+	v1alpha1.CommonSpec `json:",inline"`
+	Scheduler           `json:",inline"`
+	// +optional
+	NetworkPolicy v1alpha1.NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// TLS selects the issuer of the certificate of the scheduler webhook
+	// +optional
+	TLS v1alpha1.TLSConfig `json:"tls,omitempty"`
+}
+
+// Scheduler defines the fields to customize the scheduler
+type Scheduler struct {
+	// enable or disable TektonScheduler Component
+	Disabled *bool `json:"disabled"`
+	// KueueConfig is the configuration of tekton-kueue, written to its
+	// config.yaml
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	KueueConfig config.Config `json:"kueueConfig,omitempty"`
+	// MultiCluster configures the scheduling on multiple clusters
+	// +optional
+	MultiCluster MultiCluster `json:"multiCluster,omitempty"`
+	// options holds additions fields and these fields will be updated on the manifests
+	// +optional
+	Options v1alpha1.AdditionalOptions `json:"options"`
+}
+
+// MultiCluster defines the role of the cluster in a multi-cluster environment
+type MultiCluster struct {
+	Disabled bool `json:"disabled"`
+	// Role is one of Hub or Spoke
+	Role v1alpha1.MultiClusterRole `json:"role"`
+}
+
+// TektonSchedulerList contains a list of TektonScheduler
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonSchedulerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonScheduler `json:"items"`
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Convertible = (*TektonTrigger)(nil)
+
+// ConvertTo implements apis.Convertible
+func (tt *TektonTrigger) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha1.TektonTrigger:
+		sink.ObjectMeta = tt.ObjectMeta
+		sink.Spec.CommonSpec = tt.Spec.CommonSpec
+		sink.Spec.Config = tt.Spec.Config
+		sink.Spec.NetworkPolicy = tt.Spec.NetworkPolicy
+		sink.Spec.Trigger = tt.Spec.Trigger.convertTo()
+		sink.Status = tt.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+func (tt *TektonTrigger) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha1.TektonTrigger:
+		tt.ObjectMeta = source.ObjectMeta
+		tt.Spec.CommonSpec = source.Spec.CommonSpec
+		tt.Spec.Config = source.Spec.Config
+		tt.Spec.NetworkPolicy = source.Spec.NetworkPolicy
+		tt.Spec.Trigger.convertFrom(source.Spec.Trigger)
+		tt.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (t *Trigger) convertFrom(source v1alpha1.Trigger) {
+	t.Disabled = source.Disabled
+	t.EnableAPIFields = source.EnableApiFields
+	t.DefaultServiceAccount = source.DefaultServiceAccount
+	t.Options = source.Options
+}
+
+func (t *Trigger) convertTo() v1alpha1.Trigger {
+	return v1alpha1.Trigger{
+		Disabled: t.Disabled,
+		TriggersProperties: v1alpha1.TriggersProperties{
+			EnableApiFields: t.EnableAPIFields,
+			OptionalTriggersProperties: v1alpha1.OptionalTriggersProperties{
+				DefaultServiceAccount: t.DefaultServiceAccount,
+			},
+		},
+		Options: t.Options,
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonTrigger is the Schema for the tektontriggers API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`
+type TektonTrigger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TektonTriggerSpec            `json:"spec,omitempty"`
+	Status v1alpha1.TektonTriggerStatus `json:"status,omitempty"`
+}
+
+// TektonTriggerSpec defines the desired state of TektonTrigger
+type TektonTriggerSpec struct {
+	v1alpha1.CommonSpec `json:",inline"`
+	Trigger             `json:",inline"`
+	// Config holds the configuration for resources created by TektonTrigger
+	// +optional
+	Config v1alpha1.Config `json:"config,omitempty"`
+	// NetworkPolicy configures NetworkPolicy creation for TektonTrigger workloads.
+	// +optional
+	NetworkPolicy v1alpha1.NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+}
+
+// Trigger defines the field to customize Trigger component
+type Trigger struct {
+	// enable or disable Trigger Component
+	Disabled        bool   `json:"disabled"`
+	EnableAPIFields string `json:"enableAPIFields,omitempty"`
+	// +optional
+	DefaultServiceAccount string `json:"defaultServiceAccount,omitempty"`
+	// options holds additions fields and these fields will be updated on the manifests
+	// +optional
+	Options v1alpha1.AdditionalOptions `json:"options"`
+}
+
+// TektonTriggerList contains a list of TektonTrigger
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonTriggerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonTrigger `json:"items"`
+}
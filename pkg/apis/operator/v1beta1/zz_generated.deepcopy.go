@@ -0,0 +1,2015 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1alpha1 "github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	pod "github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Addon) DeepCopyInto(out *Addon) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnablePAC != nil {
+		in, out := &in.EnablePAC, &out.EnablePAC
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Addon.
+func (in *Addon) DeepCopy() *Addon {
+	if in == nil {
+		return nil
+	}
+	out := new(Addon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Chain) DeepCopyInto(out *Chain) {
+	*out = *in
+	in.Rotation.DeepCopyInto(&out.Rotation)
+	in.Artifacts.DeepCopyInto(&out.Artifacts)
+	in.Storage.DeepCopyInto(&out.Storage)
+	out.Builder = in.Builder
+	out.BuildDefinition = in.BuildDefinition
+	in.Signers.DeepCopyInto(&out.Signers)
+	out.Transparency = in.Transparency
+	in.Performance.DeepCopyInto(&out.Performance)
+	if in.ControllerEnvs != nil {
+		in, out := &in.ControllerEnvs, &out.ControllerEnvs
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Chain.
+func (in *Chain) DeepCopy() *Chain {
+	if in == nil {
+		return nil
+	}
+	out := new(Chain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainArtifact) DeepCopyInto(out *ChainArtifact) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainArtifact.
+func (in *ChainArtifact) DeepCopy() *ChainArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainArtifacts) DeepCopyInto(out *ChainArtifacts) {
+	*out = *in
+	in.TaskRun.DeepCopyInto(&out.TaskRun)
+	in.PipelineRun.DeepCopyInto(&out.PipelineRun)
+	in.OCI.DeepCopyInto(&out.OCI)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainArtifacts.
+func (in *ChainArtifacts) DeepCopy() *ChainArtifacts {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainArtifacts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainBuildDefinition) DeepCopyInto(out *ChainBuildDefinition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainBuildDefinition.
+func (in *ChainBuildDefinition) DeepCopy() *ChainBuildDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainBuildDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainBuilder) DeepCopyInto(out *ChainBuilder) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainBuilder.
+func (in *ChainBuilder) DeepCopy() *ChainBuilder {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainBuilder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainDocDBStorage) DeepCopyInto(out *ChainDocDBStorage) {
+	*out = *in
+	if in.MongoServerURLSecretRef != nil {
+		in, out := &in.MongoServerURLSecretRef, &out.MongoServerURLSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainDocDBStorage.
+func (in *ChainDocDBStorage) DeepCopy() *ChainDocDBStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainDocDBStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainFulcio) DeepCopyInto(out *ChainFulcio) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainFulcio.
+func (in *ChainFulcio) DeepCopy() *ChainFulcio {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainFulcio)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainGCSStorage) DeepCopyInto(out *ChainGCSStorage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainGCSStorage.
+func (in *ChainGCSStorage) DeepCopy() *ChainGCSStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainGCSStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainGrafeasStorage) DeepCopyInto(out *ChainGrafeasStorage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainGrafeasStorage.
+func (in *ChainGrafeasStorage) DeepCopy() *ChainGrafeasStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainGrafeasStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainKMSAuth) DeepCopyInto(out *ChainKMSAuth) {
+	*out = *in
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.OIDC = in.OIDC
+	out.Spire = in.Spire
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainKMSAuth.
+func (in *ChainKMSAuth) DeepCopy() *ChainKMSAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainKMSAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainKMSOIDCAuth) DeepCopyInto(out *ChainKMSOIDCAuth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainKMSOIDCAuth.
+func (in *ChainKMSOIDCAuth) DeepCopy() *ChainKMSOIDCAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainKMSOIDCAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainKMSSigner) DeepCopyInto(out *ChainKMSSigner) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainKMSSigner.
+func (in *ChainKMSSigner) DeepCopy() *ChainKMSSigner {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainKMSSigner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainKMSSpireAuth) DeepCopyInto(out *ChainKMSSpireAuth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainKMSSpireAuth.
+func (in *ChainKMSSpireAuth) DeepCopy() *ChainKMSSpireAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainKMSSpireAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainOCIStorage) DeepCopyInto(out *ChainOCIStorage) {
+	*out = *in
+	if in.RepositoryInsecure != nil {
+		in, out := &in.RepositoryInsecure, &out.RepositoryInsecure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainOCIStorage.
+func (in *ChainOCIStorage) DeepCopy() *ChainOCIStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainOCIStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainPipelineRunArtifact) DeepCopyInto(out *ChainPipelineRunArtifact) {
+	*out = *in
+	in.ChainArtifact.DeepCopyInto(&out.ChainArtifact)
+	if in.EnableDeepInspection != nil {
+		in, out := &in.EnableDeepInspection, &out.EnableDeepInspection
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainPipelineRunArtifact.
+func (in *ChainPipelineRunArtifact) DeepCopy() *ChainPipelineRunArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainPipelineRunArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainSigners) DeepCopyInto(out *ChainSigners) {
+	*out = *in
+	in.X509.DeepCopyInto(&out.X509)
+	in.KMS.DeepCopyInto(&out.KMS)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainSigners.
+func (in *ChainSigners) DeepCopy() *ChainSigners {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainSigners)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainStorage) DeepCopyInto(out *ChainStorage) {
+	*out = *in
+	out.GCS = in.GCS
+	in.OCI.DeepCopyInto(&out.OCI)
+	in.DocDB.DeepCopyInto(&out.DocDB)
+	out.Grafeas = in.Grafeas
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainStorage.
+func (in *ChainStorage) DeepCopy() *ChainStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainTransparency) DeepCopyInto(out *ChainTransparency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainTransparency.
+func (in *ChainTransparency) DeepCopy() *ChainTransparency {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainTransparency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainX509Signer) DeepCopyInto(out *ChainX509Signer) {
+	*out = *in
+	in.Fulcio.DeepCopyInto(&out.Fulcio)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainX509Signer.
+func (in *ChainX509Signer) DeepCopy() *ChainX509Signer {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainX509Signer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dashboard) DeepCopyInto(out *Dashboard) {
+	*out = *in
+	in.Ingress.DeepCopyInto(&out.Ingress)
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dashboard.
+func (in *Dashboard) DeepCopy() *Dashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(Dashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hub) DeepCopyInto(out *Hub) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hub.
+func (in *Hub) DeepCopy() *Hub {
+	if in == nil {
+		return nil
+	}
+	out := new(Hub)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManualApprovalGate) DeepCopyInto(out *ManualApprovalGate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManualApprovalGate.
+func (in *ManualApprovalGate) DeepCopy() *ManualApprovalGate {
+	if in == nil {
+		return nil
+	}
+	out := new(ManualApprovalGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManualApprovalGate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManualApprovalGateList) DeepCopyInto(out *ManualApprovalGateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManualApprovalGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManualApprovalGateList.
+func (in *ManualApprovalGateList) DeepCopy() *ManualApprovalGateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManualApprovalGateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManualApprovalGateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiCluster) DeepCopyInto(out *MultiCluster) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiCluster.
+func (in *MultiCluster) DeepCopy() *MultiCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenShiftPipelinesAsCode) DeepCopyInto(out *OpenShiftPipelinesAsCode) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenShiftPipelinesAsCode.
+func (in *OpenShiftPipelinesAsCode) DeepCopy() *OpenShiftPipelinesAsCode {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenShiftPipelinesAsCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenShiftPipelinesAsCode) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenShiftPipelinesAsCodeList) DeepCopyInto(out *OpenShiftPipelinesAsCodeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenShiftPipelinesAsCode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenShiftPipelinesAsCodeList.
+func (in *OpenShiftPipelinesAsCodeList) DeepCopy() *OpenShiftPipelinesAsCodeList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenShiftPipelinesAsCodeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenShiftPipelinesAsCodeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Pipeline) DeepCopyInto(out *Pipeline) {
+	*out = *in
+	in.FeatureFlags.DeepCopyInto(&out.FeatureFlags)
+	in.Defaults.DeepCopyInto(&out.Defaults)
+	in.Metrics.DeepCopyInto(&out.Metrics)
+	in.Tracing.DeepCopyInto(&out.Tracing)
+	in.Resolvers.DeepCopyInto(&out.Resolvers)
+	in.Performance.DeepCopyInto(&out.Performance)
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pipeline.
+func (in *Pipeline) DeepCopy() *Pipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(Pipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineDefaults) DeepCopyInto(out *PipelineDefaults) {
+	*out = *in
+	if in.TimeoutMinutes != nil {
+		in, out := &in.TimeoutMinutes, &out.TimeoutMinutes
+		*out = new(uint)
+		**out = **in
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(pod.Template)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AffinityAssistantPodTemplate != nil {
+		in, out := &in.AffinityAssistantPodTemplate, &out.AffinityAssistantPodTemplate
+		*out = new(pod.AffinityAssistantTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineDefaults.
+func (in *PipelineDefaults) DeepCopy() *PipelineDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineFeatureFlags) DeepCopyInto(out *PipelineFeatureFlags) {
+	*out = *in
+	if in.DisableCredsInit != nil {
+		in, out := &in.DisableCredsInit, &out.DisableCredsInit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AwaitSidecarReadiness != nil {
+		in, out := &in.AwaitSidecarReadiness, &out.AwaitSidecarReadiness
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RunningInEnvironmentWithInjectedSidecars != nil {
+		in, out := &in.RunningInEnvironmentWithInjectedSidecars, &out.RunningInEnvironmentWithInjectedSidecars
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireGitSSHSecretKnownHosts != nil {
+		in, out := &in.RequireGitSSHSecretKnownHosts, &out.RequireGitSSHSecretKnownHosts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableCustomTasks != nil {
+		in, out := &in.EnableCustomTasks, &out.EnableCustomTasks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SendCloudEventsForRuns != nil {
+		in, out := &in.SendCloudEventsForRuns, &out.SendCloudEventsForRuns
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableProvenanceInStatus != nil {
+		in, out := &in.EnableProvenanceInStatus, &out.EnableProvenanceInStatus
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KeepPodOnCancel != nil {
+		in, out := &in.KeepPodOnCancel, &out.KeepPodOnCancel
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxResultSize != nil {
+		in, out := &in.MaxResultSize, &out.MaxResultSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SetSecurityContext != nil {
+		in, out := &in.SetSecurityContext, &out.SetSecurityContext
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableCELInWhenExpression != nil {
+		in, out := &in.EnableCELInWhenExpression, &out.EnableCELInWhenExpression
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableStepActions != nil {
+		in, out := &in.EnableStepActions, &out.EnableStepActions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableParamEnum != nil {
+		in, out := &in.EnableParamEnum, &out.EnableParamEnum
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineFeatureFlags.
+func (in *PipelineFeatureFlags) DeepCopy() *PipelineFeatureFlags {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineFeatureFlags)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineMetrics) DeepCopyInto(out *PipelineMetrics) {
+	*out = *in
+	if in.CountWithReason != nil {
+		in, out := &in.CountWithReason, &out.CountWithReason
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineMetrics.
+func (in *PipelineMetrics) DeepCopy() *PipelineMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineResolver) DeepCopyInto(out *PipelineResolver) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineResolver.
+func (in *PipelineResolver) DeepCopy() *PipelineResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineResolvers) DeepCopyInto(out *PipelineResolvers) {
+	*out = *in
+	in.Bundles.DeepCopyInto(&out.Bundles)
+	in.Hub.DeepCopyInto(&out.Hub)
+	in.Git.DeepCopyInto(&out.Git)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineResolvers.
+func (in *PipelineResolvers) DeepCopy() *PipelineResolvers {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineResolvers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTracing) DeepCopyInto(out *PipelineTracing) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTracing.
+func (in *PipelineTracing) DeepCopy() *PipelineTracing {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Pruner) DeepCopyInto(out *Pruner) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.PrunerConfig.DeepCopyInto(&out.PrunerConfig)
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pruner.
+func (in *Pruner) DeepCopy() *Pruner {
+	if in == nil {
+		return nil
+	}
+	out := new(Pruner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrunerConfig.
+func (in *PrunerConfig) DeepCopy() *PrunerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrunerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Result) DeepCopyInto(out *Result) {
+	*out = *in
+	in.Database.DeepCopyInto(&out.Database)
+	in.Server.DeepCopyInto(&out.Server)
+	in.Logs.DeepCopyInto(&out.Logs)
+	in.LoggingPlugin.DeepCopyInto(&out.LoggingPlugin)
+	in.Route.DeepCopyInto(&out.Route)
+	out.LokiStack = in.LokiStack
+	in.Watcher.DeepCopyInto(&out.Watcher)
+	in.Rotation.DeepCopyInto(&out.Rotation)
+	in.Backup.DeepCopyInto(&out.Backup)
+	in.RetentionPolicy.DeepCopyInto(&out.RetentionPolicy)
+	in.Performance.DeepCopyInto(&out.Performance)
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Result.
+func (in *Result) DeepCopy() *Result {
+	if in == nil {
+		return nil
+	}
+	out := new(Result)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsAuth) DeepCopyInto(out *ResultsAuth) {
+	*out = *in
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Impersonate != nil {
+		in, out := &in.Impersonate, &out.Impersonate
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsAuth.
+func (in *ResultsAuth) DeepCopy() *ResultsAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsDatabase) DeepCopyInto(out *ResultsDatabase) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EnableAutoMigration != nil {
+		in, out := &in.EnableAutoMigration, &out.EnableAutoMigration
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsDatabase.
+func (in *ResultsDatabase) DeepCopy() *ResultsDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsLoggingPlugin) DeepCopyInto(out *ResultsLoggingPlugin) {
+	*out = *in
+	if in.ForwarderDelayDuration != nil {
+		in, out := &in.ForwarderDelayDuration, &out.ForwarderDelayDuration
+		*out = new(uint)
+		**out = **in
+	}
+	if in.QueryLimit != nil {
+		in, out := &in.QueryLimit, &out.QueryLimit
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsLoggingPlugin.
+func (in *ResultsLoggingPlugin) DeepCopy() *ResultsLoggingPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsLoggingPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsLogs) DeepCopyInto(out *ResultsLogs) {
+	*out = *in
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsLogs.
+func (in *ResultsLogs) DeepCopy() *ResultsLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsLokiStack) DeepCopyInto(out *ResultsLokiStack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsLokiStack.
+func (in *ResultsLokiStack) DeepCopy() *ResultsLokiStack {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsLokiStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsPrometheus) DeepCopyInto(out *ResultsPrometheus) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Histogram != nil {
+		in, out := &in.Histogram, &out.Histogram
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsPrometheus.
+func (in *ResultsPrometheus) DeepCopy() *ResultsPrometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsPrometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsRoute) DeepCopyInto(out *ResultsRoute) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(v1alpha1.GatewayReference)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsRoute.
+func (in *ResultsRoute) DeepCopy() *ResultsRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsServer) DeepCopyInto(out *ResultsServer) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	in.Prometheus.DeepCopyInto(&out.Prometheus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsServer.
+func (in *ResultsServer) DeepCopy() *ResultsServer {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scheduler) DeepCopyInto(out *Scheduler) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.KueueConfig.DeepCopyInto(&out.KueueConfig)
+	out.MultiCluster = in.MultiCluster
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scheduler.
+func (in *Scheduler) DeepCopy() *Scheduler {
+	if in == nil {
+		return nil
+	}
+	out := new(Scheduler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncerService) DeepCopyInto(out *SyncerService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncerService.
+func (in *SyncerService) DeepCopy() *SyncerService {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncerService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SyncerService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncerServiceList) DeepCopyInto(out *SyncerServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SyncerService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncerServiceList.
+func (in *SyncerServiceList) DeepCopy() *SyncerServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncerServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SyncerServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonAddon) DeepCopyInto(out *TektonAddon) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonAddon.
+func (in *TektonAddon) DeepCopy() *TektonAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonAddon) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonAddonList) DeepCopyInto(out *TektonAddonList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonAddon, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonAddonList.
+func (in *TektonAddonList) DeepCopy() *TektonAddonList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonAddonList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonAddonList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonAddonSpec) DeepCopyInto(out *TektonAddonSpec) {
+	*out = *in
+	out.CommonSpec = in.CommonSpec
+	in.Addon.DeepCopyInto(&out.Addon)
+	in.Config.DeepCopyInto(&out.Config)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonAddonSpec.
+func (in *TektonAddonSpec) DeepCopy() *TektonAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonChain) DeepCopyInto(out *TektonChain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonChain.
+func (in *TektonChain) DeepCopy() *TektonChain {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonChain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonChain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonChainList) DeepCopyInto(out *TektonChainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonChain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonChainList.
+func (in *TektonChainList) DeepCopy() *TektonChainList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonChainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonChainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonChainSpec) DeepCopyInto(out *TektonChainSpec) {
+	*out = *in
+	out.CommonSpec = in.CommonSpec
+	in.Chain.DeepCopyInto(&out.Chain)
+	in.Config.DeepCopyInto(&out.Config)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonChainSpec.
+func (in *TektonChainSpec) DeepCopy() *TektonChainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonChainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonConfig) DeepCopyInto(out *TektonConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonConfig.
+func (in *TektonConfig) DeepCopy() *TektonConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonConfigList) DeepCopyInto(out *TektonConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonConfigList.
+func (in *TektonConfigList) DeepCopy() *TektonConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonConfigSpec) DeepCopyInto(out *TektonConfigSpec) {
+	*out = *in
+	in.Config.DeepCopyInto(&out.Config)
+	in.Pruner.DeepCopyInto(&out.Pruner)
+	in.TektonPruner.DeepCopyInto(&out.TektonPruner)
+	in.Scheduler.DeepCopyInto(&out.Scheduler)
+	out.CommonSpec = in.CommonSpec
+	in.Addon.DeepCopyInto(&out.Addon)
+	in.Hub.DeepCopyInto(&out.Hub)
+	in.Pipeline.DeepCopyInto(&out.Pipeline)
+	in.Trigger.DeepCopyInto(&out.Trigger)
+	in.Chain.DeepCopyInto(&out.Chain)
+	in.Result.DeepCopyInto(&out.Result)
+	in.Dashboard.DeepCopyInto(&out.Dashboard)
+	in.MulticlusterProxyAAE.DeepCopyInto(&out.MulticlusterProxyAAE)
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Platforms.DeepCopyInto(&out.Platforms)
+	if in.TargetNamespaceMetadata != nil {
+		in, out := &in.TargetNamespaceMetadata, &out.TargetNamespaceMetadata
+		*out = new(v1alpha1.NamespaceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.Rollback.DeepCopyInto(&out.Rollback)
+	in.Verification.DeepCopyInto(&out.Verification)
+	in.TLS.DeepCopyInto(&out.TLS)
+	in.ResultsGuard.DeepCopyInto(&out.ResultsGuard)
+	in.PipelineNetworkPolicy.DeepCopyInto(&out.PipelineNetworkPolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonConfigSpec.
+func (in *TektonConfigSpec) DeepCopy() *TektonConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonDashboard) DeepCopyInto(out *TektonDashboard) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonDashboard.
+func (in *TektonDashboard) DeepCopy() *TektonDashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonDashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonDashboard) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonDashboardList) DeepCopyInto(out *TektonDashboardList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonDashboard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonDashboardList.
+func (in *TektonDashboardList) DeepCopy() *TektonDashboardList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonDashboardList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonDashboardList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonDashboardSpec) DeepCopyInto(out *TektonDashboardSpec) {
+	*out = *in
+	out.CommonSpec = in.CommonSpec
+	in.Dashboard.DeepCopyInto(&out.Dashboard)
+	in.Config.DeepCopyInto(&out.Config)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonDashboardSpec.
+func (in *TektonDashboardSpec) DeepCopy() *TektonDashboardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonDashboardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonMulticlusterProxyAAE) DeepCopyInto(out *TektonMulticlusterProxyAAE) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonMulticlusterProxyAAE.
+func (in *TektonMulticlusterProxyAAE) DeepCopy() *TektonMulticlusterProxyAAE {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonMulticlusterProxyAAE)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonMulticlusterProxyAAE) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonMulticlusterProxyAAEList) DeepCopyInto(out *TektonMulticlusterProxyAAEList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonMulticlusterProxyAAE, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonMulticlusterProxyAAEList.
+func (in *TektonMulticlusterProxyAAEList) DeepCopy() *TektonMulticlusterProxyAAEList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonMulticlusterProxyAAEList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonMulticlusterProxyAAEList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonPipeline) DeepCopyInto(out *TektonPipeline) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonPipeline.
+func (in *TektonPipeline) DeepCopy() *TektonPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonPipeline) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonPipelineList) DeepCopyInto(out *TektonPipelineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonPipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonPipelineList.
+func (in *TektonPipelineList) DeepCopy() *TektonPipelineList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonPipelineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonPipelineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonPipelineSpec) DeepCopyInto(out *TektonPipelineSpec) {
+	*out = *in
+	out.CommonSpec = in.CommonSpec
+	in.Pipeline.DeepCopyInto(&out.Pipeline)
+	in.Config.DeepCopyInto(&out.Config)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonPipelineSpec.
+func (in *TektonPipelineSpec) DeepCopy() *TektonPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonPruner) DeepCopyInto(out *TektonPruner) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonPruner.
+func (in *TektonPruner) DeepCopy() *TektonPruner {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonPruner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonPruner) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonPrunerList) DeepCopyInto(out *TektonPrunerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonPruner, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonPrunerList.
+func (in *TektonPrunerList) DeepCopy() *TektonPrunerList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonPrunerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonPrunerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonPrunerSpec) DeepCopyInto(out *TektonPrunerSpec) {
+	*out = *in
+	out.CommonSpec = in.CommonSpec
+	in.Pruner.DeepCopyInto(&out.Pruner)
+	in.Config.DeepCopyInto(&out.Config)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonPrunerSpec.
+func (in *TektonPrunerSpec) DeepCopy() *TektonPrunerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonPrunerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonResult) DeepCopyInto(out *TektonResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonResult.
+func (in *TektonResult) DeepCopy() *TektonResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonResultList) DeepCopyInto(out *TektonResultList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonResultList.
+func (in *TektonResultList) DeepCopy() *TektonResultList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonResultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonResultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonResultSpec) DeepCopyInto(out *TektonResultSpec) {
+	*out = *in
+	out.CommonSpec = in.CommonSpec
+	in.Result.DeepCopyInto(&out.Result)
+	in.Config.DeepCopyInto(&out.Config)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.TLS.DeepCopyInto(&out.TLS)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonResultSpec.
+func (in *TektonResultSpec) DeepCopy() *TektonResultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonResultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonScheduler) DeepCopyInto(out *TektonScheduler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonScheduler.
+func (in *TektonScheduler) DeepCopy() *TektonScheduler {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonScheduler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonScheduler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonSchedulerList) DeepCopyInto(out *TektonSchedulerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonScheduler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonSchedulerList.
+func (in *TektonSchedulerList) DeepCopy() *TektonSchedulerList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonSchedulerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonSchedulerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonSchedulerSpec) DeepCopyInto(out *TektonSchedulerSpec) {
+	*out = *in
+	out.CommonSpec = in.CommonSpec
+	in.Scheduler.DeepCopyInto(&out.Scheduler)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.TLS.DeepCopyInto(&out.TLS)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonSchedulerSpec.
+func (in *TektonSchedulerSpec) DeepCopy() *TektonSchedulerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonSchedulerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonTrigger) DeepCopyInto(out *TektonTrigger) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonTrigger.
+func (in *TektonTrigger) DeepCopy() *TektonTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonTrigger) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonTriggerList) DeepCopyInto(out *TektonTriggerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonTrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonTriggerList.
+func (in *TektonTriggerList) DeepCopy() *TektonTriggerList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonTriggerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonTriggerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonTriggerSpec) DeepCopyInto(out *TektonTriggerSpec) {
+	*out = *in
+	out.CommonSpec = in.CommonSpec
+	in.Trigger.DeepCopyInto(&out.Trigger)
+	in.Config.DeepCopyInto(&out.Config)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonTriggerSpec.
+func (in *TektonTriggerSpec) DeepCopy() *TektonTriggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonTriggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Trigger) DeepCopyInto(out *Trigger) {
+	*out = *in
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Trigger.
+func (in *Trigger) DeepCopy() *Trigger {
+	if in == nil {
+		return nil
+	}
+	out := new(Trigger)
+	in.DeepCopyInto(out)
+	return out
+}
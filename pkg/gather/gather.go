@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gather collects the state of an operator installation into a
+// tarball, for troubleshooting.
+package gather
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	DefaultOperatorNamespace = "tekton-operator"
+	DefaultSince             = time.Hour
+	DefaultLogLines          = 1000
+
+	// rootDir is the top level directory of the tarball
+	rootDir = "must-gather"
+)
+
+// Options configures what is collected
+type Options struct {
+	// OperatorNamespace is the namespace of the operator and webhook deployments
+	OperatorNamespace string
+	// TargetNamespace is the namespace of the components, defaults to the
+	// target namespace of the TektonConfig
+	TargetNamespace string
+	// Since limits the collected events and logs to the ones more recent than this
+	Since time.Duration
+	// LogLines limits the number of lines collected for each container
+	LogLines int64
+}
+
+// Gatherer collects the operator CRs, the TektonInstallerSets and the
+// workloads, events, logs and configuration of the components
+type Gatherer struct {
+	kubeClient     kubernetes.Interface
+	operatorClient versioned.Interface
+	opts           Options
+
+	tw  *tar.Writer
+	now time.Time
+	// errs records what could not be collected, the gathering is best effort
+	errs []string
+}
+
+func New(kubeClient kubernetes.Interface, operatorClient versioned.Interface, opts Options) *Gatherer {
+	if opts.OperatorNamespace == "" {
+		opts.OperatorNamespace = DefaultOperatorNamespace
+	}
+	if opts.Since == 0 {
+		opts.Since = DefaultSince
+	}
+	if opts.LogLines == 0 {
+		opts.LogLines = DefaultLogLines
+	}
+	return &Gatherer{kubeClient: kubeClient, operatorClient: operatorClient, opts: opts}
+}
+
+// Gather writes a gzipped tarball to w. Secrets are not collected and the
+// sensitive values of the collected resources and logs are redacted. The
+// resources which can't be read are listed in errors.txt
+func (g *Gatherer) Gather(ctx context.Context, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	g.tw = tar.NewWriter(gz)
+	g.now = time.Now()
+	g.errs = nil
+
+	g.gatherOperatorResources(ctx)
+	g.gatherInstallerSets(ctx)
+
+	targetNamespace := g.targetNamespace(ctx)
+	g.gatherNamespace(ctx, targetNamespace)
+	if g.opts.OperatorNamespace != targetNamespace {
+		g.gatherNamespace(ctx, g.opts.OperatorNamespace)
+	}
+
+	if len(g.errs) > 0 {
+		if err := g.writeFile("errors.txt", []byte(strings.Join(g.errs, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+	if err := g.tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func (g *Gatherer) targetNamespace(ctx context.Context) string {
+	if g.opts.TargetNamespace != "" {
+		return g.opts.TargetNamespace
+	}
+	tc, err := g.operatorClient.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	if err == nil && tc.Spec.TargetNamespace != "" {
+		return tc.Spec.TargetNamespace
+	}
+	return "tekton-pipelines"
+}
+
+// gatherOperatorResources collects the operator CRs with their status, with
+// the credentials of their spec and annotations redacted
+func (g *Gatherer) gatherOperatorResources(ctx context.Context) {
+	c := g.operatorClient.OperatorV1alpha1()
+	opts := metav1.ListOptions{}
+	resources := []struct {
+		name string
+		list func() (runtime.Object, error)
+	}{
+		{"tektonconfigs", func() (runtime.Object, error) { return c.TektonConfigs().List(ctx, opts) }},
+		{"tektonpipelines", func() (runtime.Object, error) { return c.TektonPipelines().List(ctx, opts) }},
+		{"tektontriggers", func() (runtime.Object, error) { return c.TektonTriggers().List(ctx, opts) }},
+		{"tektonchains", func() (runtime.Object, error) { return c.TektonChains().List(ctx, opts) }},
+		{"tektonresults", func() (runtime.Object, error) { return c.TektonResults().List(ctx, opts) }},
+		{"tektondashboards", func() (runtime.Object, error) { return c.TektonDashboards().List(ctx, opts) }},
+		{"tektonaddons", func() (runtime.Object, error) { return c.TektonAddons().List(ctx, opts) }},
+		{"tektonpruners", func() (runtime.Object, error) { return c.TektonPruners().List(ctx, opts) }},
+		{"tektonschedulers", func() (runtime.Object, error) { return c.TektonSchedulers().List(ctx, opts) }},
+		{"tektonmulticlusterproxyaaes", func() (runtime.Object, error) { return c.TektonMulticlusterProxyAAEs().List(ctx, opts) }},
+		{"manualapprovalgates", func() (runtime.Object, error) { return c.ManualApprovalGates().List(ctx, opts) }},
+		{"openshiftpipelinesascodes", func() (runtime.Object, error) { return c.OpenShiftPipelinesAsCodes().List(ctx, opts) }},
+		{"syncerservices", func() (runtime.Object, error) { return c.SyncerServices().List(ctx, opts) }},
+	}
+	for _, r := range resources {
+		list, err := r.list()
+		if err != nil {
+			g.recordError(r.name, err)
+			continue
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			g.recordError(r.name, err)
+			continue
+		}
+		for _, item := range items {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+			if err != nil {
+				g.recordError(r.name, err)
+				continue
+			}
+			obj := &unstructured.Unstructured{Object: content}
+			redactResource(obj)
+			g.writeYAML(path.Join("operator", r.name, obj.GetName()+".yaml"), obj.Object)
+		}
+	}
+}
+
+// gatherInstallerSets collects the TektonInstallerSets, with their
+// manifests redacted, and a summary of their hashes and conditions
+func (g *Gatherer) gatherInstallerSets(ctx context.Context) {
+	list, err := g.operatorClient.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		g.recordError("tektoninstallersets", err)
+		return
+	}
+	sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].Name < list.Items[j].Name })
+
+	summary := &bytes.Buffer{}
+	w := tabwriter.NewWriter(summary, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCREATED-BY\tTYPE\tLAST-APPLIED-HASH\tREADY\tREASON")
+	for i := range list.Items {
+		is := &list.Items[i]
+		for j := range is.Spec.Manifests {
+			redactObject(&is.Spec.Manifests[j])
+		}
+		g.writeYAML(path.Join("installersets", is.Name+".yaml"), is)
+
+		ready, reason := "Unknown", ""
+		if cond := is.Status.GetCondition(apis.ConditionReady); cond != nil {
+			ready, reason = string(cond.Status), cond.Reason
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", is.Name, is.Labels[v1alpha1.CreatedByKey],
+			is.Labels[v1alpha1.InstallerSetType], is.Annotations[v1alpha1.LastAppliedHashKey], ready, reason)
+	}
+	_ = w.Flush()
+	if err := g.writeFile(path.Join("installersets", "summary.txt"), summary.Bytes()); err != nil {
+		g.recordError("installersets/summary.txt", err)
+	}
+}
+
+// gatherNamespace collects the workloads, recent events, feature flag
+// ConfigMaps and pod logs of a namespace
+func (g *Gatherer) gatherNamespace(ctx context.Context, namespace string) {
+	dir := path.Join("namespaces", namespace)
+	opts := metav1.ListOptions{}
+
+	if deployments, err := g.kubeClient.AppsV1().Deployments(namespace).List(ctx, opts); err != nil {
+		g.recordError(dir+"/deployments", err)
+	} else {
+		for i := range deployments.Items {
+			d := &deployments.Items[i]
+			redactPodSpec(&d.Spec.Template.Spec)
+			g.writeYAML(path.Join(dir, "deployments", d.Name+".yaml"), d)
+		}
+	}
+
+	if statefulSets, err := g.kubeClient.AppsV1().StatefulSets(namespace).List(ctx, opts); err != nil {
+		g.recordError(dir+"/statefulsets", err)
+	} else {
+		for i := range statefulSets.Items {
+			s := &statefulSets.Items[i]
+			redactPodSpec(&s.Spec.Template.Spec)
+			g.writeYAML(path.Join(dir, "statefulsets", s.Name+".yaml"), s)
+		}
+	}
+
+	if configMaps, err := g.kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, opts); err != nil {
+		g.recordError(dir+"/configmaps", err)
+	} else {
+		for i := range configMaps.Items {
+			cm := &configMaps.Items[i]
+			if !isFeatureFlagConfigMap(cm.Name) {
+				continue
+			}
+			redactConfigData(cm.Data)
+			g.writeYAML(path.Join(dir, "configmaps", cm.Name+".yaml"), cm)
+		}
+	}
+
+	if events, err := g.kubeClient.CoreV1().Events(namespace).List(ctx, opts); err != nil {
+		g.recordError(dir+"/events", err)
+	} else {
+		g.writeYAML(path.Join(dir, "events.yaml"), g.recentEvents(events.Items))
+	}
+
+	pods, err := g.kubeClient.CoreV1().Pods(namespace).List(ctx, opts)
+	if err != nil {
+		g.recordError(dir+"/pods", err)
+		return
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		redactPodSpec(&pod.Spec)
+		g.writeYAML(path.Join(dir, "pods", pod.Name+".yaml"), pod)
+		for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			g.gatherLogs(ctx, path.Join(dir, "logs", pod.Name, c.Name+".log"), pod, c.Name)
+		}
+	}
+}
+
+func (g *Gatherer) gatherLogs(ctx context.Context, name string, pod *corev1.Pod, container string) {
+	since := int64(g.opts.Since.Seconds())
+	logs, err := g.kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container:    container,
+		TailLines:    &g.opts.LogLines,
+		SinceSeconds: &since,
+	}).DoRaw(ctx)
+	if err != nil {
+		g.recordError(name, err)
+		return
+	}
+	if err := g.writeFile(name, []byte(redactText(string(logs)))); err != nil {
+		g.recordError(name, err)
+	}
+}
+
+// recentEvents returns the events seen within the Since window, oldest first
+func (g *Gatherer) recentEvents(events []corev1.Event) []corev1.Event {
+	cutoff := g.now.Add(-g.opts.Since)
+	recent := []corev1.Event{}
+	for _, e := range events {
+		if eventTime(e).After(cutoff) {
+			recent = append(recent, e)
+		}
+	}
+	sort.SliceStable(recent, func(i, j int) bool { return eventTime(recent[i]).Before(eventTime(recent[j])) })
+	return recent
+}
+
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+// isFeatureFlagConfigMap selects the ConfigMaps holding the configuration
+// of the components, e.g. feature-flags or config-defaults
+func isFeatureFlagConfigMap(name string) bool {
+	return strings.HasPrefix(name, "feature-flags") || strings.HasPrefix(name, "config-") ||
+		strings.HasSuffix(name, "-config") || strings.HasSuffix(name, "-info")
+}
+
+func (g *Gatherer) writeYAML(name string, obj interface{}) {
+	data, err := yaml.Marshal(obj)
+	if err == nil {
+		err = g.writeFile(name, data)
+	}
+	if err != nil {
+		g.recordError(name, err)
+	}
+}
+
+func (g *Gatherer) writeFile(name string, data []byte) error {
+	if err := g.tw.WriteHeader(&tar.Header{
+		Name:    path.Join(rootDir, name),
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: g.now,
+	}); err != nil {
+		return err
+	}
+	_, err := g.tw.Write(data)
+	return err
+}
+
+func (g *Gatherer) recordError(what string, err error) {
+	if apierrs.IsNotFound(err) {
+		// e.g. the CRD of an optional component is not installed
+		return
+	}
+	g.errs = append(g.errs, fmt.Sprintf("%s: %v", what, err))
+}
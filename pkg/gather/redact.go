@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gather
+
+import (
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Redacted replaces the sensitive values in the gathered data
+const Redacted = "REDACTED"
+
+var (
+	// sensitiveName matches the names of environment variables and keys
+	// holding credentials, the Mongo server URL of Tekton Chains carries the
+	// credentials of the database
+	sensitiveName = regexp.MustCompile(`(?i)(password|passwd|secret|token|api[_-]?key|private[_-]?key|credential|auth|mongo[_-]?server[_-]?url)`)
+	// sensitiveText matches "key: value" and "key=value" pairs holding
+	// credentials in logs and configuration files
+	sensitiveText = regexp.MustCompile(`(?i)((?:password|passwd|secret|token|api[_-]?key|private[_-]?key|credentials?)["']?\s*[:=]\s*["']?)([^\s"',}]+)`)
+	// bearerToken matches HTTP authorization headers
+	bearerToken = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+)
+
+// podSpecPaths are the paths of the pod spec in the workload manifests
+var podSpecPaths = map[string][]string{
+	"Pod":         {"spec"},
+	"Deployment":  {"spec", "template", "spec"},
+	"StatefulSet": {"spec", "template", "spec"},
+	"DaemonSet":   {"spec", "template", "spec"},
+	"ReplicaSet":  {"spec", "template", "spec"},
+	"Job":         {"spec", "template", "spec"},
+	"CronJob":     {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// redactObject blanks the data of a Secret manifest, the values of a
+// ConfigMap manifest whose key suggests a credential and the literal
+// credentials in the environment of a workload manifest
+func redactObject(u *unstructured.Unstructured) {
+	kind := u.GetKind()
+	switch {
+	case kind == "Secret":
+		for _, field := range []string{"data", "stringData"} {
+			values, found, err := unstructured.NestedMap(u.Object, field)
+			if err != nil || !found {
+				continue
+			}
+			for k := range values {
+				values[k] = Redacted
+			}
+			_ = unstructured.SetNestedMap(u.Object, values, field)
+		}
+	case kind == "ConfigMap":
+		data, found, err := unstructured.NestedStringMap(u.Object, "data")
+		if err == nil && found {
+			redactConfigData(data)
+			_ = unstructured.SetNestedStringMap(u.Object, data, "data")
+		}
+	case podSpecPaths[kind] != nil:
+		redactManifestPodSpec(u, podSpecPaths[kind])
+	default:
+		return
+	}
+	annotations := u.GetAnnotations()
+	if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; ok {
+		annotations[corev1.LastAppliedConfigAnnotation] = Redacted
+		u.SetAnnotations(annotations)
+	}
+}
+
+// redactResource blanks the values of the spec whose key suggests a
+// credential, e.g. signers.kms.auth.token of TektonChain, the literal values
+// of the environment variables whose name suggests a credential, and the
+// credentials found in the other values of the spec and in the annotations.
+// The last applied configuration holds a copy of the spec and is blanked.
+func redactResource(u *unstructured.Unstructured) {
+	if spec, ok := u.Object["spec"]; ok {
+		u.Object["spec"] = redactValue("", spec)
+	}
+	annotations := u.GetAnnotations()
+	if len(annotations) == 0 {
+		return
+	}
+	for k, v := range annotations {
+		if k == corev1.LastAppliedConfigAnnotation || sensitiveName.MatchString(k) {
+			annotations[k] = Redacted
+			continue
+		}
+		annotations[k] = redactText(v)
+	}
+	u.SetAnnotations(annotations)
+}
+
+// redactValue redacts a value of the spec found under key
+func redactValue(key string, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			v[k] = redactValue(k, field)
+		}
+		// environment variables, e.g. controllerEnvs
+		if name, ok := v["name"].(string); ok && sensitiveName.MatchString(name) {
+			if literal, ok := v["value"].(string); ok && literal != "" {
+				v["value"] = Redacted
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(key, item)
+		}
+		return v
+	case string:
+		return redactKeyValue(key, v)
+	}
+	return value
+}
+
+// redactManifestPodSpec applies redactPodSpec to the pod spec found at path
+// in a workload manifest
+func redactManifestPodSpec(u *unstructured.Unstructured, path []string) {
+	values, found, err := unstructured.NestedMap(u.Object, path...)
+	if err != nil || !found {
+		return
+	}
+	spec := &corev1.PodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(values, spec); err != nil {
+		return
+	}
+	redactPodSpec(spec)
+	if values, err = runtime.DefaultUnstructuredConverter.ToUnstructured(spec); err == nil {
+		_ = unstructured.SetNestedMap(u.Object, values, path...)
+	}
+}
+
+// redactConfigData blanks the values of a ConfigMap whose key suggests a
+// credential, e.g. signers.kms.auth.token of chains-config, and the
+// credentials found in the other values
+func redactConfigData(data map[string]string) {
+	for k, v := range data {
+		data[k] = redactKeyValue(k, v)
+	}
+}
+
+// redactKeyValue blanks value when its key suggests a credential, and the
+// credentials found in it otherwise
+func redactKeyValue(key, value string) string {
+	if value != "" && sensitiveName.MatchString(key) {
+		return Redacted
+	}
+	return redactText(value)
+}
+
+// redactPodSpec blanks the literal values of the environment variables
+// whose name suggests a credential
+func redactPodSpec(spec *corev1.PodSpec) {
+	redact := func(containers []corev1.Container) {
+		for i := range containers {
+			for j := range containers[i].Env {
+				env := &containers[i].Env[j]
+				if env.Value != "" && sensitiveName.MatchString(env.Name) {
+					env.Value = Redacted
+				}
+			}
+		}
+	}
+	redact(spec.InitContainers)
+	redact(spec.Containers)
+}
+
+// redactText blanks the credentials found in logs and configuration values
+func redactText(text string) string {
+	if !strings.ContainsAny(text, ":=") && !strings.Contains(strings.ToLower(text), "bearer") {
+		return text
+	}
+	text = sensitiveText.ReplaceAllString(text, "${1}"+Redacted)
+	return bearerToken.ReplaceAllString(text, "${1}"+Redacted)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobpruner
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ResourcePipelineRun and ResourceTaskRun are the resources pruned
+	ResourcePipelineRun = "pipelinerun"
+	ResourceTaskRun     = "taskrun"
+)
+
+// NamespaceConfig is how the runs of a namespace are pruned
+type NamespaceConfig struct {
+	Namespace string
+	// Keep is the number of most recent runs kept
+	Keep *uint
+	// KeepSince keeps the runs which completed within this number of minutes
+	KeepSince *uint
+	// Resources are the resources pruned, pipelinerun and taskrun
+	Resources []string
+	// PrunePerResource applies keep and keep-since to the runs of each
+	// Pipeline and Task rather than to all the runs of the namespace
+	PrunePerResource bool
+}
+
+// ParseNamespaceConfigs parses the configurations the operator passes to the
+// pruner, separated by spaces. Each configuration is formatted as
+// "namespace;--keep=N,--keep-since=M;resources;prunePerResource", for
+// example "ns-one;--keep=5;pipelinerun,taskrun;false".
+func ParseNamespaceConfigs(s string) ([]NamespaceConfig, error) {
+	configs := []NamespaceConfig{}
+	for _, field := range strings.Fields(s) {
+		parts := strings.Split(field, ";")
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("invalid prune configuration %q, expected 4 fields separated by ';'", field)
+		}
+		cfg := NamespaceConfig{Namespace: parts[0]}
+		if cfg.Namespace == "" {
+			return nil, fmt.Errorf("invalid prune configuration %q, the namespace is missing", field)
+		}
+
+		for _, flag := range strings.Split(parts[1], ",") {
+			if flag == "" {
+				continue
+			}
+			name, value, _ := strings.Cut(flag, "=")
+			n, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of %s in prune configuration %q: %w", name, field, err)
+			}
+			u := uint(n)
+			switch name {
+			case "--keep":
+				cfg.Keep = &u
+			case "--keep-since":
+				cfg.KeepSince = &u
+			default:
+				return nil, fmt.Errorf("unknown flag %s in prune configuration %q", name, field)
+			}
+		}
+		if cfg.Keep == nil && cfg.KeepSince == nil {
+			return nil, fmt.Errorf("invalid prune configuration %q, keep or keep-since is required", field)
+		}
+
+		for _, resource := range strings.Split(parts[2], ",") {
+			if resource != ResourcePipelineRun && resource != ResourceTaskRun {
+				return nil, fmt.Errorf("unknown resource %q in prune configuration %q", resource, field)
+			}
+			cfg.Resources = append(cfg.Resources, resource)
+		}
+
+		perResource, err := strconv.ParseBool(parts[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid prune-per-resource in prune configuration %q: %w", field, err)
+		}
+		cfg.PrunePerResource = perResource
+		configs = append(configs, cfg)
+	}
+	return configs, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobpruner
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseNamespaceConfigs(t *testing.T) {
+	keep := func(v uint) *uint { return &v }
+	tests := []struct {
+		name    string
+		args    string
+		want    []NamespaceConfig
+		wantErr string
+	}{
+		{
+			name: "empty",
+			args: "",
+			want: []NamespaceConfig{},
+		},
+		{
+			name: "multiple namespaces",
+			args: "ns-one;--keep=5;pipelinerun;false ns-two;--keep=4,--keep-since=300;pipelinerun,taskrun;true",
+			want: []NamespaceConfig{
+				{Namespace: "ns-one", Keep: keep(5), Resources: []string{ResourcePipelineRun}},
+				{Namespace: "ns-two", Keep: keep(4), KeepSince: keep(300), Resources: []string{ResourcePipelineRun, ResourceTaskRun}, PrunePerResource: true},
+			},
+		},
+		{
+			name: "keep-since only",
+			args: "ns-one;--keep-since=60;taskrun;false",
+			want: []NamespaceConfig{
+				{Namespace: "ns-one", KeepSince: keep(60), Resources: []string{ResourceTaskRun}},
+			},
+		},
+		{
+			name:    "missing fields",
+			args:    "ns-one;--keep=5;pipelinerun",
+			wantErr: "expected 4 fields",
+		},
+		{
+			name:    "missing namespace",
+			args:    ";--keep=5;pipelinerun;false",
+			wantErr: "the namespace is missing",
+		},
+		{
+			name:    "unknown flag",
+			args:    "ns-one;--max=5;pipelinerun;false",
+			wantErr: "unknown flag --max",
+		},
+		{
+			name:    "invalid keep",
+			args:    "ns-one;--keep=five;pipelinerun;false",
+			wantErr: "invalid value of --keep",
+		},
+		{
+			name:    "missing keep",
+			args:    "ns-one;;pipelinerun;false",
+			wantErr: "keep or keep-since is required",
+		},
+		{
+			name:    "unknown resource",
+			args:    "ns-one;--keep=5;pipelines;false",
+			wantErr: "unknown resource",
+		},
+		{
+			name:    "invalid prune per resource",
+			args:    "ns-one;--keep=5;pipelinerun;yes",
+			wantErr: "invalid prune-per-resource",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseNamespaceConfigs(test.args)
+			if test.wantErr != "" {
+				assert.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, test.want, got)
+		})
+	}
+}
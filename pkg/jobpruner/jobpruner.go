@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobpruner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// maxErrors is the number of errors reported for a namespace
+	maxErrors = 3
+	// listLimit is the number of runs listed per request
+	listLimit = 500
+)
+
+var (
+	resources = map[string]schema.GroupVersionResource{
+		ResourcePipelineRun: {Group: "tekton.dev", Version: "v1", Resource: "pipelineruns"},
+		ResourceTaskRun:     {Group: "tekton.dev", Version: "v1", Resource: "taskruns"},
+	}
+	// parentLabels group the runs by Pipeline and Task when pruning per
+	// resource
+	parentLabels = map[string]string{
+		ResourcePipelineRun: "tekton.dev/pipeline",
+		ResourceTaskRun:     "tekton.dev/task",
+	}
+)
+
+// Pruner deletes the completed PipelineRuns and TaskRuns which are neither
+// among the most recent runs to keep nor completed within keep-since
+type Pruner struct {
+	client dynamic.Interface
+	logger *zap.SugaredLogger
+	now    func() time.Time
+	// resultsGracePeriod keeps the runs not stored in Tekton Results, 0
+	// disables it
+	resultsGracePeriod time.Duration
+}
+
+// New returns a Pruner deleting the runs with the given client
+func New(client dynamic.Interface, logger *zap.SugaredLogger) *Pruner {
+	return &Pruner{client: client, logger: logger, now: time.Now}
+}
+
+// WithResultsGuard keeps the runs which were not stored in Tekton Results
+// until gracePeriod after their completion, 0 disables it
+func (p *Pruner) WithResultsGuard(gracePeriod time.Duration) *Pruner {
+	p.resultsGracePeriod = gracePeriod
+	return p
+}
+
+// Candidates are the runs which would be deleted in dry-run by namespace,
+// formatted as resource/name
+type Candidates map[string][]string
+
+// Prune prunes the runs of the namespaces and summarizes what it did, the
+// failures are reported in the summary. In dry-run no run is deleted, the runs
+// which would be deleted are returned as candidates.
+func (p *Pruner) Prune(ctx context.Context, configs []NamespaceConfig, dryRun bool) (v1alpha1.PruneSummary, Candidates) {
+	summary := v1alpha1.PruneSummary{DryRun: dryRun, Namespaces: []v1alpha1.NamespacePruneSummary{}}
+	var candidates Candidates
+	if dryRun {
+		candidates = Candidates{}
+	}
+	for _, cfg := range configs {
+		ns := p.pruneNamespace(ctx, cfg, candidates)
+		summary.Deleted += ns.Deleted
+		summary.Kept += ns.Kept
+		summary.Failed += ns.Failed
+		summary.Candidates += ns.Candidates
+		summary.Namespaces = append(summary.Namespaces, ns)
+	}
+	summary.CompletionTime = &metav1.Time{Time: p.now()}
+	return summary, candidates
+}
+
+// pruneNamespace prunes the runs of a namespace, or adds them to the
+// candidates when they are not nil
+func (p *Pruner) pruneNamespace(ctx context.Context, cfg NamespaceConfig, candidates Candidates) v1alpha1.NamespacePruneSummary {
+	summary := v1alpha1.NamespacePruneSummary{Namespace: cfg.Namespace}
+	fail := func(err error) {
+		p.logger.Errorw("pruning failed", "namespace", cfg.Namespace, "error", err)
+		summary.Failed++
+		if len(summary.Errors) < maxErrors {
+			summary.Errors = append(summary.Errors, err.Error())
+		}
+	}
+
+	for _, resource := range cfg.Resources {
+		client := p.client.Resource(resources[resource]).Namespace(cfg.Namespace)
+		items := []unstructured.Unstructured{}
+		err := listPages(ctx, client, func(item unstructured.Unstructured) {
+			items = append(items, item)
+		})
+		if err != nil {
+			fail(fmt.Errorf("failed to list %ss: %w", resource, err))
+			continue
+		}
+		for _, runs := range groupRuns(items, cfg.PrunePerResource, parentLabels[resource]) {
+			deleted, kept := selectRuns(runs, cfg.Keep, cfg.KeepSince, p.now())
+			deleted, unstored := p.keepUnstored(deleted)
+			summary.Kept += kept + unstored
+			for _, run := range deleted {
+				if candidates != nil {
+					candidates[cfg.Namespace] = append(candidates[cfg.Namespace], resource+"/"+run.GetName())
+					summary.Candidates++
+					continue
+				}
+				if err := client.Delete(ctx, run.GetName(), metav1.DeleteOptions{}); err != nil {
+					fail(fmt.Errorf("failed to delete %s %s: %w", resource, run.GetName(), err))
+					continue
+				}
+				p.logger.Infow("deleted", "resource", resource, "namespace", cfg.Namespace, "name", run.GetName())
+				summary.Deleted++
+			}
+		}
+		// the runs of no Pipeline or Task are not pruned per resource
+		if cfg.PrunePerResource {
+			summary.Kept += len(items) - countGrouped(items, parentLabels[resource])
+		}
+	}
+	return summary
+}
+
+// listPages lists the runs by pages of listLimit runs and calls fn with each
+// of them
+func listPages(ctx context.Context, client dynamic.ResourceInterface, fn func(unstructured.Unstructured)) error {
+	options := metav1.ListOptions{Limit: listLimit}
+	for {
+		list, err := client.List(ctx, options)
+		if err != nil {
+			return err
+		}
+		for _, item := range list.Items {
+			fn(item)
+		}
+		if list.GetContinue() == "" {
+			return nil
+		}
+		options.Continue = list.GetContinue()
+	}
+}
+
+// groupRuns groups the runs by the Pipeline or Task they run when pruning
+// per resource, and in a single group otherwise
+func groupRuns(runs []unstructured.Unstructured, perResource bool, parentLabel string) [][]unstructured.Unstructured {
+	if !perResource {
+		return [][]unstructured.Unstructured{runs}
+	}
+	byParent := map[string][]unstructured.Unstructured{}
+	for _, run := range runs {
+		if parent := run.GetLabels()[parentLabel]; parent != "" {
+			byParent[parent] = append(byParent[parent], run)
+		}
+	}
+	parents := make([]string, 0, len(byParent))
+	for parent := range byParent {
+		parents = append(parents, parent)
+	}
+	sort.Strings(parents)
+	groups := make([][]unstructured.Unstructured, 0, len(parents))
+	for _, parent := range parents {
+		groups = append(groups, byParent[parent])
+	}
+	return groups
+}
+
+func countGrouped(runs []unstructured.Unstructured, parentLabel string) int {
+	count := 0
+	for _, run := range runs {
+		if run.GetLabels()[parentLabel] != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// selectRuns returns the completed runs to delete and the number of runs
+// kept. A completed run is kept when it is among the keep most recent
+// completed runs, or when it completed within keepSince minutes. Runs which
+// did not complete are always kept.
+func selectRuns(runs []unstructured.Unstructured, keep, keepSince *uint, now time.Time) ([]unstructured.Unstructured, int) {
+	type completedRun struct {
+		run       unstructured.Unstructured
+		completed time.Time
+	}
+	completed := []completedRun{}
+	kept := 0
+	for _, run := range runs {
+		t, ok := completionTime(run)
+		if !ok {
+			kept++
+			continue
+		}
+		completed = append(completed, completedRun{run: run, completed: t})
+	}
+	// most recently completed first
+	sort.SliceStable(completed, func(i, j int) bool {
+		return completed[j].completed.Before(completed[i].completed)
+	})
+
+	deleted := []unstructured.Unstructured{}
+	for i, c := range completed {
+		keptByCount := keep != nil && uint(i) < *keep
+		keptByAge := keepSince != nil && now.Sub(c.completed) < time.Duration(*keepSince)*time.Minute
+		if keptByCount || keptByAge {
+			kept++
+			continue
+		}
+		deleted = append(deleted, c.run)
+	}
+	return deleted, kept
+}
+
+// keepUnstored removes the runs which were not stored in Tekton Results and
+// completed within the grace period from the runs to delete, it returns the
+// remaining runs and the number of runs kept
+func (p *Pruner) keepUnstored(runs []unstructured.Unstructured) ([]unstructured.Unstructured, int) {
+	if p.resultsGracePeriod == 0 {
+		return runs, 0
+	}
+	deletable := make([]unstructured.Unstructured, 0, len(runs))
+	for _, run := range runs {
+		if run.GetAnnotations()[v1alpha1.ResultsStoredAnnotation] != "true" {
+			if t, ok := completionTime(run); ok && p.now().Sub(t) < p.resultsGracePeriod {
+				p.logger.Debugw("kept until stored in results", "namespace", run.GetNamespace(), "name", run.GetName())
+				continue
+			}
+		}
+		deletable = append(deletable, run)
+	}
+	return deletable, len(runs) - len(deletable)
+}
+
+// completionTime returns when the run completed, and false when it is still
+// running
+func completionTime(run unstructured.Unstructured) (time.Time, bool) {
+	value, found, err := unstructured.NestedString(run.Object, "status", "completionTime")
+	if err != nil || !found {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
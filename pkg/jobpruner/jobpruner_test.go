@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobpruner
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// testRun returns a run created minutes ago, which completed a minute later
+// unless running
+func testRun(kind, namespace, name, parent string, minutes int, running bool) runtime.Object {
+	run := &unstructured.Unstructured{}
+	run.SetAPIVersion("tekton.dev/v1")
+	run.SetKind(kind)
+	run.SetNamespace(namespace)
+	run.SetName(name)
+	run.SetCreationTimestamp(metav1.NewTime(now.Add(-time.Duration(minutes) * time.Minute)))
+	if parent != "" {
+		label := parentLabels[ResourcePipelineRun]
+		if kind == "TaskRun" {
+			label = parentLabels[ResourceTaskRun]
+		}
+		run.SetLabels(map[string]string{label: parent})
+	}
+	if !running {
+		completed := now.Add(-time.Duration(minutes-1) * time.Minute).Format(time.RFC3339)
+		_ = unstructured.SetNestedField(run.Object, completed, "status", "completionTime")
+	}
+	return run
+}
+
+func newTestPruner(objects ...runtime.Object) (*Pruner, *dynamicFake.FakeDynamicClient) {
+	client := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[ResourcePipelineRun]: "PipelineRunList",
+			resources[ResourceTaskRun]:     "TaskRunList",
+		}, objects...)
+	p := New(client, zap.NewNop().Sugar())
+	p.now = func() time.Time { return now }
+	return p, client
+}
+
+func remaining(t *testing.T, client *dynamicFake.FakeDynamicClient, resource, namespace string) []string {
+	t.Helper()
+	list, err := client.Resource(resources[resource]).Namespace(namespace).List(context.Background(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestPrune(t *testing.T) {
+	keep := func(v uint) *uint { return &v }
+	objects := []runtime.Object{
+		testRun("PipelineRun", "ns-one", "pr-1", "build", 50, false),
+		testRun("PipelineRun", "ns-one", "pr-2", "build", 40, false),
+		testRun("PipelineRun", "ns-one", "pr-3", "test", 30, false),
+		testRun("PipelineRun", "ns-one", "pr-4", "test", 20, false),
+		testRun("PipelineRun", "ns-one", "pr-5", "", 10, false),
+		testRun("PipelineRun", "ns-one", "pr-running", "build", 60, true),
+		testRun("TaskRun", "ns-one", "tr-1", "lint", 50, false),
+		testRun("TaskRun", "ns-one", "tr-2", "lint", 5, false),
+		testRun("PipelineRun", "ns-two", "pr-1", "", 50, false),
+	}
+	tests := []struct {
+		name         string
+		config       NamespaceConfig
+		deleted      int
+		kept         int
+		pipelineRuns []string
+		taskRuns     []string
+	}{
+		{
+			name:         "keep",
+			config:       NamespaceConfig{Namespace: "ns-one", Keep: keep(2), Resources: []string{ResourcePipelineRun}},
+			deleted:      3,
+			kept:         3,
+			pipelineRuns: []string{"pr-4", "pr-5", "pr-running"},
+			taskRuns:     []string{"tr-1", "tr-2"},
+		},
+		{
+			name:         "keep since",
+			config:       NamespaceConfig{Namespace: "ns-one", KeepSince: keep(25), Resources: []string{ResourcePipelineRun, ResourceTaskRun}},
+			deleted:      4,
+			kept:         4,
+			pipelineRuns: []string{"pr-4", "pr-5", "pr-running"},
+			taskRuns:     []string{"tr-2"},
+		},
+		{
+			name:         "keep or keep since",
+			config:       NamespaceConfig{Namespace: "ns-one", Keep: keep(1), KeepSince: keep(25), Resources: []string{ResourcePipelineRun}},
+			deleted:      3,
+			kept:         3,
+			pipelineRuns: []string{"pr-4", "pr-5", "pr-running"},
+			taskRuns:     []string{"tr-1", "tr-2"},
+		},
+		{
+			name:         "per resource",
+			config:       NamespaceConfig{Namespace: "ns-one", Keep: keep(1), Resources: []string{ResourcePipelineRun, ResourceTaskRun}, PrunePerResource: true},
+			deleted:      3,
+			kept:         5,
+			pipelineRuns: []string{"pr-2", "pr-4", "pr-5", "pr-running"},
+			taskRuns:     []string{"tr-2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, client := newTestPruner(objects...)
+			summary, candidates := p.Prune(context.Background(), []NamespaceConfig{test.config}, false)
+			assert.Assert(t, candidates == nil)
+			assert.Equal(t, test.deleted, summary.Deleted)
+			assert.Equal(t, test.kept, summary.Kept)
+			assert.Equal(t, 0, summary.Failed)
+			assert.Equal(t, 1, len(summary.Namespaces))
+			assert.Equal(t, "ns-one", summary.Namespaces[0].Namespace)
+			assert.DeepEqual(t, test.pipelineRuns, remaining(t, client, ResourcePipelineRun, "ns-one"))
+			assert.DeepEqual(t, test.taskRuns, remaining(t, client, ResourceTaskRun, "ns-one"))
+			// other namespaces are not pruned
+			assert.DeepEqual(t, []string{"pr-1"}, remaining(t, client, ResourcePipelineRun, "ns-two"))
+		})
+	}
+}
+
+func TestPruneKeepsMostRecentlyCompleted(t *testing.T) {
+	keep := uint(1)
+	// pr-1 was created first but completed last
+	longRun := testRun("PipelineRun", "ns-one", "pr-1", "", 50, false)
+	_ = unstructured.SetNestedField(longRun.(*unstructured.Unstructured).Object, now.Add(-5*time.Minute).Format(time.RFC3339), "status", "completionTime")
+	p, client := newTestPruner(
+		longRun,
+		testRun("PipelineRun", "ns-one", "pr-2", "", 40, false),
+	)
+
+	summary, _ := p.Prune(context.Background(), []NamespaceConfig{
+		{Namespace: "ns-one", Keep: &keep, Resources: []string{ResourcePipelineRun}},
+	}, false)
+	assert.Equal(t, 1, summary.Deleted)
+	assert.DeepEqual(t, []string{"pr-1"}, remaining(t, client, ResourcePipelineRun, "ns-one"))
+}
+
+func TestPrunePaging(t *testing.T) {
+	keep := uint(1)
+	p, client := newTestPruner()
+	pages := map[string]*unstructured.UnstructuredList{
+		"":       {Items: []unstructured.Unstructured{*testRun("PipelineRun", "ns-one", "pr-1", "", 50, false).(*unstructured.Unstructured)}},
+		"page-2": {Items: []unstructured.Unstructured{*testRun("PipelineRun", "ns-one", "pr-2", "", 40, false).(*unstructured.Unstructured)}},
+	}
+	pages[""].SetContinue("page-2")
+	client.PrependReactor("list", "pipelineruns", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		options := action.(k8stesting.ListActionImpl).ListOptions
+		assert.Equal(t, int64(listLimit), options.Limit)
+		return true, pages[options.Continue], nil
+	})
+
+	summary, candidates := p.Prune(context.Background(), []NamespaceConfig{
+		{Namespace: "ns-one", Keep: &keep, Resources: []string{ResourcePipelineRun}},
+	}, true)
+	assert.Equal(t, 1, summary.Kept)
+	assert.DeepEqual(t, Candidates{"ns-one": {"pipelinerun/pr-1"}}, candidates)
+}
+
+func TestPruneFailures(t *testing.T) {
+	keep := uint(0)
+	p, client := newTestPruner(
+		testRun("PipelineRun", "ns-one", "pr-1", "", 50, false),
+		testRun("PipelineRun", "ns-one", "pr-2", "", 40, false),
+		testRun("PipelineRun", "ns-two", "pr-1", "", 50, false),
+	)
+	client.PrependReactor("delete", "pipelineruns", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "ns-two" {
+			return true, nil, errors.New("forbidden")
+		}
+		return false, nil, nil
+	})
+
+	summary, _ := p.Prune(context.Background(), []NamespaceConfig{
+		{Namespace: "ns-one", Keep: &keep, Resources: []string{ResourcePipelineRun}},
+		{Namespace: "ns-two", Keep: &keep, Resources: []string{ResourcePipelineRun}},
+	}, false)
+	assert.Equal(t, 2, summary.Deleted)
+	assert.Equal(t, 1, summary.Failed)
+	assert.DeepEqual(t, now, summary.CompletionTime.Time)
+	assert.Equal(t, 2, len(summary.Namespaces))
+	assert.Equal(t, 0, summary.Namespaces[0].Failed)
+	assert.Equal(t, 1, summary.Namespaces[1].Failed)
+	assert.DeepEqual(t, []string{"failed to delete pipelinerun pr-1: forbidden"}, summary.Namespaces[1].Errors)
+}
+
+func TestPruneDryRun(t *testing.T) {
+	keep := uint(1)
+	p, client := newTestPruner(
+		testRun("PipelineRun", "ns-one", "pr-1", "build", 50, false),
+		testRun("PipelineRun", "ns-one", "pr-2", "build", 40, false),
+		testRun("PipelineRun", "ns-one", "pr-3", "build", 30, false),
+		testRun("TaskRun", "ns-one", "tr-1", "lint", 50, false),
+		testRun("TaskRun", "ns-one", "tr-2", "lint", 40, true),
+	)
+
+	summary, candidates := p.Prune(context.Background(), []NamespaceConfig{
+		{Namespace: "ns-one", Keep: &keep, Resources: []string{ResourcePipelineRun, ResourceTaskRun}, PrunePerResource: true},
+	}, true)
+	assert.Assert(t, summary.DryRun)
+	assert.Equal(t, 0, summary.Deleted)
+	assert.Equal(t, 2, summary.Candidates)
+	assert.Equal(t, 3, summary.Kept)
+	assert.Equal(t, 2, summary.Namespaces[0].Candidates)
+	assert.DeepEqual(t, Candidates{"ns-one": {"pipelinerun/pr-2", "pipelinerun/pr-1"}}, candidates)
+	// nothing is deleted
+	assert.DeepEqual(t, []string{"pr-1", "pr-2", "pr-3"}, remaining(t, client, ResourcePipelineRun, "ns-one"))
+	assert.DeepEqual(t, []string{"tr-1", "tr-2"}, remaining(t, client, ResourceTaskRun, "ns-one"))
+}
+
+func TestPruneResultsGuard(t *testing.T) {
+	stored := func(obj runtime.Object) runtime.Object {
+		obj.(*unstructured.Unstructured).SetAnnotations(map[string]string{v1alpha1.ResultsStoredAnnotation: "true"})
+		return obj
+	}
+	keep := uint(1)
+	p, client := newTestPruner(
+		testRun("PipelineRun", "ns-one", "pr-1", "", 300, false),
+		stored(testRun("PipelineRun", "ns-one", "pr-2", "", 50, false)),
+		testRun("PipelineRun", "ns-one", "pr-3", "", 40, false),
+		testRun("PipelineRun", "ns-one", "pr-4", "", 30, false),
+	)
+	p.WithResultsGuard(2 * time.Hour)
+
+	summary, _ := p.Prune(context.Background(), []NamespaceConfig{
+		{Namespace: "ns-one", Keep: &keep, Resources: []string{ResourcePipelineRun}},
+	}, false)
+	// pr-1 completed before the grace period and pr-2 is stored, pr-3 is
+	// kept until it is stored
+	assert.Equal(t, 2, summary.Deleted)
+	assert.Equal(t, 2, summary.Kept)
+	assert.DeepEqual(t, []string{"pr-3", "pr-4"}, remaining(t, client, ResourcePipelineRun, "ns-one"))
+}
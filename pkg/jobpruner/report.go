@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobpruner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// SummaryAnnotation holds the summary of the last run on the CronJob
+	SummaryAnnotation = "operator.tekton.dev/prune-summary"
+
+	// JobNameEnvKey and NamespaceEnvKey locate the Job the pruner runs in
+	JobNameEnvKey   = "JOB_NAME"
+	NamespaceEnvKey = "SYSTEM_NAMESPACE"
+
+	// TerminationMessagePath is where the summary is written for the status
+	// of the container
+	TerminationMessagePath = "/dev/termination-log"
+
+	// ReportSuffix is appended to the name of the CronJob to name the
+	// ConfigMap holding the candidates in dry-run
+	ReportSuffix = "-dry-run"
+	// ReportLabel is set on the ConfigMaps holding the candidates in dry-run
+	ReportLabel = "operator.tekton.dev/prune-dry-run"
+
+	maxTerminationMessageLength = 4096
+	maxReportSize               = 900 * 1024
+)
+
+// Report annotates the CronJob which created the Job with the summary and
+// writes it to the termination message of the container. In dry-run the
+// candidates are written to a ConfigMap named after the CronJob, or after the
+// Job when it was not created by a CronJob.
+func Report(ctx context.Context, kube kubernetes.Interface, namespace, jobName string, summary v1alpha1.PruneSummary, candidates Candidates) error {
+	reportErr := report(ctx, kube, namespace, jobName, &summary, candidates)
+	if err := writeTerminationMessage(TerminationMessagePath, summary); err != nil {
+		return err
+	}
+	return reportErr
+}
+
+func report(ctx context.Context, kube kubernetes.Interface, namespace, jobName string, summary *v1alpha1.PruneSummary, candidates Candidates) error {
+	if jobName == "" {
+		return nil
+	}
+	job, err := kube.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	owner := metav1.OwnerReference{APIVersion: "batch/v1", Kind: "Job", Name: job.Name, UID: job.UID, Controller: ptr.Bool(true)}
+	if ref := metav1.GetControllerOf(job); ref != nil && ref.Kind == "CronJob" {
+		owner = metav1.OwnerReference{APIVersion: ref.APIVersion, Kind: ref.Kind, Name: ref.Name, UID: ref.UID, Controller: ptr.Bool(true)}
+	}
+
+	if summary.DryRun {
+		summary.Report = owner.Name + ReportSuffix
+		if err := WriteReport(ctx, kube, namespace, summary.Report, owner, nil, candidates); err != nil {
+			return err
+		}
+	}
+	if owner.Kind != "CronJob" {
+		return nil
+	}
+
+	out, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": map[string]string{SummaryAnnotation: string(out)}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = kube.BatchV1().CronJobs(namespace).Patch(ctx, owner.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// WriteReport writes the candidates to a ConfigMap, with a key per namespace
+// listing a candidate per line. The ConfigMap is owned by owner, so that it
+// is deleted along with it.
+func WriteReport(ctx context.Context, kube kubernetes.Interface, namespace, name string, owner metav1.OwnerReference, annotations map[string]string, candidates Candidates) error {
+	namespaces := make([]string, 0, len(candidates))
+	for ns := range candidates {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	data := map[string]string{}
+	size := 0
+	for _, ns := range namespaces {
+		runs := candidates[ns]
+		sort.Strings(runs)
+		value := strings.Join(runs, "\n") + "\n"
+		// a ConfigMap is limited to 1MiB
+		if size+len(value) > maxReportSize {
+			value = fmt.Sprintf("# %d candidates, not listed as the report is full\n", len(runs))
+		}
+		size += len(ns) + len(value)
+		data[ns] = value
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			Labels:          map[string]string{ReportLabel: "true"},
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Data: data,
+	}
+	existing, err := kube.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = kube.CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	existing.Labels = cm.Labels
+	existing.Annotations = cm.Annotations
+	existing.OwnerReferences = cm.OwnerReferences
+	existing.Data = cm.Data
+	_, err = kube.CoreV1().ConfigMaps(namespace).Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// writeTerminationMessage writes the totals of the summary and the
+// namespaces where pruning failed, the message is limited to 4096 bytes
+func writeTerminationMessage(path string, summary v1alpha1.PruneSummary) error {
+	failed := FailedNamespaces(summary)
+	out, err := json.Marshal(failed)
+	if err != nil {
+		return err
+	}
+	if len(out) > maxTerminationMessageLength {
+		// keep the totals only
+		failed.Namespaces = nil
+		if out, err = json.Marshal(failed); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to write the termination message: %w", err)
+	}
+	return nil
+}
+
+// FailedNamespaces returns the summary listing only the namespaces where
+// pruning failed
+func FailedNamespaces(summary v1alpha1.PruneSummary) v1alpha1.PruneSummary {
+	failed := summary.DeepCopy()
+	failed.Namespaces = nil
+	for _, ns := range summary.Namespaces {
+		if ns.Failed > 0 {
+			failed.Namespaces = append(failed.Namespaces, ns)
+		}
+	}
+	return *failed
+}
+
+// ParseSummary parses the summary annotated on a CronJob, it returns nil when
+// there is none
+func ParseSummary(annotations map[string]string) (*v1alpha1.PruneSummary, error) {
+	value, ok := annotations[SummaryAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	summary := &v1alpha1.PruneSummary{}
+	if err := json.Unmarshal([]byte(value), summary); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
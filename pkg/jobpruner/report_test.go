@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobpruner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testSummary() v1alpha1.PruneSummary {
+	return v1alpha1.PruneSummary{
+		Deleted: 3,
+		Kept:    2,
+		Failed:  1,
+		Namespaces: []v1alpha1.NamespacePruneSummary{
+			{Namespace: "ns-one", Deleted: 3, Kept: 1},
+			{Namespace: "ns-two", Kept: 1, Failed: 1, Errors: []string{"forbidden"}},
+		},
+	}
+}
+
+func TestReport(t *testing.T) {
+	ctx := context.Background()
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-resource-pruner-abc", Namespace: "tekton-pipelines"},
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "tekton-resource-pruner-abc-123",
+			Namespace:       "tekton-pipelines",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+		},
+	}
+	kube := fake.NewSimpleClientset(cronJob, job)
+
+	assert.NilError(t, Report(ctx, kube, "tekton-pipelines", job.Name, testSummary(), nil))
+
+	got, err := kube.BatchV1().CronJobs("tekton-pipelines").Get(ctx, cronJob.Name, metav1.GetOptions{})
+	assert.NilError(t, err)
+	summary, err := ParseSummary(got.Annotations)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, testSummary(), *summary)
+}
+
+func TestReportWithoutCronJob(t *testing.T) {
+	ctx := context.Background()
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: "tekton-pipelines"}}
+	kube := fake.NewSimpleClientset(job)
+
+	assert.NilError(t, Report(ctx, kube, "tekton-pipelines", job.Name, testSummary(), nil))
+	assert.NilError(t, Report(ctx, kube, "tekton-pipelines", "", testSummary(), nil))
+}
+
+func TestReportDryRun(t *testing.T) {
+	ctx := context.Background()
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-resource-pruner-abc", Namespace: "tekton-pipelines", UID: "cronjob-uid"},
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "tekton-resource-pruner-abc-123",
+			Namespace:       "tekton-pipelines",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+		},
+	}
+	kube := fake.NewSimpleClientset(cronJob, job)
+	summary := v1alpha1.PruneSummary{DryRun: true, Candidates: 3, Kept: 1}
+	candidates := Candidates{
+		"ns-one": {"taskrun/tr-1", "pipelinerun/pr-1"},
+		"ns-two": {"pipelinerun/pr-2"},
+	}
+
+	assert.NilError(t, Report(ctx, kube, "tekton-pipelines", job.Name, summary, candidates))
+
+	report, err := kube.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, "tekton-resource-pruner-abc-dry-run", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, map[string]string{
+		"ns-one": "pipelinerun/pr-1\ntaskrun/tr-1\n",
+		"ns-two": "pipelinerun/pr-2\n",
+	}, report.Data)
+	assert.Equal(t, "true", report.Labels[ReportLabel])
+	assert.Equal(t, 1, len(report.OwnerReferences))
+	assert.Equal(t, "CronJob", report.OwnerReferences[0].Kind)
+	assert.Equal(t, cronJob.UID, report.OwnerReferences[0].UID)
+
+	got, err := kube.BatchV1().CronJobs("tekton-pipelines").Get(ctx, cronJob.Name, metav1.GetOptions{})
+	assert.NilError(t, err)
+	annotated, err := ParseSummary(got.Annotations)
+	assert.NilError(t, err)
+	assert.Equal(t, "tekton-resource-pruner-abc-dry-run", annotated.Report)
+	assert.Equal(t, 3, annotated.Candidates)
+
+	// the report is updated by the next run
+	assert.NilError(t, Report(ctx, kube, "tekton-pipelines", job.Name, summary, Candidates{}))
+	report, err = kube.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, "tekton-resource-pruner-abc-dry-run", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(report.Data))
+}
+
+func TestWriteReportLimit(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset()
+	large := []string{}
+	for i := 0; i < maxReportSize/40; i++ {
+		large = append(large, fmt.Sprintf("pipelinerun/pr-%010d", i))
+	}
+	candidates := Candidates{"ns-a": large, "ns-b": large}
+
+	assert.NilError(t, WriteReport(ctx, kube, "tekton-pipelines", "report", metav1.OwnerReference{}, nil, candidates))
+	report, err := kube.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, "report", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(report.Data["ns-a"], "pipelinerun/pr-"))
+	assert.Equal(t, fmt.Sprintf("# %d candidates, not listed as the report is full\n", len(large)), report.Data["ns-b"])
+}
+
+func TestWriteTerminationMessage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "termination-log")
+	assert.NilError(t, writeTerminationMessage(path, testSummary()))
+	out, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"deleted":3,"kept":2,"failed":1,"namespaces":[{"namespace":"ns-two","deleted":0,"kept":1,"failed":1,"errors":["forbidden"]}]}`, string(out))
+
+	// only the totals are written when the namespaces do not fit
+	summary := testSummary()
+	summary.Namespaces[1].Errors = []string{strings.Repeat("x", maxTerminationMessageLength)}
+	assert.NilError(t, writeTerminationMessage(path, summary))
+	out, err = os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"deleted":3,"kept":2,"failed":1}`, string(out))
+}
+
+func TestParseSummary(t *testing.T) {
+	summary, err := ParseSummary(map[string]string{})
+	assert.NilError(t, err)
+	assert.Assert(t, summary == nil)
+
+	_, err = ParseSummary(map[string]string{SummaryAnnotation: "{"})
+	assert.ErrorContains(t, err, "unexpected end of JSON input")
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobpruner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pruner/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// prunerRules are the rules of the global config of TektonPruner which apply
+// to a namespace
+type prunerRules struct {
+	ttl        *int32
+	successful int32
+	failed     int32
+}
+
+// run holds what is needed to evaluate the rules on a run
+type run struct {
+	name      string
+	created   time.Time
+	completed *time.Time
+	succeeded bool
+	// stored is true once the run is stored in Tekton Results
+	stored bool
+}
+
+// notEvaluated are the rules of TektonPruner which Evaluate does not
+// evaluate, the candidates may include runs they would keep
+var notEvaluated = []string{
+	"the pruner ConfigMaps of the namespaces",
+	"the selectors of the namespaces of the global config",
+}
+
+// Evaluate reports the runs which the global config of TektonPruner would
+// prune, without deleting them. The TTL and the history limits of the global
+// config and of its namespaces are evaluated, and the runs kept by the results
+// guard are not candidates. The namespace ConfigMaps and the selectors are not
+// evaluated, which is reported in the summary. The TaskRuns of PipelineRuns are
+// pruned with their PipelineRun and are not evaluated.
+func (p *Pruner) Evaluate(ctx context.Context, globalConfig *config.GlobalConfig) (v1alpha1.PruneSummary, Candidates, error) {
+	if globalConfig == nil {
+		globalConfig = &config.GlobalConfig{}
+	}
+	summary := v1alpha1.PruneSummary{DryRun: true, NotEvaluated: append([]string{}, notEvaluated...)}
+	candidates := Candidates{}
+	now := p.now()
+	for _, resource := range []string{ResourcePipelineRun, ResourceTaskRun} {
+		byNamespace, err := p.listRuns(ctx, resource)
+		if err != nil {
+			return summary, nil, fmt.Errorf("failed to list %ss: %w", resource, err)
+		}
+		for namespace, runs := range byNamespace {
+			expired := 0
+			for _, r := range selectExpiredRuns(runs, resolveRules(globalConfig, namespace), now) {
+				if p.keptUntilStored(r, now) {
+					continue
+				}
+				candidates[namespace] = append(candidates[namespace], resource+"/"+r.name)
+				expired++
+			}
+			summary.Candidates += expired
+			summary.Kept += len(runs) - expired
+		}
+	}
+	summary.CompletionTime = &metav1.Time{Time: now}
+	return summary, candidates, nil
+}
+
+// listRuns lists the runs of all the namespaces by pages, keeping only what is
+// needed to evaluate the rules
+func (p *Pruner) listRuns(ctx context.Context, resource string) (map[string][]run, error) {
+	byNamespace := map[string][]run{}
+	err := listPages(ctx, p.client.Resource(resources[resource]), func(item unstructured.Unstructured) {
+		if resource == ResourceTaskRun && item.GetLabels()[config.LabelPipelineRunName] != "" {
+			return
+		}
+		r := run{
+			name:    item.GetName(),
+			created: item.GetCreationTimestamp().Time,
+			stored:  item.GetAnnotations()[v1alpha1.ResultsStoredAnnotation] == "true",
+		}
+		if t, ok := completionTime(item); ok {
+			r.completed = &t
+			r.succeeded = succeeded(item)
+		}
+		byNamespace[item.GetNamespace()] = append(byNamespace[item.GetNamespace()], r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return byNamespace, nil
+}
+
+// resolveRules returns the rules of a namespace, the settings of the
+// namespace take precedence over the global ones
+func resolveRules(globalConfig *config.GlobalConfig, namespace string) prunerRules {
+	global := globalConfig.PrunerConfig
+	ns := globalConfig.Namespaces[namespace].PrunerConfig
+	defaultLimit := int32(config.DefaultHistoryLimit)
+	return prunerRules{
+		ttl:        firstOf(ns.TTLSecondsAfterFinished, global.TTLSecondsAfterFinished),
+		successful: *firstOf(ns.SuccessfulHistoryLimit, ns.HistoryLimit, global.SuccessfulHistoryLimit, global.HistoryLimit, &defaultLimit),
+		failed:     *firstOf(ns.FailedHistoryLimit, ns.HistoryLimit, global.FailedHistoryLimit, global.HistoryLimit, &defaultLimit),
+	}
+}
+
+func firstOf(values ...*int32) *int32 {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// keptUntilStored returns true when the results guard keeps the run, which
+// was not stored in Tekton Results and completed within the grace period
+func (p *Pruner) keptUntilStored(r run, now time.Time) bool {
+	return p.resultsGracePeriod > 0 && !r.stored && now.Sub(*r.completed) < p.resultsGracePeriod
+}
+
+// selectExpiredRuns returns the completed runs which expired their TTL, or
+// which exceed the history limit of the successful or of the failed runs of
+// the namespace
+func selectExpiredRuns(runs []run, rules prunerRules, now time.Time) []run {
+	successful, failed := []run{}, []run{}
+	for _, r := range runs {
+		switch {
+		case r.completed == nil:
+		case r.succeeded:
+			successful = append(successful, r)
+		default:
+			failed = append(failed, r)
+		}
+	}
+
+	expired := []run{}
+	for _, group := range []struct {
+		runs  []run
+		limit int32
+	}{{successful, rules.successful}, {failed, rules.failed}} {
+		// most recent first
+		sort.SliceStable(group.runs, func(i, j int) bool { return group.runs[j].created.Before(group.runs[i].created) })
+		for i, r := range group.runs {
+			ttlExpired := rules.ttl != nil && !now.Before(r.completed.Add(time.Duration(*rules.ttl)*time.Second))
+			if ttlExpired || int32(i) >= group.limit {
+				expired = append(expired, r)
+			}
+		}
+	}
+	return expired
+}
+
+// succeeded returns whether the Succeeded condition of the run is true
+func succeeded(run unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(run.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == "Succeeded" {
+			return condition["status"] == "True"
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobpruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pruner/pkg/config"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/ptr"
+)
+
+// testCompletedRun returns a completed run with its Succeeded condition
+func testCompletedRun(kind, namespace, name string, minutes int, success bool, labels map[string]string) runtime.Object {
+	run := testRun(kind, namespace, name, "", minutes, false).(*unstructured.Unstructured)
+	status := "False"
+	if success {
+		status = "True"
+	}
+	_ = unstructured.SetNestedSlice(run.Object, []interface{}{
+		map[string]interface{}{"type": "Succeeded", "status": status},
+	}, "status", "conditions")
+	if labels != nil {
+		run.SetLabels(labels)
+	}
+	return run
+}
+
+func TestEvaluate(t *testing.T) {
+	p, client := newTestPruner(
+		testCompletedRun("PipelineRun", "ns-one", "pr-s1", 50, true, nil),
+		testCompletedRun("PipelineRun", "ns-one", "pr-s2", 40, true, nil),
+		testCompletedRun("PipelineRun", "ns-one", "pr-s3", 30, true, nil),
+		testCompletedRun("PipelineRun", "ns-one", "pr-f1", 45, false, nil),
+		testCompletedRun("PipelineRun", "ns-one", "pr-f2", 35, false, nil),
+		testRun("PipelineRun", "ns-one", "pr-running", "", 60, true),
+		testCompletedRun("TaskRun", "ns-one", "tr-of-pr", 300, false, map[string]string{config.LabelPipelineRunName: "pr-s1"}),
+		testCompletedRun("TaskRun", "ns-one", "tr-1", 200, true, nil),
+		testCompletedRun("PipelineRun", "ns-two", "pr-old", 30, true, nil),
+		testCompletedRun("PipelineRun", "ns-two", "pr-new", 5, true, nil),
+	)
+	globalConfig := &config.GlobalConfig{
+		PrunerConfig: config.PrunerConfig{
+			SuccessfulHistoryLimit: ptr.Int32(2),
+			FailedHistoryLimit:     ptr.Int32(1),
+		},
+		Namespaces: map[string]config.NamespaceSpec{
+			"ns-two": {PrunerConfig: config.PrunerConfig{TTLSecondsAfterFinished: ptr.Int32(600)}},
+		},
+	}
+
+	summary, candidates, err := p.Evaluate(context.Background(), globalConfig)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, Candidates{
+		"ns-one": {"pipelinerun/pr-s1", "pipelinerun/pr-f1"},
+		"ns-two": {"pipelinerun/pr-old"},
+	}, candidates)
+	assert.Assert(t, summary.DryRun)
+	assert.Equal(t, 3, summary.Candidates)
+	assert.Equal(t, 6, summary.Kept)
+	assert.DeepEqual(t, now, summary.CompletionTime.Time)
+	// nothing is deleted
+	assert.Equal(t, 6, len(remaining(t, client, ResourcePipelineRun, "ns-one")))
+}
+
+var cmpRules = cmp.AllowUnexported(prunerRules{})
+
+func TestResolveRules(t *testing.T) {
+	globalConfig := &config.GlobalConfig{
+		PrunerConfig: config.PrunerConfig{
+			TTLSecondsAfterFinished: ptr.Int32(3600),
+			HistoryLimit:            ptr.Int32(10),
+			FailedHistoryLimit:      ptr.Int32(3),
+		},
+		Namespaces: map[string]config.NamespaceSpec{
+			"ns-one": {PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(5)}},
+			"ns-two": {PrunerConfig: config.PrunerConfig{TTLSecondsAfterFinished: ptr.Int32(60), SuccessfulHistoryLimit: ptr.Int32(1)}},
+		},
+	}
+	tests := []struct {
+		namespace string
+		want      prunerRules
+	}{
+		{namespace: "other", want: prunerRules{ttl: ptr.Int32(3600), successful: 10, failed: 3}},
+		{namespace: "ns-one", want: prunerRules{ttl: ptr.Int32(3600), successful: 5, failed: 5}},
+		{namespace: "ns-two", want: prunerRules{ttl: ptr.Int32(60), successful: 1, failed: 3}},
+	}
+	for _, test := range tests {
+		t.Run(test.namespace, func(t *testing.T) {
+			assert.DeepEqual(t, test.want, resolveRules(globalConfig, test.namespace), cmpRules)
+		})
+	}
+
+	// the default history limit applies without config
+	assert.DeepEqual(t, prunerRules{successful: config.DefaultHistoryLimit, failed: config.DefaultHistoryLimit},
+		resolveRules(&config.GlobalConfig{}, "ns-one"), cmpRules)
+}
+
+func TestEvaluateResultsGuard(t *testing.T) {
+	stored := func(obj runtime.Object) runtime.Object {
+		obj.(*unstructured.Unstructured).SetAnnotations(map[string]string{v1alpha1.ResultsStoredAnnotation: "true"})
+		return obj
+	}
+	p, _ := newTestPruner(
+		testCompletedRun("PipelineRun", "ns-one", "pr-1", 300, true, nil),
+		stored(testCompletedRun("PipelineRun", "ns-one", "pr-2", 50, true, nil)),
+		testCompletedRun("PipelineRun", "ns-one", "pr-3", 40, true, nil),
+		testCompletedRun("PipelineRun", "ns-one", "pr-4", 30, true, nil),
+	)
+	p.WithResultsGuard(2 * time.Hour)
+	globalConfig := &config.GlobalConfig{PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(1)}}
+
+	summary, candidates, err := p.Evaluate(context.Background(), globalConfig)
+	assert.NilError(t, err)
+	// pr-1 completed before the grace period and pr-2 is stored, pr-3 is
+	// kept until it is stored
+	assert.DeepEqual(t, Candidates{"ns-one": {"pipelinerun/pr-2", "pipelinerun/pr-1"}}, candidates)
+	assert.Equal(t, 2, summary.Candidates)
+	assert.Equal(t, 2, summary.Kept)
+	assert.DeepEqual(t, notEvaluated, summary.NotEvaluated)
+}
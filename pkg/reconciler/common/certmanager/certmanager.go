@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certmanager issues the TLS certificates of the components with
+// cert-manager when TektonConfig selects the certManager TLS provider.
+package certmanager
+
+import (
+	"fmt"
+
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+)
+
+// GroupVersion is the cert-manager API the operator creates Certificates with
+const GroupVersion = "cert-manager.io/v1"
+
+// Certificate describes a certificate of a component
+type Certificate struct {
+	// Name of the Certificate
+	Name string
+	// SecretName is the Secret the certificate is stored in, mounted by the
+	// component
+	SecretName string
+	// DNSNames the certificate is issued for
+	DNSNames []string
+	// Rotation sets the duration of the certificate and when cert-manager
+	// renews it, cert-manager defaults apply when unset
+	Rotation v1alpha1.RotationPolicy
+}
+
+// Available returns an error when the cert-manager API is not served
+func Available(client discovery.DiscoveryInterface) error {
+	if _, err := client.ServerResourcesForGroupVersion(GroupVersion); err != nil {
+		return fmt.Errorf("cert-manager (%s) is required by the %s TLS provider: %w", GroupVersion, v1alpha1.TLSProviderCertManager, err)
+	}
+	return nil
+}
+
+// IssuerRef returns the cert-manager reference of the issuer, an Issuer of
+// cert-manager.io by default
+func IssuerRef(ref *v1alpha1.IssuerReference) cmmeta.IssuerReference {
+	if ref == nil {
+		return cmmeta.IssuerReference{}
+	}
+	issuer := cmmeta.IssuerReference{Name: ref.Name, Kind: ref.Kind, Group: ref.Group}
+	if issuer.Kind == "" {
+		issuer.Kind = "Issuer"
+	}
+	if issuer.Group == "" {
+		issuer.Group = v1alpha1.CertManagerGroup
+	}
+	return issuer
+}
+
+// Generate builds the manifest of the Certificate issued by the issuer of
+// the TLS configuration in namespace
+func Generate(tls v1alpha1.TLSConfig, namespace string, cert Certificate) (mf.Manifest, error) {
+	obj := &certv1.Certificate{
+		TypeMeta:   metav1.TypeMeta{Kind: "Certificate", APIVersion: GroupVersion},
+		ObjectMeta: metav1.ObjectMeta{Name: cert.Name, Namespace: namespace},
+		Spec: certv1.CertificateSpec{
+			SecretName: cert.SecretName,
+			DNSNames:   cert.DNSNames,
+			IssuerRef:  IssuerRef(tls.IssuerRef),
+			Usages:     []certv1.KeyUsage{certv1.UsageServerAuth, certv1.UsageDigitalSignature, certv1.UsageKeyEncipherment},
+		},
+	}
+	if len(cert.DNSNames) > 0 {
+		obj.Spec.CommonName = cert.DNSNames[0]
+	}
+	if cert.Rotation.Validity != nil {
+		obj.Spec.Duration = cert.Rotation.Validity
+	}
+	if cert.Rotation.RenewBefore != nil {
+		obj.Spec.RenewBefore = cert.Rotation.RenewBefore
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return mf.Manifest{}, fmt.Errorf("converting Certificate %q: %w", cert.Name, err)
+	}
+	u := unstructured.Unstructured{}
+	u.SetUnstructuredContent(content)
+	// the status of a new object is not part of the desired state
+	unstructured.RemoveNestedField(u.Object, "status")
+	return mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{u}))
+}
+
+// IssuerTransformer issues the Certificates of a release with the issuer of
+// the TLS configuration instead of the issuer shipped with the release. It
+// leaves them untouched with the selfSigned provider.
+func IssuerTransformer(tls v1alpha1.TLSConfig) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "Certificate" || !tls.IsCertManager() {
+			return nil
+		}
+		issuer := IssuerRef(tls.IssuerRef)
+		return unstructured.SetNestedStringMap(u.Object, map[string]string{
+			"name":  issuer.Name,
+			"kind":  issuer.Kind,
+			"group": issuer.Group,
+		}, "spec", "issuerRef")
+	}
+}
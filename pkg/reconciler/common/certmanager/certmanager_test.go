@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager_test
+
+import (
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common/certmanager"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func releaseCertificate() unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": certmanager.GroupVersion,
+		"kind":       "Certificate",
+		"metadata":   map[string]interface{}{"name": "webhook", "namespace": "tekton-pipelines"},
+		"spec": map[string]interface{}{
+			"secretName": "webhook-tls",
+			"issuerRef":  map[string]interface{}{"name": "selfsigned", "kind": "Issuer"},
+		},
+	}}
+}
+
+func TestIssuerTransformer(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{releaseCertificate()}))
+	assert.NilError(t, err)
+
+	// the issuer of the release is kept with the selfSigned provider
+	selfSigned, err := manifest.Transform(certmanager.IssuerTransformer(v1alpha1.TLSConfig{}))
+	assert.NilError(t, err)
+	issuer, _, _ := unstructured.NestedStringMap(selfSigned.Resources()[0].Object, "spec", "issuerRef")
+	assert.DeepEqual(t, issuer, map[string]string{"name": "selfsigned", "kind": "Issuer"})
+
+	tls := v1alpha1.TLSConfig{Provider: v1alpha1.TLSProviderCertManager, IssuerRef: &v1alpha1.IssuerReference{Name: "ca", Kind: "ClusterIssuer"}}
+	issued, err := manifest.Transform(certmanager.IssuerTransformer(tls))
+	assert.NilError(t, err)
+	issuer, _, _ = unstructured.NestedStringMap(issued.Resources()[0].Object, "spec", "issuerRef")
+	assert.DeepEqual(t, issuer, map[string]string{"name": "ca", "kind": "ClusterIssuer", "group": "cert-manager.io"})
+}
+
+func TestGenerate(t *testing.T) {
+	tls := v1alpha1.TLSConfig{Provider: v1alpha1.TLSProviderCertManager, IssuerRef: &v1alpha1.IssuerReference{Name: "ca"}}
+	m, err := certmanager.Generate(tls, "tekton-pipelines", certmanager.Certificate{
+		Name:       "api",
+		SecretName: "api-tls",
+		DNSNames:   []string{"api.tekton-pipelines.svc.cluster.local", "api.example.com"},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(m.Resources()), 1)
+
+	u := m.Resources()[0]
+	assert.Equal(t, u.GetAPIVersion(), certmanager.GroupVersion)
+	assert.Equal(t, u.GetNamespace(), "tekton-pipelines")
+	commonName, _, _ := unstructured.NestedString(u.Object, "spec", "commonName")
+	assert.Equal(t, commonName, "api.tekton-pipelines.svc.cluster.local")
+	issuer, _, _ := unstructured.NestedStringMap(u.Object, "spec", "issuerRef")
+	assert.DeepEqual(t, issuer, map[string]string{"name": "ca", "kind": "Issuer", "group": "cert-manager.io"})
+	_, hasStatus := u.Object["status"]
+	assert.Assert(t, !hasStatus)
+	_, hasDuration := u.Object["spec"].(map[string]interface{})["duration"]
+	assert.Assert(t, !hasDuration)
+}
+
+func TestGenerate_Rotation(t *testing.T) {
+	tls := v1alpha1.TLSConfig{Provider: v1alpha1.TLSProviderCertManager, IssuerRef: &v1alpha1.IssuerReference{Name: "ca"}}
+	m, err := certmanager.Generate(tls, "tekton-pipelines", certmanager.Certificate{
+		Name:       "api",
+		SecretName: "api-tls",
+		DNSNames:   []string{"api.tekton-pipelines.svc.cluster.local"},
+		Rotation: v1alpha1.RotationPolicy{
+			Validity:    &metav1.Duration{Duration: 720 * time.Hour},
+			RenewBefore: &metav1.Duration{Duration: 168 * time.Hour},
+		},
+	})
+	assert.NilError(t, err)
+
+	spec := m.Resources()[0].Object["spec"].(map[string]interface{})
+	assert.Equal(t, spec["duration"], "720h0m0s")
+	assert.Equal(t, spec["renewBefore"], "168h0m0s")
+}
+
+func TestAvailable(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	assert.ErrorContains(t, certmanager.Available(kube.Discovery()), "cert-manager (cert-manager.io/v1) is required")
+
+	kube.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{GroupVersion: certmanager.GroupVersion}}
+	assert.NilError(t, certmanager.Available(kube.Discovery()))
+}
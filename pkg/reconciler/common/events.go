@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8scache "k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+)
+
+// Reasons of the events emitted on TektonConfig and the component CRs for
+// the transitions of their lifecycle
+const (
+	ReasonInstallStarted        = "InstallStarted"
+	ReasonInstallCompleted      = "InstallCompleted"
+	ReasonUpgradeStarted        = "UpgradeStarted"
+	ReasonUpgradeCompleted      = "UpgradeCompleted"
+	ReasonUpgradeFailed         = "UpgradeFailed"
+	ReasonComponentReady        = "ComponentReady"
+	ReasonComponentNotReady     = "ComponentNotReady"
+	ReasonComponentDisabled     = "ComponentDisabled"
+	ReasonConfigurationRejected = "ConfigurationRejected"
+	ReasonRequeueLoop           = "RequeueLoop"
+)
+
+// emittedEvents keeps the last message of the events emitted on the
+// objects, keyed by object UID and by reason, or reason and component
+var emittedEvents = struct {
+	sync.Mutex
+	messages map[types.UID]map[string]string
+}{messages: map[types.UID]map[string]string{}}
+
+func eventKey(reason, component string) string {
+	if component == "" {
+		return reason
+	}
+	return reason + "/" + component
+}
+
+// EmitEvent emits an event on obj with the event recorder of the context.
+// Reconciles run the same code paths again and again, so an event is not
+// emitted again while its message is the last one emitted for the object and
+// the reason, until the reason is resolved with ResolveEvent
+func EmitEvent(ctx context.Context, obj metav1.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	emitEvent(ctx, obj, "", eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// EmitComponentEvent is EmitEvent for the events of a component reported on
+// another object, such as TektonConfig, the events of each component are
+// deduplicated on their own
+func EmitComponentEvent(ctx context.Context, obj metav1.Object, component, eventType, reason, messageFmt string, args ...interface{}) {
+	emitEvent(ctx, obj, component, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func emitEvent(ctx context.Context, obj metav1.Object, component, eventType, reason, message string) {
+	ro, ok := obj.(runtime.Object)
+	recorder := controller.GetEventRecorder(ctx)
+	if !ok || recorder == nil {
+		return
+	}
+	key := eventKey(reason, component)
+
+	emittedEvents.Lock()
+	defer emittedEvents.Unlock()
+	messages := emittedEvents.messages[obj.GetUID()]
+	if last, ok := messages[key]; ok && last == message {
+		return
+	}
+	if messages == nil {
+		messages = map[string]string{}
+		emittedEvents.messages[obj.GetUID()] = messages
+	}
+	messages[key] = message
+	recorder.Event(ro, eventType, reason, message)
+}
+
+// ResolveEvent forgets the events emitted for the reasons on obj, so their
+// next occurrence is emitted again
+func ResolveEvent(obj metav1.Object, reasons ...string) {
+	ResolveComponentEvent(obj, "", reasons...)
+}
+
+// ResolveComponentEvent forgets the events emitted for the reasons of a
+// component on obj, so their next occurrence is emitted again
+func ResolveComponentEvent(obj metav1.Object, component string, reasons ...string) {
+	emittedEvents.Lock()
+	defer emittedEvents.Unlock()
+	messages := emittedEvents.messages[obj.GetUID()]
+	for _, reason := range reasons {
+		delete(messages, eventKey(reason, component))
+	}
+	if len(messages) == 0 {
+		delete(emittedEvents.messages, obj.GetUID())
+	}
+}
+
+// ForgetEvents forgets all the events emitted on obj, it is called when obj
+// is deleted
+func ForgetEvents(obj metav1.Object) {
+	emittedEvents.Lock()
+	defer emittedEvents.Unlock()
+	delete(emittedEvents.messages, obj.GetUID())
+}
+
+// ForgetEventsOnDelete is an informer DeleteFunc which forgets the events of
+// the deleted objects, for the kinds which have no finalizer
+func ForgetEventsOnDelete(obj interface{}) {
+	if tombstone, ok := obj.(k8scache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if o, ok := obj.(metav1.Object); ok {
+		ForgetEvents(o)
+	}
+}
+
+// EmitConfigurationRejected emits a warning event on obj for a configuration
+// the operator cannot apply
+func EmitConfigurationRejected(ctx context.Context, obj metav1.Object, message string) {
+	EmitEvent(ctx, obj, corev1.EventTypeWarning, ReasonConfigurationRejected, "%s", message)
+}
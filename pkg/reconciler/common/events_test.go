@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+)
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestEmitEventDeduplicates(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.Background(), recorder)
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: "pipeline", UID: types.UID("uid-1")}}
+
+	EmitEvent(ctx, tp, corev1.EventTypeNormal, ReasonInstallStarted, "Installing %s %s", "TektonPipeline", "v0.70.0")
+	EmitEvent(ctx, tp, corev1.EventTypeNormal, ReasonInstallStarted, "Installing %s %s", "TektonPipeline", "v0.70.0")
+	assert.DeepEqual(t, drainEvents(recorder), []string{"Normal InstallStarted Installing TektonPipeline v0.70.0"})
+
+	// a different message or object is emitted
+	EmitEvent(ctx, tp, corev1.EventTypeNormal, ReasonInstallStarted, "Installing %s %s", "TektonPipeline", "v0.71.0")
+	other := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: "pipeline", UID: types.UID("uid-2")}}
+	EmitEvent(ctx, other, corev1.EventTypeNormal, ReasonInstallStarted, "Installing %s %s", "TektonPipeline", "v0.70.0")
+	assert.DeepEqual(t, drainEvents(recorder), []string{
+		"Normal InstallStarted Installing TektonPipeline v0.71.0",
+		"Normal InstallStarted Installing TektonPipeline v0.70.0",
+	})
+
+	// a resolved reason is emitted again on its next occurrence
+	EmitConfigurationRejected(ctx, tp, "invalid")
+	EmitConfigurationRejected(ctx, tp, "invalid")
+	ResolveEvent(tp, ReasonConfigurationRejected)
+	EmitConfigurationRejected(ctx, tp, "invalid")
+	assert.DeepEqual(t, drainEvents(recorder), []string{
+		"Warning ConfigurationRejected invalid",
+		"Warning ConfigurationRejected invalid",
+	})
+
+	// only the last message is kept, going back to a previous one emits it
+	EmitEvent(ctx, tp, corev1.EventTypeNormal, ReasonInstallStarted, "Installing %s %s", "TektonPipeline", "v0.70.0")
+	assert.DeepEqual(t, drainEvents(recorder), []string{"Normal InstallStarted Installing TektonPipeline v0.70.0"})
+
+	// the events of the components are deduplicated on their own
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: "config", UID: types.UID("uid-3")}}
+	EmitComponentEvent(ctx, tc, "TektonPipeline", corev1.EventTypeWarning, ReasonComponentNotReady, "not ready")
+	EmitComponentEvent(ctx, tc, "TektonTrigger", corev1.EventTypeWarning, ReasonComponentNotReady, "not ready")
+	EmitComponentEvent(ctx, tc, "TektonPipeline", corev1.EventTypeWarning, ReasonComponentNotReady, "not ready")
+	ResolveComponentEvent(tc, "TektonTrigger", ReasonComponentNotReady)
+	EmitComponentEvent(ctx, tc, "TektonPipeline", corev1.EventTypeWarning, ReasonComponentNotReady, "not ready")
+	EmitComponentEvent(ctx, tc, "TektonTrigger", corev1.EventTypeWarning, ReasonComponentNotReady, "not ready")
+	assert.Equal(t, len(drainEvents(recorder)), 3)
+
+	// the events of a deleted object are forgotten
+	ForgetEvents(tc)
+	ForgetEventsOnDelete(tp)
+	emittedEvents.Lock()
+	assert.Equal(t, len(emittedEvents.messages[tc.GetUID()]), 0)
+	assert.Equal(t, len(emittedEvents.messages[tp.GetUID()]), 0)
+	emittedEvents.Unlock()
+
+	// nothing is emitted without a recorder
+	EmitEvent(context.Background(), tp, corev1.EventTypeNormal, ReasonUpgradeStarted, "Upgrading")
+	assert.Equal(t, len(drainEvents(recorder)), 0)
+}
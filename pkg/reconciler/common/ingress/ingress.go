@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ingress generates the Ingress or the Gateway API route exposing a
+// component outside of the cluster.
+package ingress
+
+import (
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// Backend is the Service of a component exposed by the Ingress or the route
+type Backend struct {
+	// Name of the Ingress or the route
+	Name string
+	// Service and Port the traffic is sent to
+	Service string
+	Port    int32
+	// Annotations required by the component, e.g. the protocol of the
+	// backend. The annotations of the configuration take precedence
+	Annotations map[string]string
+	// TLS is set when the Service serves TLS, the Gateway API routes then
+	// come with a BackendTLSPolicy validating its certificate
+	TLS *BackendTLS
+}
+
+// BackendTLS describes how the Gateway validates the certificate of a Service
+type BackendTLS struct {
+	// Hostname the certificate of the Service is valid for
+	Hostname string
+	// CAConfigMap is the ConfigMap holding the CA of the certificate in ca.crt
+	CAConfigMap string
+}
+
+// Generate builds the manifest of the Ingress or the route configured by
+// cfg in namespace. It returns an empty manifest when cfg is not enabled.
+func Generate(cfg v1alpha1.IngressConfig, namespace string, backend Backend) (mf.Manifest, error) {
+	if !cfg.Enabled {
+		return mf.Manifest{}, nil
+	}
+
+	meta := metav1.ObjectMeta{
+		Name:        backend.Name,
+		Namespace:   namespace,
+		Annotations: annotations(backend.Annotations, cfg.Annotations),
+	}
+
+	var objs []apimachineryRuntime.Object
+	switch cfg.GetType() {
+	case v1alpha1.IngressTypeIngress:
+		objs = append(objs, newIngress(cfg, meta, backend))
+	case v1alpha1.IngressTypeHTTPRoute:
+		objs = append(objs, newHTTPRoute(cfg, meta, backend))
+	case v1alpha1.IngressTypeGRPCRoute:
+		objs = append(objs, newGRPCRoute(cfg, meta, backend))
+	default:
+		return mf.Manifest{}, fmt.Errorf("unsupported ingress type %q", cfg.Type)
+	}
+	if cfg.GetType() != v1alpha1.IngressTypeIngress && backend.TLS != nil {
+		objs = append(objs, newBackendTLSPolicy(namespace, backend))
+	}
+
+	resources := make([]unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		content, err := apimachineryRuntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return mf.Manifest{}, fmt.Errorf("converting %s %q: %w", obj.GetObjectKind().GroupVersionKind().Kind, backend.Name, err)
+		}
+		u := unstructured.Unstructured{}
+		u.SetUnstructuredContent(content)
+		// the status of a new object is not part of the desired state
+		unstructured.RemoveNestedField(u.Object, "status")
+		resources = append(resources, u)
+	}
+	return mf.ManifestFrom(mf.Slice(resources))
+}
+
+func newIngress(cfg v1alpha1.IngressConfig, meta metav1.ObjectMeta, backend Backend) *networkingv1.Ingress {
+	ing := &networkingv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: networkingv1.SchemeGroupVersion.String()},
+		ObjectMeta: meta,
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: cfg.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     cfg.GetPath(),
+						PathType: ptr.To(networkingv1.PathTypePrefix),
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: backend.Service,
+							Port: networkingv1.ServiceBackendPort{Number: backend.Port},
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+	if cfg.ClassName != "" {
+		ing.Spec.IngressClassName = ptr.To(cfg.ClassName)
+	}
+	if cfg.TLSSecret != "" {
+		ing.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{cfg.Host}, SecretName: cfg.TLSSecret}}
+	}
+	return ing
+}
+
+func newHTTPRoute(cfg v1alpha1.IngressConfig, meta metav1.ObjectMeta, backend Backend) *gatewayv1.HTTPRoute {
+	return &gatewayv1.HTTPRoute{
+		TypeMeta:   metav1.TypeMeta{Kind: "HTTPRoute", APIVersion: gatewayv1.GroupVersion.String()},
+		ObjectMeta: meta,
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: commonRouteSpec(cfg),
+			Hostnames:       hostnames(cfg),
+			Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+						Value: ptr.To(cfg.GetPath()),
+					},
+				}},
+				BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: backendRef(backend)}},
+			}},
+		},
+	}
+}
+
+func newGRPCRoute(cfg v1alpha1.IngressConfig, meta metav1.ObjectMeta, backend Backend) *gatewayv1.GRPCRoute {
+	return &gatewayv1.GRPCRoute{
+		TypeMeta:   metav1.TypeMeta{Kind: "GRPCRoute", APIVersion: gatewayv1.GroupVersion.String()},
+		ObjectMeta: meta,
+		Spec: gatewayv1.GRPCRouteSpec{
+			CommonRouteSpec: commonRouteSpec(cfg),
+			Hostnames:       hostnames(cfg),
+			// a rule without matches forwards all the services of the host
+			Rules: []gatewayv1.GRPCRouteRule{{
+				BackendRefs: []gatewayv1.GRPCBackendRef{{BackendRef: backendRef(backend)}},
+			}},
+		},
+	}
+}
+
+func newBackendTLSPolicy(namespace string, backend Backend) *gatewayv1.BackendTLSPolicy {
+	return &gatewayv1.BackendTLSPolicy{
+		TypeMeta:   metav1.TypeMeta{Kind: "BackendTLSPolicy", APIVersion: gatewayv1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: backend.Name, Namespace: namespace},
+		Spec: gatewayv1.BackendTLSPolicySpec{
+			TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{{
+				LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+					Kind: "Service",
+					Name: gatewayv1.ObjectName(backend.Service),
+				},
+			}},
+			Validation: gatewayv1.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayv1.LocalObjectReference{{
+					Kind: "ConfigMap",
+					Name: gatewayv1.ObjectName(backend.TLS.CAConfigMap),
+				}},
+				Hostname: gatewayv1.PreciseHostname(backend.TLS.Hostname),
+			},
+		},
+	}
+}
+
+func commonRouteSpec(cfg v1alpha1.IngressConfig) gatewayv1.CommonRouteSpec {
+	parent := gatewayv1.ParentReference{Name: gatewayv1.ObjectName(cfg.Gateway.Name)}
+	if cfg.Gateway.Namespace != "" {
+		parent.Namespace = ptr.To(gatewayv1.Namespace(cfg.Gateway.Namespace))
+	}
+	if cfg.Gateway.SectionName != "" {
+		parent.SectionName = ptr.To(gatewayv1.SectionName(cfg.Gateway.SectionName))
+	}
+	return gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{parent}}
+}
+
+func hostnames(cfg v1alpha1.IngressConfig) []gatewayv1.Hostname {
+	if cfg.Host == "" {
+		return nil
+	}
+	return []gatewayv1.Hostname{gatewayv1.Hostname(cfg.Host)}
+}
+
+func backendRef(backend Backend) gatewayv1.BackendRef {
+	return gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{
+		Name: gatewayv1.ObjectName(backend.Service),
+		Port: ptr.To(backend.Port),
+	}}
+}
+
+func annotations(defaults, overrides map[string]string) map[string]string {
+	if len(defaults) == 0 && len(overrides) == 0 {
+		return nil
+	}
+	merged := make(map[string]string, len(defaults)+len(overrides))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
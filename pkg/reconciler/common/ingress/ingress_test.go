@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress_test
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common/ingress"
+	"gotest.tools/v3/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+var backend = ingress.Backend{
+	Name:        "tekton-dashboard",
+	Service:     "tekton-dashboard",
+	Port:        9097,
+	Annotations: map[string]string{"example.com/backend-protocol": "HTTP"},
+}
+
+func TestGenerate_Disabled(t *testing.T) {
+	m, err := ingress.Generate(v1alpha1.IngressConfig{Host: "tekton.example.com"}, "tekton-pipelines", backend)
+	assert.NilError(t, err)
+	assert.Equal(t, len(m.Resources()), 0)
+}
+
+func TestGenerate_Ingress(t *testing.T) {
+	cfg := v1alpha1.IngressConfig{
+		Enabled:     true,
+		Host:        "tekton.example.com",
+		ClassName:   "nginx",
+		TLSSecret:   "tekton-dashboard-tls",
+		Annotations: map[string]string{"cert-manager.io/cluster-issuer": "letsencrypt", "example.com/backend-protocol": "HTTPS"},
+	}
+	m, err := ingress.Generate(cfg, "tekton-pipelines", backend)
+	assert.NilError(t, err)
+	assert.Equal(t, len(m.Resources()), 1)
+
+	u := m.Resources()[0]
+	assert.Equal(t, u.GetKind(), "Ingress")
+	assert.Equal(t, u.GetNamespace(), "tekton-pipelines")
+	// the annotations of the configuration take precedence
+	assert.DeepEqual(t, u.GetAnnotations(), map[string]string{
+		"cert-manager.io/cluster-issuer": "letsencrypt",
+		"example.com/backend-protocol":   "HTTPS",
+	})
+
+	ing := &networkingv1.Ingress{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, ing))
+	assert.Equal(t, *ing.Spec.IngressClassName, "nginx")
+	assert.DeepEqual(t, ing.Spec.TLS, []networkingv1.IngressTLS{{Hosts: []string{"tekton.example.com"}, SecretName: "tekton-dashboard-tls"}})
+	rule := ing.Spec.Rules[0]
+	assert.Equal(t, rule.Host, "tekton.example.com")
+	path := rule.HTTP.Paths[0]
+	assert.Equal(t, path.Path, "/")
+	assert.Equal(t, *path.PathType, networkingv1.PathTypePrefix)
+	assert.Equal(t, path.Backend.Service.Name, "tekton-dashboard")
+	assert.Equal(t, path.Backend.Service.Port.Number, int32(9097))
+}
+
+func TestGenerate_HTTPRoute(t *testing.T) {
+	cfg := v1alpha1.IngressConfig{
+		Enabled: true,
+		Type:    v1alpha1.IngressTypeHTTPRoute,
+		Host:    "tekton.example.com",
+		Path:    "/dashboard",
+		Gateway: &v1alpha1.GatewayReference{Name: "public", Namespace: "gateways", SectionName: "https"},
+	}
+	m, err := ingress.Generate(cfg, "tekton-pipelines", backend)
+	assert.NilError(t, err)
+	assert.Equal(t, len(m.Resources()), 1)
+
+	u := m.Resources()[0]
+	assert.Equal(t, u.GetKind(), "HTTPRoute")
+	assert.Equal(t, u.GetAPIVersion(), "gateway.networking.k8s.io/v1")
+
+	route := &gatewayv1.HTTPRoute{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, route))
+	parent := route.Spec.ParentRefs[0]
+	assert.Equal(t, string(parent.Name), "public")
+	assert.Equal(t, string(*parent.Namespace), "gateways")
+	assert.Equal(t, string(*parent.SectionName), "https")
+	assert.DeepEqual(t, route.Spec.Hostnames, []gatewayv1.Hostname{"tekton.example.com"})
+	rule := route.Spec.Rules[0]
+	assert.Equal(t, *rule.Matches[0].Path.Value, "/dashboard")
+	assert.Equal(t, string(rule.BackendRefs[0].Name), "tekton-dashboard")
+	assert.Equal(t, *rule.BackendRefs[0].Port, int32(9097))
+}
+
+func TestGenerate_GRPCRoute(t *testing.T) {
+	cfg := v1alpha1.IngressConfig{
+		Enabled: true,
+		Type:    v1alpha1.IngressTypeGRPCRoute,
+		Host:    "results.example.com",
+		Gateway: &v1alpha1.GatewayReference{Name: "public"},
+	}
+	grpcBackend := ingress.Backend{
+		Name:    "tekton-results-api",
+		Service: "tekton-results-api-service",
+		Port:    8080,
+		TLS: &ingress.BackendTLS{
+			Hostname:    "tekton-results-api-service.tekton-pipelines.svc.cluster.local",
+			CAConfigMap: "tekton-results-api-ca",
+		},
+	}
+	m, err := ingress.Generate(cfg, "tekton-pipelines", grpcBackend)
+	assert.NilError(t, err)
+	assert.Equal(t, len(m.Resources()), 2)
+
+	route := &gatewayv1.GRPCRoute{}
+	assert.Equal(t, m.Resources()[0].GetKind(), "GRPCRoute")
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(m.Resources()[0].Object, route))
+	assert.Equal(t, string(route.Spec.ParentRefs[0].Name), "public")
+	assert.Assert(t, route.Spec.ParentRefs[0].Namespace == nil)
+	assert.DeepEqual(t, route.Spec.Hostnames, []gatewayv1.Hostname{"results.example.com"})
+	rule := route.Spec.Rules[0]
+	assert.Equal(t, len(rule.Matches), 0)
+	assert.Equal(t, string(rule.BackendRefs[0].Name), "tekton-results-api-service")
+	assert.Equal(t, *rule.BackendRefs[0].Port, int32(8080))
+
+	policy := &gatewayv1.BackendTLSPolicy{}
+	assert.Equal(t, m.Resources()[1].GetKind(), "BackendTLSPolicy")
+	assert.Equal(t, m.Resources()[1].GetNamespace(), "tekton-pipelines")
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(m.Resources()[1].Object, policy))
+	assert.Equal(t, string(policy.Spec.TargetRefs[0].Kind), "Service")
+	assert.Equal(t, string(policy.Spec.TargetRefs[0].Name), "tekton-results-api-service")
+	assert.Equal(t, string(policy.Spec.Validation.CACertificateRefs[0].Name), "tekton-results-api-ca")
+	assert.Equal(t, string(policy.Spec.Validation.Hostname), "tekton-results-api-service.tekton-pipelines.svc.cluster.local")
+}
+
+func TestGenerate_IngressWithBackendTLS(t *testing.T) {
+	tlsBackend := backend
+	tlsBackend.TLS = &ingress.BackendTLS{Hostname: "tekton-dashboard.tekton-pipelines.svc", CAConfigMap: "ca"}
+	m, err := ingress.Generate(v1alpha1.IngressConfig{Enabled: true}, "tekton-pipelines", tlsBackend)
+	assert.NilError(t, err)
+	// the ingress controller is configured through the annotations
+	assert.Equal(t, len(m.Resources()), 1)
+	assert.Equal(t, m.Resources()[0].GetKind(), "Ingress")
+}
@@ -84,6 +84,17 @@ func (ctrl Controller) InitController(ctx context.Context, opts PayloadOptions)
 	return manifest, releaseVersion
 }
 
+// SourceManifest returns the payload manifest of the component, the same one
+// InitController loads, without creating a client for it
+func (ctrl Controller) SourceManifest(ctx context.Context, opts PayloadOptions) (mf.Manifest, error) {
+	manifest := mf.Manifest{}
+	ctrl.Manifest = &manifest
+	if err := ctrl.fetchSourceManifests(ctx, opts); err != nil {
+		return mf.Manifest{}, err
+	}
+	return manifest, nil
+}
+
 // fetchSourceManifests mutates the passed manifest by appending one
 // appropriate for the passed TektonComponent
 func (ctrl Controller) fetchSourceManifests(ctx context.Context, opts PayloadOptions) error {
@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+)
+
+const meterName = "github.com/tektoncd/operator"
+
+// Values of the result attribute shared by the operator metrics
+const (
+	ResultSuccess = "success"
+	ResultRequeue = "requeue"
+	ResultError   = "error"
+)
+
+// instruments holds the operator metrics. They are created from the
+// global MeterProvider, which sharedmain configures from the
+// config-observability ConfigMap and exports on the metrics port.
+type instruments struct {
+	reconcileDuration metric.Float64Histogram
+	reconcileErrors   metric.Int64Counter
+	componentReady    metric.Int64ObservableGauge
+	componentInfo     metric.Int64ObservableGauge
+	installs          metric.Int64Counter
+	installerSetApply metric.Int64Counter
+	drifts            metric.Int64Counter
+	upgradeSteps      metric.Int64Counter
+
+	mu         sync.Mutex
+	components map[string]componentState
+}
+
+type componentState struct {
+	name    string
+	version string
+	ready   bool
+}
+
+var metrics = newInstruments(otel.Meter(meterName))
+
+func newInstruments(meter metric.Meter) *instruments {
+	m := &instruments{components: map[string]componentState{}}
+	// the API only returns an error alongside a usable no-op instrument,
+	// so errors are reported to the otel error handler and ignored here
+	var errs []error
+	var err error
+	m.reconcileDuration, err = meter.Float64Histogram("tekton_operator_reconcile_duration",
+		metric.WithDescription("Duration of a reconcile of an operator resource"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60))
+	errs = append(errs, err)
+	m.reconcileErrors, err = meter.Int64Counter("tekton_operator_reconcile_errors",
+		metric.WithDescription("Number of reconciles of an operator resource which returned an error"))
+	errs = append(errs, err)
+	m.componentReady, err = meter.Int64ObservableGauge("tekton_operator_component_ready",
+		metric.WithDescription("Whether a component managed by the operator is ready (1) or not (0)"))
+	errs = append(errs, err)
+	m.componentInfo, err = meter.Int64ObservableGauge("tekton_operator_component_info",
+		metric.WithDescription("Installed version of a component managed by the operator, always 1"))
+	errs = append(errs, err)
+	m.installs, err = meter.Int64Counter("tekton_operator_component_installs",
+		metric.WithDescription("Number of installs and upgrades of a component"))
+	errs = append(errs, err)
+	m.installerSetApply, err = meter.Int64Counter("tekton_operator_installerset_applies",
+		metric.WithDescription("Number of times the manifests of a TektonInstallerSet were applied"))
+	errs = append(errs, err)
+	m.drifts, err = meter.Int64Counter("tekton_operator_drifts",
+		metric.WithDescription("Number of resources found drifted from their TektonInstallerSet"))
+	errs = append(errs, err)
+	m.upgradeSteps, err = meter.Int64Counter("tekton_operator_upgrade_steps",
+		metric.WithDescription("Number of pre and post upgrade steps run by the operator"))
+	errs = append(errs, err)
+	_, err = meter.RegisterCallback(m.observeComponents, m.componentReady, m.componentInfo)
+	errs = append(errs, err)
+	if err := errors.Join(errs...); err != nil {
+		otel.Handle(err)
+	}
+	return m
+}
+
+func (m *instruments) observeComponents(_ context.Context, o metric.Observer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for kind, state := range m.components {
+		ready := int64(0)
+		if state.ready {
+			ready = 1
+		}
+		o.ObserveInt64(m.componentReady, ready, metric.WithAttributes(
+			attribute.String("kind", kind), attribute.String("name", state.name)))
+		if state.version != "" {
+			o.ObserveInt64(m.componentInfo, 1, metric.WithAttributes(
+				attribute.String("kind", kind), attribute.String("name", state.name),
+				attribute.String("version", state.version)))
+		}
+	}
+	return nil
+}
+
+// RecordComponentStatus records the readiness and the installed version
+// of a component, reported by the tekton_operator_component_ready and
+// tekton_operator_component_info gauges
+func RecordComponentStatus(kind, name, version string, ready bool) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	metrics.components[kind] = componentState{name: name, version: version, ready: ready}
+}
+
+// ForgetComponentStatus stops reporting the gauges of a component which
+// is no longer installed
+func ForgetComponentStatus(kind string) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	delete(metrics.components, kind)
+}
+
+// RecordInstallerSetApply counts an apply of the manifests of an installer
+// set created by the given kind, err being the outcome of the apply
+func RecordInstallerSetApply(ctx context.Context, createdBy string, err error) {
+	metrics.installerSetApply.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("created_by", createdBy), attribute.String("result", resultOf(err))))
+}
+
+// RecordDrift counts a resource of an installer set found drifted,
+// reverted tells whether the operator restored the desired state
+func RecordDrift(ctx context.Context, createdBy, resourceKind string, reverted bool) {
+	action := "reported"
+	if reverted {
+		action = "reverted"
+	}
+	metrics.drifts.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("created_by", createdBy), attribute.String("resource_kind", resourceKind),
+		attribute.String("action", action)))
+}
+
+// RecordUpgradeStep counts the outcome of a pre or post upgrade step
+func RecordUpgradeStep(ctx context.Context, phase, step string, err error) {
+	metrics.upgradeSteps.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("phase", phase), attribute.String("step", step),
+		attribute.String("result", resultOf(err))))
+}
+
+func resultOf(err error) string {
+	if err == nil {
+		return ResultSuccess
+	}
+	if ok, _ := controller.IsRequeueKey(err); ok {
+		return ResultRequeue
+	}
+	return ResultError
+}
+
+// Recorder counts the installs and upgrades of a component done through
+// the installer set client
+type Recorder struct {
+	kind string
+}
+
+// NewRecorder returns a Recorder for the component of the given kind
+func NewRecorder(kind string) (*Recorder, error) {
+	return &Recorder{kind: kind}, nil
+}
+
+func (m *Recorder) LogMetrics(status, version string, logger *zap.SugaredLogger) {
+	metrics.installs.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("kind", m.kind), attribute.String("operation", status),
+		attribute.String("version", version)))
+	logger.Debugw("recorded component install", "kind", m.kind, "operation", status, "version", version)
+}
+
+// InstrumentReconciler wraps the reconciler of a controller so that the
+// duration and the errors of every reconcile are recorded, labelled with
+// the given name. Leader election calls are forwarded to the wrapped
+// reconciler.
+func InstrumentReconciler(name string, r controller.Reconciler) controller.Reconciler {
+	return &instrumentedReconciler{name: name, Reconciler: r}
+}
+
+type instrumentedReconciler struct {
+	controller.Reconciler
+	name string
+}
+
+var _ reconciler.LeaderAware = (*instrumentedReconciler)(nil)
+
+func (r *instrumentedReconciler) Reconcile(ctx context.Context, key string) error {
+	start := time.Now()
+	err := r.Reconciler.Reconcile(ctx, key)
+	result := resultOf(err)
+	metrics.reconcileDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("reconciler", r.name), attribute.String("result", result)))
+	if result == ResultError {
+		metrics.reconcileErrors.Add(ctx, 1, metric.WithAttributes(attribute.String("reconciler", r.name)))
+	}
+	return err
+}
+
+func (r *instrumentedReconciler) Promote(b reconciler.Bucket, enq func(reconciler.Bucket, types.NamespacedName)) error {
+	if la, ok := r.Reconciler.(reconciler.LeaderAware); ok {
+		return la.Promote(b, enq)
+	}
+	return nil
+}
+
+func (r *instrumentedReconciler) Demote(b reconciler.Bucket) {
+	if la, ok := r.Reconciler.(reconciler.LeaderAware); ok {
+		la.Demote(b)
+	}
+}
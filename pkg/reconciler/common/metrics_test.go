@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/reconciler"
+)
+
+// setupMetrics points the operator metrics to a manual reader for the test
+func setupMetrics(t *testing.T) *sdkmetric.ManualReader {
+	t.Helper()
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	saved := metrics
+	metrics = newInstruments(provider.Meter(meterName))
+	t.Cleanup(func() {
+		metrics = saved
+		_ = provider.Shutdown(context.Background())
+	})
+	return reader
+}
+
+// collect returns the data points of the named metric keyed by their
+// attributes, histograms are reported by their count
+func collect(t *testing.T, reader *sdkmetric.ManualReader, name string) map[string]int64 {
+	t.Helper()
+	rm := metricdata.ResourceMetrics{}
+	assert.NilError(t, reader.Collect(context.Background(), &rm))
+	points := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					points[attrs(dp.Attributes)] = dp.Value
+				}
+			case metricdata.Gauge[int64]:
+				for _, dp := range data.DataPoints {
+					points[attrs(dp.Attributes)] = dp.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					points[attrs(dp.Attributes)] = int64(dp.Count)
+				}
+			}
+		}
+	}
+	return points
+}
+
+func attrs(set attribute.Set) string {
+	s := ""
+	for _, kv := range set.ToSlice() {
+		if s != "" {
+			s += ","
+		}
+		s += string(kv.Key) + "=" + kv.Value.Emit()
+	}
+	return s
+}
+
+type fakeReconciler struct {
+	reconciler.LeaderAwareFuncs
+	err error
+}
+
+func (r *fakeReconciler) Reconcile(context.Context, string) error {
+	return r.err
+}
+
+func TestInstrumentReconciler(t *testing.T) {
+	reader := setupMetrics(t)
+	ctx := context.Background()
+
+	failing := &fakeReconciler{err: errors.New("boom")}
+	r := InstrumentReconciler(v1alpha1.KindTektonPipeline, failing)
+	assert.ErrorContains(t, r.Reconcile(ctx, "pipeline"), "boom")
+	assert.NilError(t, InstrumentReconciler(v1alpha1.KindTektonPipeline, &fakeReconciler{}).Reconcile(ctx, "pipeline"))
+	assert.Equal(t, InstrumentReconciler(v1alpha1.KindTektonConfig, &fakeReconciler{err: v1alpha1.REQUEUE_EVENT_AFTER}).Reconcile(ctx, "config"),
+		v1alpha1.REQUEUE_EVENT_AFTER)
+
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_reconcile_duration"), map[string]int64{
+		"reconciler=TektonPipeline,result=error":   1,
+		"reconciler=TektonPipeline,result=success": 1,
+		"reconciler=TektonConfig,result=requeue":   1,
+	})
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_reconcile_errors"), map[string]int64{
+		"reconciler=TektonPipeline": 1,
+	})
+
+	// leader election is forwarded to the wrapped reconciler
+	promoted := false
+	failing.PromoteFunc = func(reconciler.Bucket, func(reconciler.Bucket, types.NamespacedName)) error {
+		promoted = true
+		return nil
+	}
+	la, ok := r.(reconciler.LeaderAware)
+	assert.Assert(t, ok)
+	assert.NilError(t, la.Promote(reconciler.UniversalBucket(), func(reconciler.Bucket, types.NamespacedName) {}))
+	assert.Assert(t, promoted)
+}
+
+func TestComponentStatusMetrics(t *testing.T) {
+	reader := setupMetrics(t)
+
+	RecordComponentStatus(v1alpha1.KindTektonPipeline, "pipeline", "v0.70.0", true)
+	RecordComponentStatus(v1alpha1.KindTektonChain, "chain", "", false)
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_component_ready"), map[string]int64{
+		"kind=TektonPipeline,name=pipeline": 1,
+		"kind=TektonChain,name=chain":       0,
+	})
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_component_info"), map[string]int64{
+		"kind=TektonPipeline,name=pipeline,version=v0.70.0": 1,
+	})
+
+	ForgetComponentStatus(v1alpha1.KindTektonChain)
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_component_ready"), map[string]int64{
+		"kind=TektonPipeline,name=pipeline": 1,
+	})
+}
+
+func TestInstallerSetAndUpgradeMetrics(t *testing.T) {
+	reader := setupMetrics(t)
+	ctx := context.Background()
+
+	RecordInstallerSetApply(ctx, v1alpha1.KindTektonPipeline, nil)
+	RecordInstallerSetApply(ctx, v1alpha1.KindTektonPipeline, nil)
+	RecordInstallerSetApply(ctx, v1alpha1.KindTektonPipeline, errors.New("boom"))
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_installerset_applies"), map[string]int64{
+		"created_by=TektonPipeline,result=success": 2,
+		"created_by=TektonPipeline,result=error":   1,
+	})
+
+	RecordDrift(ctx, v1alpha1.KindTektonPipeline, "Deployment", true)
+	RecordDrift(ctx, v1alpha1.KindTektonPipeline, "ConfigMap", false)
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_drifts"), map[string]int64{
+		"action=reverted,created_by=TektonPipeline,resource_kind=Deployment": 1,
+		"action=reported,created_by=TektonPipeline,resource_kind=ConfigMap":  1,
+	})
+
+	RecordUpgradeStep(ctx, "pre", "upgradePipelineProperties", nil)
+	RecordUpgradeStep(ctx, "post", "upgradeStorageVersion", errors.New("boom"))
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_upgrade_steps"), map[string]int64{
+		"phase=pre,result=success,step=upgradePipelineProperties": 1,
+		"phase=post,result=error,step=upgradeStorageVersion":      1,
+	})
+
+	recorder, err := NewRecorder(v1alpha1.KindTektonPipeline)
+	assert.NilError(t, err)
+	recorder.LogMetrics("Upgrade", "v0.70.0", zap.NewNop().Sugar())
+	assert.DeepEqual(t, collect(t, reader, "tekton_operator_component_installs"), map[string]int64{
+		"kind=TektonPipeline,operation=Upgrade,version=v0.70.0": 1,
+	})
+}
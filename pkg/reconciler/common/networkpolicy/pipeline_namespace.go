@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpolicy
+
+import (
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// PipelineNamespaceDefaultDenyName is the default-deny policy of the
+	// TaskRun pods in the pipeline namespaces
+	PipelineNamespaceDefaultDenyName = "tekton-taskrun-default-deny"
+	// PipelineNamespaceEgressName is the policy allowing the egress of the
+	// TaskRun pods in the pipeline namespaces
+	PipelineNamespaceEgressName = "tekton-taskrun-egress"
+)
+
+// TaskRunPodSelector matches the pods of the TaskRuns, the other workloads of
+// the pipeline namespaces are left alone
+var TaskRunPodSelector = metav1.LabelSelector{
+	MatchExpressions: []metav1.LabelSelectorRequirement{
+		{Key: "tekton.dev/taskRun", Operator: metav1.LabelSelectorOpExists},
+	},
+}
+
+// PipelineNamespaceParams holds the services which the TaskRun pods of the
+// pipeline namespaces may reach
+type PipelineNamespaceParams struct {
+	// TargetNamespace of the Tekton components
+	TargetNamespace string
+	// APIServer is the egress rule to the API server
+	APIServer networkingv1.NetworkPolicyEgressRule
+	// ResultsAPIPort is the port of the Results API, 0 when TektonResult is
+	// not installed
+	ResultsAPIPort int32
+	// Egress are the additional egress rules configured by the user
+	Egress []networkingv1.NetworkPolicyEgressRule
+}
+
+// PipelineNamespacePolicies returns the default-deny policy of the TaskRun
+// pods and the policy allowing their egress to DNS, the API server, the
+// Tekton webhook and resolvers, the Results API and the configured targets
+func PipelineNamespacePolicies(platform PlatformParams, p PipelineNamespaceParams) []networkingv1.NetworkPolicy {
+	tcp := corev1.ProtocolTCP
+	webhookPort := intstr.FromInt32(8443)
+	resolverPort := intstr.FromInt32(8080)
+	targetNamespace := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"kubernetes.io/metadata.name": p.TargetNamespace},
+	}
+
+	egress := []networkingv1.NetworkPolicyEgressRule{
+		DNSEgressRule(platform),
+		p.APIServer,
+		{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: targetNamespace,
+					PodSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      "app",
+								Operator: metav1.LabelSelectorOpIn,
+								Values:   []string{"tekton-pipelines-webhook", "tekton-pipelines-resolvers"},
+							},
+						},
+					},
+				},
+			},
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &tcp, Port: &webhookPort},
+				{Protocol: &tcp, Port: &resolverPort},
+			},
+		},
+	}
+	if p.ResultsAPIPort != 0 {
+		resultsPort := intstr.FromInt32(p.ResultsAPIPort)
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: targetNamespace,
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "tekton-results-api"},
+					},
+				},
+			},
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &tcp, Port: &resultsPort},
+			},
+		})
+	}
+	egress = append(egress, p.Egress...)
+
+	return []networkingv1.NetworkPolicy{
+		DefaultDenyPolicy(PipelineNamespaceDefaultDenyName, TaskRunPodSelector),
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: PipelineNamespaceEgressName},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: TaskRunPodSelector,
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Egress:      egress,
+			},
+		},
+	}
+}
+
+// APIServerEndpointsEgressRule allows egress to the addresses and ports of
+// the endpoints of the kubernetes Service. It returns false when the
+// endpoints have no address, APIServerEgressRule is then the only option.
+func APIServerEndpointsEgressRule(endpoints *corev1.Endpoints) (networkingv1.NetworkPolicyEgressRule, bool) {
+	rule := networkingv1.NetworkPolicyEgressRule{}
+	seenPorts := map[int32]bool{}
+	for _, subset := range endpoints.Subsets {
+		for _, address := range subset.Addresses {
+			ip := net.ParseIP(address.IP)
+			if ip == nil {
+				continue
+			}
+			cidr := address.IP + "/32"
+			if ip.To4() == nil {
+				cidr = address.IP + "/128"
+			}
+			rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+		}
+		for _, port := range subset.Ports {
+			if seenPorts[port.Port] {
+				continue
+			}
+			seenPorts[port.Port] = true
+			protocol := port.Protocol
+			if protocol == "" {
+				protocol = corev1.ProtocolTCP
+			}
+			value := intstr.FromInt32(port.Port)
+			rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &value})
+		}
+	}
+	if len(rule.To) == 0 {
+		return networkingv1.NetworkPolicyEgressRule{}, false
+	}
+	return rule, true
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpolicy_test
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/reconciler/common/networkpolicy"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPipelineNamespacePolicies(t *testing.T) {
+	tcp := corev1.ProtocolTCP
+	gitPort := intstr.FromInt32(9418)
+	userEgress := networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &gitPort}},
+	}
+	policies := networkpolicy.PipelineNamespacePolicies(networkpolicy.KubernetesPlatformDefaults(), networkpolicy.PipelineNamespaceParams{
+		TargetNamespace: "tekton-pipelines",
+		APIServer:       networkpolicy.APIServerEgressRule(),
+		ResultsAPIPort:  8080,
+		Egress:          []networkingv1.NetworkPolicyEgressRule{userEgress},
+	})
+	if len(policies) != 2 {
+		t.Fatalf("expected 2 policies, got %d", len(policies))
+	}
+
+	deny := policies[0]
+	if deny.Name != networkpolicy.PipelineNamespaceDefaultDenyName || len(deny.Spec.Egress) != 0 || len(deny.Spec.Ingress) != 0 {
+		t.Errorf("expected a default-deny policy, got %+v", deny)
+	}
+	if len(deny.Spec.PodSelector.MatchExpressions) != 1 || deny.Spec.PodSelector.MatchExpressions[0].Key != "tekton.dev/taskRun" {
+		t.Errorf("expected the TaskRun pods to be selected, got %+v", deny.Spec.PodSelector)
+	}
+
+	egress := policies[1]
+	if egress.Name != networkpolicy.PipelineNamespaceEgressName {
+		t.Errorf("expected %s, got %s", networkpolicy.PipelineNamespaceEgressName, egress.Name)
+	}
+	// DNS, API server, webhook and resolvers, Results API and the user rule
+	if got := len(egress.Spec.Egress); got != 5 {
+		t.Fatalf("expected 5 egress rules, got %d", got)
+	}
+	if got := egress.Spec.Egress[3].To[0].PodSelector.MatchLabels["app"]; got != "tekton-results-api" {
+		t.Errorf("expected an egress rule to the Results API, got %q", got)
+	}
+	if got := egress.Spec.Egress[4].Ports[0].Port.IntValue(); got != 9418 {
+		t.Errorf("expected the user egress rule last, got port %d", got)
+	}
+
+	// no Results API rule when TektonResult is not installed
+	policies = networkpolicy.PipelineNamespacePolicies(networkpolicy.KubernetesPlatformDefaults(), networkpolicy.PipelineNamespaceParams{
+		TargetNamespace: "tekton-pipelines",
+		APIServer:       networkpolicy.APIServerEgressRule(),
+	})
+	if got := len(policies[1].Spec.Egress); got != 3 {
+		t.Errorf("expected 3 egress rules, got %d", got)
+	}
+}
+
+func TestAPIServerEndpointsEgressRule(t *testing.T) {
+	endpoints := &corev1.Endpoints{
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+				Ports:     []corev1.EndpointPort{{Name: "https", Port: 6443, Protocol: corev1.ProtocolTCP}},
+			},
+		},
+	}
+	rule, ok := networkpolicy.APIServerEndpointsEgressRule(endpoints)
+	if !ok {
+		t.Fatal("expected a rule")
+	}
+	if len(rule.To) != 2 || rule.To[0].IPBlock.CIDR != "10.0.0.1/32" || rule.To[1].IPBlock.CIDR != "fd00::1/128" {
+		t.Errorf("unexpected peers %+v", rule.To)
+	}
+	if len(rule.Ports) != 1 || rule.Ports[0].Port.IntValue() != 6443 {
+		t.Errorf("unexpected ports %+v", rule.Ports)
+	}
+
+	if _, ok := networkpolicy.APIServerEndpointsEgressRule(&corev1.Endpoints{}); ok {
+		t.Error("expected no rule without addresses")
+	}
+}
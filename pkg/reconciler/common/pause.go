@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+)
+
+// maxOwnerDepth bounds the walk through the owners, installer sets are owned
+// by components which are owned by TektonConfig
+const maxOwnerDepth = 3
+
+type ownerGetter func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error)
+
+// ownerGetters fetch the operator resources which own other operator resources
+var ownerGetters = map[string]ownerGetter{
+	v1alpha1.KindTektonConfig: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonConfigs().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonPipeline: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonPipelines().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonTrigger: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonTriggers().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonChain: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonChains().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonResult: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonResults().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonDashboard: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonDashboards().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonAddon: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonAddons().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonPruner: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonPruners().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonScheduler: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonSchedulers().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindTektonMulticlusterProxyAAE: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().TektonMulticlusterProxyAAEs().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindSyncerService: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().SyncerServices().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindManualApprovalGate: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().ManualApprovalGates().Get(ctx, name, metav1.GetOptions{})
+	},
+	v1alpha1.KindOpenShiftPipelinesAsCode: func(ctx context.Context, c clientset.Interface, name string) (metav1.Object, error) {
+		return c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().Get(ctx, name, metav1.GetOptions{})
+	},
+}
+
+// PausedBy returns the resource whose PausedKey annotation pauses the
+// reconciliation of obj, that is obj itself or one of the operator resources
+// owning it. An empty string is returned when the reconciliation is not paused
+func PausedBy(ctx context.Context, c clientset.Interface, obj metav1.Object) (string, error) {
+	return pausedBy(ctx, c, obj, "", 0)
+}
+
+func pausedBy(ctx context.Context, c clientset.Interface, obj metav1.Object, kind string, depth int) (string, error) {
+	if v1alpha1.IsPaused(obj) {
+		if kind == "" {
+			return obj.GetName(), nil
+		}
+		return kind + "/" + obj.GetName(), nil
+	}
+	if depth >= maxOwnerDepth {
+		return "", nil
+	}
+	for _, owner := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err != nil || gv.Group != v1alpha1.GroupName {
+			continue
+		}
+		get, ok := ownerGetters[owner.Kind]
+		if !ok {
+			continue
+		}
+		ownerObj, err := get(ctx, c, owner.Name)
+		if err != nil {
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+		if by, err := pausedBy(ctx, c, ownerObj, owner.Kind, depth+1); by != "" || err != nil {
+			return by, err
+		}
+	}
+	return "", nil
+}
+
+// ReconcilePaused checks whether the reconciliation of obj is paused and
+// maintains the Paused condition in its status. When paused it returns an
+// event which requeues obj, so that it notices when it is resumed, and the
+// caller must return it without applying any change.
+// Once resumed the Paused condition is removed and the caller goes on with a
+// full reconciliation, which reverts any change made while paused.
+func ReconcilePaused(ctx context.Context, c clientset.Interface, obj metav1.Object, status apis.ConditionsAccessor) error {
+	logger := logging.FromContext(ctx)
+
+	by, err := PausedBy(ctx, c, obj)
+	if err != nil {
+		logger.Errorw("failed to check whether reconciliation is paused", "error", err)
+		return err
+	}
+
+	if by == "" {
+		if v1alpha1.MarkResumed(status) {
+			logger.Infow("reconciliation resumed, resyncing all resources", "name", obj.GetName())
+		}
+		return nil
+	}
+
+	msg := fmt.Sprintf("Reconciliation paused by the %s annotation on %s", v1alpha1.PausedKey, by)
+	v1alpha1.MarkPaused(status, msg)
+	logger.Infow("reconciliation paused, skipping", "name", obj.GetName(), "pausedBy", by)
+	return v1alpha1.REQUEUE_EVENT_AFTER
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorFake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func pausedAnnotation(paused bool) map[string]string {
+	if !paused {
+		return nil
+	}
+	return map[string]string{v1alpha1.PausedKey: "true"}
+}
+
+func ownerRef(kind, name string) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: v1alpha1.SchemeGroupVersion.String(),
+		Kind:       kind,
+		Name:       name,
+	}
+}
+
+func TestPausedBy(t *testing.T) {
+	tests := []struct {
+		name           string
+		configPaused   bool
+		pipelinePaused bool
+		setPaused      bool
+		setOwner       *metav1.OwnerReference
+		want           string
+	}{
+		{
+			name: "nothing paused",
+			want: "",
+		},
+		{
+			name:      "installer set paused",
+			setPaused: true,
+			want:      "pipeline-main-static",
+		},
+		{
+			name:           "owning component paused",
+			pipelinePaused: true,
+			want:           "TektonPipeline/pipeline",
+		},
+		{
+			name:         "TektonConfig paused",
+			configPaused: true,
+			want:         "TektonConfig/config",
+		},
+		{
+			name:         "owner not found",
+			configPaused: true,
+			setOwner:     &metav1.OwnerReference{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.KindTektonTrigger, Name: "trigger"},
+			want:         "",
+		},
+		{
+			name:         "owner of another group",
+			configPaused: true,
+			setOwner:     &metav1.OwnerReference{APIVersion: "apps/v1", Kind: v1alpha1.KindTektonPipeline, Name: "pipeline"},
+			want:         "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{
+				Name:        v1alpha1.ConfigResourceName,
+				Annotations: pausedAnnotation(test.configPaused),
+			}}
+			tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{
+				Name:            v1alpha1.PipelineResourceName,
+				Annotations:     pausedAnnotation(test.pipelinePaused),
+				OwnerReferences: []metav1.OwnerReference{ownerRef(v1alpha1.KindTektonConfig, v1alpha1.ConfigResourceName)},
+			}}
+			owner := ownerRef(v1alpha1.KindTektonPipeline, v1alpha1.PipelineResourceName)
+			if test.setOwner != nil {
+				owner = *test.setOwner
+			}
+			is := &v1alpha1.TektonInstallerSet{ObjectMeta: metav1.ObjectMeta{
+				Name:            "pipeline-main-static",
+				Annotations:     pausedAnnotation(test.setPaused),
+				OwnerReferences: []metav1.OwnerReference{owner},
+			}}
+			client := operatorFake.NewSimpleClientset(tc, tp)
+
+			got, err := PausedBy(ctx, client, is)
+			assert.NilError(t, err)
+			assert.Equal(t, got, test.want)
+		})
+	}
+}
+
+func TestReconcilePaused(t *testing.T) {
+	ctx := context.Background()
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{
+		Name:        v1alpha1.PipelineResourceName,
+		Annotations: pausedAnnotation(true),
+	}}
+	tp.Status.InitializeConditions()
+	client := operatorFake.NewSimpleClientset()
+
+	err := ReconcilePaused(ctx, client, tp, &tp.Status)
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	cond := tp.Status.GetCondition(v1alpha1.Paused)
+	assert.Assert(t, cond != nil && cond.IsTrue())
+	assert.Equal(t, cond.Message, "Reconciliation paused by the operator.tekton.dev/paused annotation on pipeline")
+
+	// resume
+	tp.Annotations = nil
+	err = ReconcilePaused(ctx, client, tp, &tp.Status)
+	assert.NilError(t, err)
+	assert.Assert(t, tp.Status.GetCondition(v1alpha1.Paused) == nil)
+}
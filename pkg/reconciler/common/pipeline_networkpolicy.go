@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common/networkpolicy"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	pipelineNetworkPolicyComponent = "pipeline-network-policy"
+	defaultResultsAPIPort          = int32(8080)
+)
+
+// pipelineNetworkPolicyLabels are set on the generated policies, to find them
+// when a namespace is no longer selected
+var pipelineNetworkPolicyLabels = map[string]string{
+	v1alpha1.CreatedByKey: v1alpha1.KindTektonConfig,
+	v1alpha1.ComponentKey: pipelineNetworkPolicyComponent,
+}
+
+// ReconcilePipelineNetworkPolicies generates the NetworkPolicies of the
+// TaskRun pods in the namespaces selected by the TektonConfig, and removes the
+// generated policies from the namespaces which are no longer selected
+func ReconcilePipelineNetworkPolicies(ctx context.Context, k kubernetes.Interface, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx)
+	desired := map[string]bool{}
+
+	if selector := tc.Spec.PipelineNetworkPolicy.NamespaceSelector; selector != nil {
+		nsSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return err
+		}
+		namespaces, err := k.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: nsSelector.String()})
+		if err != nil {
+			return err
+		}
+		policies, err := pipelineNetworkPolicies(ctx, k, tc)
+		if err != nil {
+			return err
+		}
+
+		ignorePattern := regexp.MustCompile(NamespaceIgnorePattern)
+		for _, namespace := range namespaces.Items {
+			// the policies of the components are managed by the components
+			if ignorePattern.MatchString(namespace.GetName()) || namespace.GetName() == tc.Spec.TargetNamespace ||
+				namespace.GetDeletionTimestamp() != nil {
+				continue
+			}
+			for _, policy := range policies {
+				desired[namespace.GetName()+"/"+policy.GetName()] = true
+				if err := ensureNetworkPolicy(ctx, k, tc, namespace.GetName(), policy); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	existing, err := k.NetworkingV1().NetworkPolicies(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(pipelineNetworkPolicyLabels).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, policy := range existing.Items {
+		if desired[policy.GetNamespace()+"/"+policy.GetName()] {
+			continue
+		}
+		logger.Infow("deleting pipeline network policy", "namespace", policy.GetNamespace(), "name", policy.GetName())
+		err := k.NetworkingV1().NetworkPolicies(policy.GetNamespace()).Delete(ctx, policy.GetName(), metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// pipelineNetworkPolicies builds the policies of the pipeline namespaces for
+// the platform
+func pipelineNetworkPolicies(ctx context.Context, k kubernetes.Interface, tc *v1alpha1.TektonConfig) ([]networkingv1.NetworkPolicy, error) {
+	platform := networkpolicy.KubernetesPlatformDefaults()
+	if v1alpha1.IsOpenShiftPlatform() {
+		platform = networkpolicy.OpenShiftPlatformDefaults()
+	}
+
+	params := networkpolicy.PipelineNamespaceParams{
+		TargetNamespace: tc.Spec.TargetNamespace,
+		APIServer:       networkpolicy.APIServerEgressRule(),
+		Egress:          tc.Spec.PipelineNetworkPolicy.Egress,
+	}
+	// restrict the egress to the API server to its endpoints when they are known
+	endpoints, err := k.CoreV1().Endpoints(metav1.NamespaceDefault).Get(ctx, "kubernetes", metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get the API server endpoints: %w", err)
+	}
+	if err == nil {
+		if rule, ok := networkpolicy.APIServerEndpointsEgressRule(endpoints); ok {
+			params.APIServer = rule
+		}
+	}
+	if tc.Spec.IsResultEnabled() {
+		params.ResultsAPIPort = defaultResultsAPIPort
+		if port := tc.Spec.Result.ServerPort; port != nil && *port >= 1 && *port <= 65535 {
+			params.ResultsAPIPort = int32(*port)
+		}
+	}
+	return networkpolicy.PipelineNamespacePolicies(platform, params), nil
+}
+
+// ensureNetworkPolicy creates the policy in the namespace, or updates it when
+// it differs
+func ensureNetworkPolicy(ctx context.Context, k kubernetes.Interface, tc *v1alpha1.TektonConfig, namespace string, policy networkingv1.NetworkPolicy) error {
+	client := k.NetworkingV1().NetworkPolicies(namespace)
+	ownerRef := *metav1.NewControllerRef(tc, tc.GetGroupVersionKind())
+
+	existing, err := client.Get(ctx, policy.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		policy.Namespace = namespace
+		policy.Labels = pipelineNetworkPolicyLabels
+		policy.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		_, err = client.Create(ctx, &policy, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, policy.Spec) &&
+		equality.Semantic.DeepEqual(existing.Labels, pipelineNetworkPolicyLabels) {
+		return nil
+	}
+	existing.Spec = policy.Spec
+	existing.Labels = pipelineNetworkPolicyLabels
+	existing.OwnerReferences = []metav1.OwnerReference{ownerRef}
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common/networkpolicy"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReconcilePipelineNetworkPolicies(t *testing.T) {
+	ctx := context.Background()
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	client := fake.NewSimpleClientset(
+		namespace("team-a", map[string]string{"pipelines": "true"}),
+		namespace("team-b", map[string]string{"pipelines": "true"}),
+		namespace("team-c", nil),
+		namespace("kube-system", map[string]string{"pipelines": "true"}),
+		namespace("tekton-operator-ns", map[string]string{"pipelines": "true"}),
+		&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "kubernetes"},
+			Subsets: []corev1.EndpointSubset{{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}},
+				Ports:     []corev1.EndpointPort{{Port: 6443}},
+			}},
+		},
+	)
+	tc := getTestTektonConfig()
+	tc.Spec.PipelineNetworkPolicy.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"pipelines": "true"}}
+
+	policyNames := func() []string {
+		list, err := client.NetworkingV1().NetworkPolicies(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, policy := range list.Items {
+			names = append(names, policy.GetNamespace()+"/"+policy.GetName())
+		}
+		return names
+	}
+
+	require.NoError(t, ReconcilePipelineNetworkPolicies(ctx, client, tc))
+	assert.ElementsMatch(t, []string{
+		"team-a/" + networkpolicy.PipelineNamespaceDefaultDenyName,
+		"team-a/" + networkpolicy.PipelineNamespaceEgressName,
+		"team-b/" + networkpolicy.PipelineNamespaceDefaultDenyName,
+		"team-b/" + networkpolicy.PipelineNamespaceEgressName,
+	}, policyNames())
+
+	egress, err := client.NetworkingV1().NetworkPolicies("team-a").Get(ctx, networkpolicy.PipelineNamespaceEgressName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.KindTektonConfig, egress.OwnerReferences[0].Kind)
+	assert.Equal(t, &networkingv1.IPBlock{CIDR: "10.0.0.1/32"}, egress.Spec.Egress[1].To[0].IPBlock)
+
+	// a modified policy is restored
+	egress.Spec.Egress = nil
+	_, err = client.NetworkingV1().NetworkPolicies("team-a").Update(ctx, egress, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, ReconcilePipelineNetworkPolicies(ctx, client, tc))
+	egress, err = client.NetworkingV1().NetworkPolicies("team-a").Get(ctx, networkpolicy.PipelineNamespaceEgressName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, egress.Spec.Egress)
+
+	// the policies are removed from the namespaces no longer selected
+	tc.Spec.PipelineNetworkPolicy.NamespaceSelector = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "kubernetes.io/metadata.name", Operator: metav1.LabelSelectorOpIn, Values: []string{"team-b"}},
+		},
+	}
+	ns, err := client.CoreV1().Namespaces().Get(ctx, "team-b", metav1.GetOptions{})
+	require.NoError(t, err)
+	ns.Labels["kubernetes.io/metadata.name"] = "team-b"
+	_, err = client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, ReconcilePipelineNetworkPolicies(ctx, client, tc))
+	assert.ElementsMatch(t, []string{
+		"team-b/" + networkpolicy.PipelineNamespaceDefaultDenyName,
+		"team-b/" + networkpolicy.PipelineNamespaceEgressName,
+	}, policyNames())
+
+	// and from all the namespaces without a selector
+	tc.Spec.PipelineNetworkPolicy.NamespaceSelector = nil
+	require.NoError(t, ReconcilePipelineNetworkPolicies(ctx, client, tc))
+	assert.Empty(t, policyNames())
+}
@@ -24,8 +24,10 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/jobpruner"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
 	"go.uber.org/zap"
 	batchv1 "k8s.io/api/batch/v1"
@@ -41,8 +43,11 @@ const (
 	prunerCronJobName        = "tekton-resource-pruner"
 	prunerServiceAccountName = "tekton-resource-pruner"
 
-	// tkn container image via environment key
-	prunerContainerImageEnvKey = "IMAGE_JOB_PRUNER_TKN"
+	// pruner container image via environment key, the image of cmd/pruner
+	prunerContainerImageEnvKey = "IMAGE_JOB_PRUNER"
+	// environment key of the former tkn image of the pruner, it is not used as
+	// the CronJobs now run cmd/pruner, which the tkn image does not provide
+	prunerContainerImageLegacyEnvKey = "IMAGE_JOB_PRUNER_TKN"
 
 	// namespace annotations
 	pruneAnnotationSkip             = "operator.tekton.dev/prune.skip"
@@ -59,60 +64,6 @@ const (
 	// prune strategy used in namespace annotation
 	pruneStrategyKeep      = "keep"
 	pruneStrategyKeepSince = "keep-since"
-
-	// script to be executed inside container
-	//nolint:dupword
-	prunerCommand = `
-	function prune() {
-		namespace=$1
-		flags=$2
-		resources=$3
-		prune_per_resource=$4
-		updated_flags=$(echo $flags | tr ',' ' ')
-		for resource in ${resources//,/ }; do
-			echo ""	
-			if [[ "$prune_per_resource" == "true" ]]; then
-				parent_resource=$(echo $resource | sed "s/run$//")
-				resource_names=$(tkn $parent_resource list --namespace=$namespace --no-headers --output=jsonpath={.items[*].metadata.name})
-				if [ $? -ne 0 ]; then
-					echo "error on getting list of '$parent_resource'"
-					error_status=1
-					continue
-				fi
-				if [[ "$resource_names" == "" ]]; then
-					echo "there is no '$parent_resource' available in '$namespace'"
-					continue
-				fi
-				for resource_name in $resource_names; do
-					echo ""
-					target_cmd="tkn $resource delete --$parent_resource=\"$resource_name\" $updated_flags --namespace=$namespace --force"
-					echo "\$ ${target_cmd}"
-					eval $target_cmd
-					if [ $? -ne 0 ]; then
-						error_status=1
-					fi
-				done
-			else
-				target_cmd="tkn $resource delete $updated_flags --namespace=$namespace --force"
-				echo "\$ ${target_cmd}"
-				eval $target_cmd
-				if [ $? -ne 0 ]; then
-					error_status=1
-				fi
-			fi
-		done
-	}
-	
-	error_status=0
-	for c in $*; do
-		namespace=$(echo $c | cut -d ';' -f 1)
-		flags=$(echo $c | cut -d ';' -f 2)
-		resources=$(echo $c | cut -d ';' -f 3)
-		prune_per_resource=$(echo $c | cut -d ';' -f 4)
-		prune $namespace $flags $resources $prune_per_resource
-	done
-	exit $error_status
-	`
 )
 
 var (
@@ -130,7 +81,7 @@ var (
 type Pruner struct {
 	tektonConfig    *v1alpha1.TektonConfig
 	kubeClientset   kubernetes.Interface
-	tknImage        string
+	image           string
 	targetNamespace string
 	ownerRef        metav1.OwnerReference
 	logger          *zap.SugaredLogger
@@ -143,7 +94,7 @@ type pruneConfig struct {
 	KeepSince        *uint
 	Resources        []string
 	PrunePerResource bool
-	TknImage         string
+	Image            string
 }
 
 func Prune(ctx context.Context, k kubernetes.Interface, tektonConfig *v1alpha1.TektonConfig) error {
@@ -167,16 +118,21 @@ func getPruner(ctx context.Context, k kubernetes.Interface, tektonConfig *v1alph
 }
 
 func (pr *Pruner) reconcile(ctx context.Context) error {
-	// get tkn cli container image name from environment
-	tknImageFromEnv := os.Getenv(prunerContainerImageEnvKey)
-	if tknImageFromEnv == "" {
-		return fmt.Errorf("tkn image '%s' environment variable is not set", prunerContainerImageEnvKey)
+	// get pruner container image name from environment
+	imageFromEnv := os.Getenv(prunerContainerImageEnvKey)
+	if imageFromEnv == "" && os.Getenv(prunerContainerImageLegacyEnvKey) != "" {
+		return fmt.Errorf("pruner image '%s' environment variable is not set, '%s' holds the tkn image which cannot run the pruner", prunerContainerImageEnvKey, prunerContainerImageLegacyEnvKey)
+	}
+	if imageFromEnv == "" {
+		return fmt.Errorf("pruner image '%s' environment variable is not set", prunerContainerImageEnvKey)
 	}
-	pr.tknImage = tknImageFromEnv
+	pr.image = imageFromEnv
 
 	// reconcile cron jobs
-	err := pr.reconcileCronJobs(ctx)
-	return err
+	if err := pr.reconcileCronJobs(ctx); err != nil {
+		return err
+	}
+	return pr.reportPrunerJobs(ctx)
 }
 
 func (pr *Pruner) getOwnerReferences() []metav1.OwnerReference {
@@ -257,9 +213,9 @@ func (pr *Pruner) reconcileCronJobs(ctx context.Context) error {
 	return nil
 }
 
-// to compute hash include, pruneConfigs, startingDeadlineSeconds, nodeSelector, toleration, priorityClass
+// to compute hash include, pruneConfigs, startingDeadlineSeconds, nodeSelector, toleration, priorityClass, dryRun, resultsGracePeriod
 func (pr *Pruner) computeHash(pruneConfigs []pruneConfig) (string, error) {
-	// to compute hash additionally include, nodeSelector, tolerations, priorityClassName
+	// to compute hash additionally include, nodeSelector, tolerations, priorityClassName, dryRun
 	// to update cronjobs if there is a change on those fields
 	targetObject := struct {
 		PruneConfigs            []pruneConfig
@@ -267,13 +223,15 @@ func (pr *Pruner) computeHash(pruneConfigs []pruneConfig) (string, error) {
 		NodeSelector            map[string]string
 		Tolerations             []corev1.Toleration
 		PriorityClassName       string
-		Script                  string
+		DryRun                  bool
+		ResultsGracePeriod      time.Duration
 	}{
-		PruneConfigs:      pruneConfigs,
-		NodeSelector:      pr.tektonConfig.Spec.Config.NodeSelector,
-		Tolerations:       pr.tektonConfig.Spec.Config.Tolerations,
-		PriorityClassName: pr.tektonConfig.Spec.Config.PriorityClassName,
-		Script:            prunerCommand,
+		PruneConfigs:       pruneConfigs,
+		DryRun:             pr.tektonConfig.Spec.Pruner.DryRun,
+		ResultsGracePeriod: pr.resultsGracePeriod(),
+		NodeSelector:       pr.tektonConfig.Spec.Config.NodeSelector,
+		Tolerations:        pr.tektonConfig.Spec.Config.Tolerations,
+		PriorityClassName:  pr.tektonConfig.Spec.Config.PriorityClassName,
 	}
 	// update StartingDeadlineSeconds
 	if pr.tektonConfig.Spec.Pruner.StartingDeadlineSeconds != nil {
@@ -282,6 +240,15 @@ func (pr *Pruner) computeHash(pruneConfigs []pruneConfig) (string, error) {
 	return hash.Compute(targetObject)
 }
 
+// resultsGracePeriod returns the grace period of the results guard, or 0 when
+// it is not active
+func (pr *Pruner) resultsGracePeriod() time.Duration {
+	if !pr.tektonConfig.Spec.ResultsGuardActive() {
+		return 0
+	}
+	return pr.tektonConfig.Spec.ResultsGuard.GetGracePeriod()
+}
+
 // update prune config from namespace annotations and global pruner config
 func (pr *Pruner) getPruneConfig(namespace *corev1.Namespace) *pruneConfig {
 	// create prune config and update some values from global config
@@ -289,7 +256,7 @@ func (pr *Pruner) getPruneConfig(namespace *corev1.Namespace) *pruneConfig {
 	defaultPruneConfig := pr.tektonConfig.Spec.Pruner
 	pruneCfg := pruneConfig{
 		Namespace:        namespace.GetName(),
-		TknImage:         pr.tknImage,
+		Image:            pr.image,
 		Schedule:         defaultPruneConfig.Schedule,
 		PrunePerResource: defaultPruneConfig.PrunePerResource,
 	}
@@ -532,6 +499,16 @@ func (pr *Pruner) createCronJobs(ctx context.Context, cronJobsToBeCreated map[st
 			fsGroup = nil
 		}
 
+		// in dry-run the pruner reports the runs it would delete
+		args := []string{"--namespaces", prunerCommandArgs}
+		if pr.tektonConfig.Spec.Pruner.DryRun {
+			args = append(args, "--dry-run")
+		}
+		// the runs not stored in Results are kept until the grace period
+		if gracePeriod := pr.resultsGracePeriod(); gracePeriod > 0 {
+			args = append(args, "--results-grace-period", gracePeriod.String())
+		}
+
 		cronJob := &batchv1.CronJob{
 			ObjectMeta: metav1.ObjectMeta{
 				GenerateName:    fmt.Sprintf("%s-", prunerCronJobName),
@@ -553,10 +530,23 @@ func (pr *Pruner) createCronJobs(ctx context.Context, cronJobsToBeCreated map[st
 						Template: corev1.PodTemplateSpec{
 							Spec: corev1.PodSpec{
 								Containers: []corev1.Container{{
-									Name:                     "tkn-pruner",
-									Image:                    pr.tknImage,
-									Command:                  []string{"/bin/sh", "-c", prunerCommand},
-									Args:                     []string{"-s", prunerCommandArgs},
+									Name:  "pruner",
+									Image: pr.image,
+									Args:  args,
+									Env: []corev1.EnvVar{
+										{
+											Name: jobpruner.JobNameEnvKey,
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.labels['batch.kubernetes.io/job-name']"},
+											},
+										},
+										{
+											Name: jobpruner.NamespaceEnvKey,
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+											},
+										},
+									},
 									TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 									SecurityContext: &corev1.SecurityContext{
 										AllowPrivilegeEscalation: &allowPrivilegedEscalation,
@@ -597,26 +587,23 @@ func (pr *Pruner) createCronJobs(ctx context.Context, cronJobsToBeCreated map[st
 	}
 }
 
-// Generates command arguments for passing to the tkn container.
-// Refer to the "prunerCommand" constant (at the top of this file) for the actual execution command.
-// The command args format (multiple space-separated instances): namespace;tkn_flag_1,tkn_flag_n;resources;prunePerResource.
+// Generates command arguments for passing to the pruner container, parsed by
+// jobpruner.ParseNamespaceConfigs.
+// The command args format (multiple space-separated instances): namespace;flag_1,flag_n;resources;prunePerResource.
 // NOTE: A space separates each namespace configuration, so spaces are not allowed in the namespace configuration.
 //
 // examples:
 // ns-one;--keep=5;pipelinerun;false
-//   - $ tkn pipelinerun delete --keep=5 --namespace=ns-one --force
+//   - keeps the 5 most recent pipelineruns of ns-one
 //
 // ns-two;--keep=2;taskrun;false
-//   - $ tkn taskrun delete --keep=2 --namespace=ns-two --force
+//   - keeps the 2 most recent taskruns of ns-two
 //
 // ns-three;--keep=4,--keep-since=300;pipelinerun,taskrun;false
-//   - $ tkn pipelinerun delete --keep=4 --keep-since=300 --namespace=ns-three --force
-//   - $ tkn taskrun delete --keep=4 --keep-since=300 --namespace=ns-three --force
+//   - keeps the 4 most recent pipelineruns and taskruns of ns-three, and the ones completed in the last 300 minutes
 //
 // ns-four;--keep=4;pipelinerun,taskrun;true  <= note the "true" - prunePerResource
-// resource names will be taken dynamically on the script
-//   - $ tkn pipelinerun delete --pipeline="pipeline-one" --keep=5 --namespace=ns-four --force
-//   - $ tkn taskrun delete --task="task-one" --keep=5 --namespace=ns-four --force
+//   - keeps the 4 most recent pipelineruns of each pipeline and taskruns of each task of ns-four
 func (pr *Pruner) generatePrunerCommandArgs(pruneConfigs []pruneConfig) string {
 	commands := []string{}
 	for _, pruneCfg := range pruneConfigs {
@@ -642,3 +629,33 @@ func (pr *Pruner) generatePrunerCommandArgs(pruneConfigs []pruneConfig) string {
 	// create space separated group of commands
 	return strings.Join(commands, " ")
 }
+
+// reportPrunerJobs reports in the status of TektonConfig the summary of the
+// last run of each cron job, the pruner annotates its cron job with it
+func (pr *Pruner) reportPrunerJobs(ctx context.Context) error {
+	labelsFilter := fmt.Sprintf("%s=true", pruneCronLabel)
+	cronJobs, err := pr.kubeClientset.BatchV1().CronJobs(pr.targetNamespace).List(ctx, metav1.ListOptions{LabelSelector: labelsFilter})
+	if err != nil {
+		return err
+	}
+	statuses := []v1alpha1.PrunerJobStatus{}
+	for _, cronJob := range cronJobs.Items {
+		status := v1alpha1.PrunerJobStatus{Name: cronJob.Name, Schedule: cronJob.Spec.Schedule}
+		summary, err := jobpruner.ParseSummary(cronJob.Annotations)
+		if err != nil {
+			pr.logger.Warnw("invalid summary of the pruner cron job", "name", cronJob.Name, "error", err)
+		} else if summary != nil {
+			// the namespaces are reported on the cron job, only the
+			// failures are reported in the status
+			failed := jobpruner.FailedNamespaces(*summary)
+			status.LastRun = &failed
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	if len(statuses) == 0 {
+		statuses = nil
+	}
+	pr.tektonConfig.Status.PrunerJobs = statuses
+	return nil
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pruner/pkg/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// tektonPrunerResources are the resources pruned by TektonPruner
+var tektonPrunerResources = []string{"pipelinerun", "taskrun"}
+
+// PruneMigration is the TektonPruner configuration equivalent to the
+// job-based pruner configuration. Notes are the settings which are not mapped
+// without changing which runs are kept, e.g. the schedules, and Warnings the
+// ones which could not be mapped or which make TektonPruner delete other runs
+type PruneMigration struct {
+	GlobalConfig *config.GlobalConfig
+	Notes        []string
+	Warnings     []string
+}
+
+// MigratePruneConfig translates the job-based pruner spec of the TektonConfig
+// and the prune annotations of the namespaces into a TektonPruner
+// GlobalConfig, the namespaces whose annotations resolve to other limits than
+// the global ones get an override
+func MigratePruneConfig(ctx context.Context, k kubernetes.Interface, tektonConfig *v1alpha1.TektonConfig) (*PruneMigration, error) {
+	pr, err := getPruner(ctx, k, tektonConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := tektonConfig.Spec.Pruner
+	migration := &PruneMigration{GlobalConfig: &config.GlobalConfig{}}
+	if spec.Disabled {
+		migration.note("the job-based pruner is disabled, its settings are migrated as they are")
+	}
+
+	keep, keepSince := spec.Keep, spec.KeepSince
+	if keep == nil && keepSince == nil {
+		defaultKeep := v1alpha1.PrunerDefaultKeep
+		keep = &defaultKeep
+	}
+	migration.GlobalConfig.PrunerConfig = migration.prunerConfig("global", keep, keepSince)
+	if keep != nil && keepSince != nil {
+		migration.warn("global: keep and keep-since are both set, TektonPruner deletes a run as soon as either limit is reached")
+	}
+	if spec.Schedule == "" {
+		migration.warn("global: the schedule is empty, the namespaces without a schedule annotation were not pruned and are now pruned with the global config")
+	} else {
+		migration.note(fmt.Sprintf("global: schedule %q is not mapped, TektonPruner prunes the runs as they complete", spec.Schedule))
+	}
+	if spec.StartingDeadlineSeconds != nil {
+		migration.note("global: startingDeadlineSeconds is not mapped")
+	}
+	resources := spec.Resources
+	if len(resources) == 0 {
+		resources = v1alpha1.PruningDefaultResources
+	}
+	if !equalResources(resources, tektonPrunerResources) {
+		migration.warn(fmt.Sprintf("global: resources %v is not mapped, TektonPruner prunes both the PipelineRuns and the standalone TaskRuns", resources))
+	}
+	if spec.PrunePerResource {
+		migration.warn("global: prune-per-resource is not mapped, the history limit applies to all the runs of a namespace")
+	}
+
+	namespaces, err := k.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(namespaces.Items, func(i, j int) bool {
+		return namespaces.Items[i].GetName() < namespaces.Items[j].GetName()
+	})
+
+	ignorePattern := regexp.MustCompile(NamespaceIgnorePattern)
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		if ignorePattern.MatchString(namespace.GetName()) || !hasPruneAnnotations(namespace) {
+			continue
+		}
+		migration.migrateNamespace(pr, namespace, keep, keepSince)
+	}
+
+	if len(migration.GlobalConfig.Namespaces) > 0 {
+		level := config.EnforcedConfigLevelNamespace
+		migration.GlobalConfig.EnforcedConfigLevel = &level
+	} else {
+		level := config.EnforcedConfigLevelGlobal
+		migration.GlobalConfig.EnforcedConfigLevel = &level
+	}
+
+	if err := config.ValidateGlobalConfig(migration.GlobalConfig); err != nil {
+		return nil, fmt.Errorf("migrated pruner config is invalid: %w", err)
+	}
+	return migration, nil
+}
+
+func (m *PruneMigration) migrateNamespace(pr *Pruner, namespace *corev1.Namespace, keep, keepSince *uint) {
+	name := namespace.GetName()
+	annotations := namespace.GetAnnotations()
+
+	if pr.getMapString(annotations, pruneAnnotationSkip, "") == "true" {
+		m.warn(fmt.Sprintf("namespace %s: %s is not mapped, its runs are pruned with the global config", name, pruneAnnotationSkip))
+		return
+	}
+	// not pruned by the job-based pruner, reported with the global schedule
+	if pr.tektonConfig.Spec.Pruner.Schedule == "" && pr.getMapString(annotations, pruneAnnotationSchedule, "") == "" {
+		return
+	}
+
+	pruneCfg := pr.getPruneConfig(namespace)
+	if pruneCfg == nil {
+		m.warn(fmt.Sprintf("namespace %s: the prune annotations are invalid, its runs are pruned with the global config", name))
+		return
+	}
+
+	if schedule, found := annotations[pruneAnnotationSchedule]; found {
+		m.note(fmt.Sprintf("namespace %s: %s %q is not mapped", name, pruneAnnotationSchedule, schedule))
+	}
+	if _, found := annotations[pruneAnnotationResources]; found && !equalResources(pruneCfg.Resources, tektonPrunerResources) {
+		m.warn(fmt.Sprintf("namespace %s: %s %v is not mapped, TektonPruner prunes both the PipelineRuns and the standalone TaskRuns", name, pruneAnnotationResources, pruneCfg.Resources))
+	}
+	if _, found := annotations[pruneAnnotationPrunePerResource]; found && pruneCfg.PrunePerResource {
+		m.warn(fmt.Sprintf("namespace %s: %s is not mapped, the history limit applies to all the runs of the namespace", name, pruneAnnotationPrunePerResource))
+	}
+
+	if equalUint(pruneCfg.Keep, keep) && equalUint(pruneCfg.KeepSince, keepSince) {
+		return
+	}
+	if pruneCfg.Keep == nil && keep != nil {
+		m.warn(fmt.Sprintf("namespace %s: strategy %s can not disable the history limit, TektonPruner keeps the default history limit", name, pruneStrategyKeepSince))
+	}
+	if pruneCfg.Keep != nil && pruneCfg.KeepSince != nil && (keep == nil || keepSince == nil) {
+		m.warn(fmt.Sprintf("namespace %s: keep and keep-since are both set, TektonPruner deletes a run as soon as either limit is reached", name))
+	}
+	if m.GlobalConfig.Namespaces == nil {
+		m.GlobalConfig.Namespaces = map[string]config.NamespaceSpec{}
+	}
+	m.GlobalConfig.Namespaces[name] = config.NamespaceSpec{
+		PrunerConfig: m.prunerConfig("namespace "+name, pruneCfg.Keep, pruneCfg.KeepSince),
+	}
+}
+
+// prunerConfig maps keep to historyLimit and keep-since, in minutes, to
+// ttlSecondsAfterFinished
+func (m *PruneMigration) prunerConfig(scope string, keep, keepSince *uint) config.PrunerConfig {
+	prunerConfig := config.PrunerConfig{}
+	if keep != nil {
+		if *keep > math.MaxInt32 {
+			m.warn(fmt.Sprintf("%s: keep %d is not mapped, it exceeds the maximum history limit", scope, *keep))
+		} else {
+			historyLimit := int32(*keep)
+			prunerConfig.HistoryLimit = &historyLimit
+		}
+	}
+	if keepSince != nil {
+		if *keepSince > math.MaxInt32/60 {
+			m.warn(fmt.Sprintf("%s: keep-since %d is not mapped, it exceeds the maximum TTL", scope, *keepSince))
+		} else {
+			ttl := int32(*keepSince * 60)
+			prunerConfig.TTLSecondsAfterFinished = &ttl
+		}
+	}
+	return prunerConfig
+}
+
+func (m *PruneMigration) note(note string) {
+	m.Notes = append(m.Notes, note)
+}
+
+func (m *PruneMigration) warn(warning string) {
+	m.Warnings = append(m.Warnings, warning)
+}
+
+func hasPruneAnnotations(namespace *corev1.Namespace) bool {
+	for key := range namespace.GetAnnotations() {
+		if strings.HasPrefix(key, "operator.tekton.dev/prune.") {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeResources(resources []string) []string {
+	normalized := []string{}
+	for _, resource := range resources {
+		if name, found := pruneResourceNameMap[strings.ToLower(strings.TrimSpace(resource))]; found {
+			normalized = append(normalized, name)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+func equalResources(a, b []string) bool {
+	a, b = normalizeResources(a), normalizeResources(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalUint(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
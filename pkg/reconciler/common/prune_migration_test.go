@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pruner/pkg/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+)
+
+func TestMigratePruneConfig(t *testing.T) {
+	keep := uint(10)
+	namespace := func(name string, annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+	}
+	client := fake.NewSimpleClientset(
+		namespace("openshift-pipelines", map[string]string{pruneAnnotationKeep: "1"}),
+		namespace("ns-default", nil),
+		namespace("ns-same", map[string]string{pruneAnnotationKeep: "10"}),
+		namespace("ns-keep", map[string]string{pruneAnnotationKeep: "5"}),
+		namespace("ns-keep-since", map[string]string{pruneAnnotationStrategy: "keep-since", pruneAnnotationKeepSince: "60"}),
+		namespace("ns-skip", map[string]string{pruneAnnotationSkip: "true"}),
+		namespace("ns-invalid", map[string]string{pruneAnnotationKeep: "many"}),
+		namespace("ns-unmapped", map[string]string{
+			pruneAnnotationSchedule:         "0 * * * *",
+			pruneAnnotationResources:        "taskrun",
+			pruneAnnotationPrunePerResource: "true",
+		}),
+	)
+	tc := getTestTektonConfig()
+	tc.Spec.Pruner.Keep = &keep
+	tc.Spec.Pruner.Schedule = "*/5 * * * *"
+
+	migration, err := MigratePruneConfig(context.Background(), client, tc)
+	require.NoError(t, err)
+
+	namespaceLevel := config.EnforcedConfigLevelNamespace
+	expected := &config.GlobalConfig{
+		PrunerConfig: config.PrunerConfig{
+			EnforcedConfigLevel: &namespaceLevel,
+			HistoryLimit:        ptr.Int32(10),
+		},
+		Namespaces: map[string]config.NamespaceSpec{
+			"ns-keep":       {PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(5)}},
+			"ns-keep-since": {PrunerConfig: config.PrunerConfig{TTLSecondsAfterFinished: ptr.Int32(3600)}},
+		},
+	}
+	assert.Equal(t, expected, migration.GlobalConfig)
+	assert.Equal(t, []string{
+		`global: schedule "*/5 * * * *" is not mapped, TektonPruner prunes the runs as they complete`,
+		`namespace ns-unmapped: operator.tekton.dev/prune.schedule "0 * * * *" is not mapped`,
+	}, migration.Notes)
+	assert.Equal(t, []string{
+		"namespace ns-invalid: the prune annotations are invalid, its runs are pruned with the global config",
+		"namespace ns-keep-since: strategy keep-since can not disable the history limit, TektonPruner keeps the default history limit",
+		"namespace ns-skip: operator.tekton.dev/prune.skip is not mapped, its runs are pruned with the global config",
+		"namespace ns-unmapped: operator.tekton.dev/prune.resources [taskrun] is not mapped, TektonPruner prunes both the PipelineRuns and the standalone TaskRuns",
+		"namespace ns-unmapped: operator.tekton.dev/prune.prune-per-resource is not mapped, the history limit applies to all the runs of the namespace",
+	}, migration.Warnings)
+}
+
+func TestMigratePruneConfigGlobal(t *testing.T) {
+	keepSince := uint(30)
+	tc := getTestTektonConfig()
+	tc.Spec.Pruner.Keep = nil
+	tc.Spec.Pruner.KeepSince = &keepSince
+	tc.Spec.Pruner.Schedule = ""
+	tc.Spec.Pruner.Disabled = true
+
+	migration, err := MigratePruneConfig(context.Background(), getTestKubeClient(), tc)
+	require.NoError(t, err)
+
+	globalLevel := config.EnforcedConfigLevelGlobal
+	expected := &config.GlobalConfig{
+		PrunerConfig: config.PrunerConfig{
+			EnforcedConfigLevel:     &globalLevel,
+			TTLSecondsAfterFinished: ptr.Int32(1800),
+		},
+	}
+	assert.Equal(t, expected, migration.GlobalConfig)
+	assert.Equal(t, []string{"the job-based pruner is disabled, its settings are migrated as they are"}, migration.Notes)
+	assert.Equal(t, []string{
+		"global: the schedule is empty, the namespaces without a schedule annotation were not pruned and are now pruned with the global config",
+	}, migration.Warnings)
+}
+
+func TestMigratePruneConfigDefaultKeep(t *testing.T) {
+	tc := getTestTektonConfig()
+	tc.Spec.Pruner.Keep = nil
+	tc.Spec.Pruner.Resources = []string{"pipelinerun"}
+
+	migration, err := MigratePruneConfig(context.Background(), getTestKubeClient(), tc)
+	require.NoError(t, err)
+
+	assert.Equal(t, ptr.Int32(int32(v1alpha1.PrunerDefaultKeep)), migration.GlobalConfig.HistoryLimit)
+	assert.Contains(t, migration.Warnings, "global: resources [pipelinerun] is not mapped, TektonPruner prunes both the PipelineRuns and the standalone TaskRuns")
+}
+
+func TestMigratePruneConfigScheduleOnly(t *testing.T) {
+	tc := getTestTektonConfig()
+	tc.Spec.Pruner.Schedule = v1alpha1.PrunerDefaultSchedule
+
+	migration, err := MigratePruneConfig(context.Background(), getTestKubeClient(), tc)
+	require.NoError(t, err)
+
+	// the schedule does not change which runs are kept, it does not block --apply
+	assert.Len(t, migration.Notes, 1)
+	assert.Empty(t, migration.Warnings)
+}
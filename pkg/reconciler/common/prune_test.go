@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/jobpruner"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -90,15 +91,19 @@ func TestPrunerContainerImageEnvironment(t *testing.T) {
 	tests := []struct {
 		name        string
 		image       string
+		legacyImage string
 		expectError bool
 	}{
 		{name: "TestWithImage", image: "tkn-image:tag-123", expectError: false},
 		{name: "TestWithoutImage", image: "", expectError: true},
+		{name: "TestWithLegacyImage", legacyImage: "tkn-image:tag-123", expectError: true},
+		{name: "TestWithImageAndLegacyImage", image: "tkn-image:tag-123", legacyImage: "legacy-image:tag-123", expectError: false},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Setenv(prunerContainerImageEnvKey, test.image)
+			t.Setenv(prunerContainerImageLegacyEnvKey, test.legacyImage)
 			pruner, err := getPruner(context.Background(), getTestKubeClient(), getTestTektonConfig())
 			assert.NoError(t, err)
 
@@ -107,7 +112,7 @@ func TestPrunerContainerImageEnvironment(t *testing.T) {
 				assert.NotNil(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, test.image, pruner.tknImage)
+				assert.Equal(t, test.image, pruner.image)
 			}
 		})
 	}
@@ -189,19 +194,19 @@ func TestPrunerReconcile(t *testing.T) {
 		scheduleAndArgs map[string]string // schedule and command args
 	}
 	tests := []struct {
-		name              string
-		tknContainerImage string
-		targetNamespace   string
-		tektonConfig      *v1alpha1.TektonConfig
-		client            *fake.Clientset
-		reconciles        []reconciles
+		name            string
+		containerImage  string
+		targetNamespace string
+		tektonConfig    *v1alpha1.TektonConfig
+		client          *fake.Clientset
+		reconciles      []reconciles
 	}{
 		{
-			name:              "TestCronReconcile",
-			tknContainerImage: "my-custom-tkn-image:tag-v1.0.0",
-			targetNamespace:   "test",
-			client:            getTestKubeClient(),
-			tektonConfig:      getTestTektonConfig(),
+			name:            "TestCronReconcile",
+			containerImage:  "my-custom-tkn-image:tag-v1.0.0",
+			targetNamespace: "test",
+			client:          getTestKubeClient(),
+			tektonConfig:    getTestTektonConfig(),
 			reconciles: []reconciles{
 				{ // startup - reconcile #1
 					name: "TestGlobalConfig",
@@ -592,11 +597,11 @@ func TestPrunerReconcile(t *testing.T) {
 			},
 		},
 		{
-			name:              "TestPrunerConfig",
-			tknContainerImage: "my-custom-tkn-image:tag-v1.0.0",
-			targetNamespace:   "tekton-operator",
-			client:            getTestKubeClient(),
-			tektonConfig:      getTestTektonConfig(),
+			name:            "TestPrunerConfig",
+			containerImage:  "my-custom-tkn-image:tag-v1.0.0",
+			targetNamespace: "tekton-operator",
+			client:          getTestKubeClient(),
+			tektonConfig:    getTestTektonConfig(),
 			reconciles: []reconciles{
 				{ // startup - reconcile #1
 					name: "TestPrunerEnabled",
@@ -791,7 +796,7 @@ func TestPrunerReconcile(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) { // run a individual test
-			t.Setenv(prunerContainerImageEnvKey, test.tknContainerImage)
+			t.Setenv(prunerContainerImageEnvKey, test.containerImage)
 			// update namespace
 			test.tektonConfig.Spec.CommonSpec.TargetNamespace = test.targetNamespace
 
@@ -817,7 +822,7 @@ func TestPrunerReconcile(t *testing.T) {
 					}
 
 					// verify image taken from environment variable
-					assert.Equal(t, test.tknContainerImage, pruner.tknImage)
+					assert.Equal(t, test.containerImage, pruner.image)
 
 					labelSelector := fmt.Sprintf("%s=true", pruneCronLabel)
 					cronJobsList, err := test.client.BatchV1().CronJobs(pruner.targetNamespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
@@ -826,19 +831,19 @@ func TestPrunerReconcile(t *testing.T) {
 					for _, cronJob := range cronJobsList.Items {
 						podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
 
-						// verify command, for now we have only one podSpec in the pod
+						// verify the pruner runs with the image entrypoint, for now we have only one podSpec in the pod
 						container := podSpec.Containers[0]
-						assert.Equal(t, []string{"/bin/sh", "-c", prunerCommand}, container.Command)
+						assert.Empty(t, container.Command)
 
 						// verify container image
-						assert.Equal(t, pruner.tknImage, container.Image)
+						assert.Equal(t, pruner.image, container.Image)
 
 						// verify schedule
 						actualSchedule := cronJob.Spec.Schedule
 						expectedArgs, found := reconcile.scheduleAndArgs[actualSchedule]
 						assert.True(t, found, "schedule not found", actualSchedule)
 
-						// verify command args, args index 0 holds "-s", so taking index 1
+						// verify command args, args index 0 holds "--namespaces", so taking index 1
 						actualArgs := container.Args[1]
 						assert.Equal(t, expectedArgs, actualArgs)
 
@@ -859,8 +864,112 @@ func TestPrunerReconcile(t *testing.T) {
 
 					// confirm all the schedules verified
 					assert.Empty(t, reconcile.scheduleAndArgs)
+
+					// verify the cron jobs are reported in the status
+					assert.Equal(t, len(cronJobsList.Items), len(test.tektonConfig.Status.PrunerJobs))
 				})
 			}
 		})
 	}
 }
+
+func TestPrunerReportPrunerJobs(t *testing.T) {
+	t.Setenv(prunerContainerImageEnvKey, "pruner_image:tag-123")
+	ctx := context.Background()
+	client := getTestKubeClient()
+	tc := getTestTektonConfig()
+	keep := uint(3)
+	tc.Spec.Pruner.Keep = &keep
+
+	pruner, err := getPruner(ctx, client, tc)
+	assert.NoError(t, err)
+	assert.NoError(t, pruner.reconcile(ctx))
+	assert.Len(t, tc.Status.PrunerJobs, 1)
+	assert.Equal(t, "* * * * *", tc.Status.PrunerJobs[0].Schedule)
+	assert.Nil(t, tc.Status.PrunerJobs[0].LastRun)
+
+	// the pruner annotates its cron job with the summary of the run
+	summary := `{"deleted":3,"kept":2,"failed":1,"namespaces":[` +
+		`{"namespace":"ns-one","deleted":3,"kept":1},` +
+		`{"namespace":"ns-two","kept":1,"failed":1,"errors":["forbidden"]}]}`
+	cronJob, err := client.BatchV1().CronJobs(tc.Spec.TargetNamespace).Get(ctx, tc.Status.PrunerJobs[0].Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	cronJob.Annotations[jobpruner.SummaryAnnotation] = summary
+	_, err = client.BatchV1().CronJobs(tc.Spec.TargetNamespace).Update(ctx, cronJob, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, pruner.reconcile(ctx))
+	assert.Len(t, tc.Status.PrunerJobs, 1)
+	assert.Equal(t, cronJob.Name, tc.Status.PrunerJobs[0].Name)
+	assert.Equal(t, &v1alpha1.PruneSummary{
+		Deleted: 3,
+		Kept:    2,
+		Failed:  1,
+		Namespaces: []v1alpha1.NamespacePruneSummary{
+			{Namespace: "ns-two", Kept: 1, Failed: 1, Errors: []string{"forbidden"}},
+		},
+	}, tc.Status.PrunerJobs[0].LastRun)
+
+	// the status is cleared when the pruner is disabled
+	tc.Spec.Pruner.Disabled = true
+	assert.NoError(t, pruner.reconcile(ctx))
+	assert.Empty(t, tc.Status.PrunerJobs)
+}
+
+func TestPrunerDryRun(t *testing.T) {
+	t.Setenv(prunerContainerImageEnvKey, "pruner_image:tag-123")
+	ctx := context.Background()
+	client := getTestKubeClient()
+	tc := getTestTektonConfig()
+	keep := uint(3)
+	tc.Spec.Pruner.Keep = &keep
+
+	pruner, err := getPruner(ctx, client, tc)
+	assert.NoError(t, err)
+	assert.NoError(t, pruner.reconcile(ctx))
+	cronJobs, err := client.BatchV1().CronJobs(tc.Spec.TargetNamespace).List(ctx, metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, cronJobs.Items, 1)
+	assert.NotContains(t, cronJobs.Items[0].Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args, "--dry-run")
+
+	// the cron job is replaced to run in dry-run
+	tc.Spec.Pruner.DryRun = true
+	assert.NoError(t, pruner.reconcile(ctx))
+	cronJobs, err = client.BatchV1().CronJobs(tc.Spec.TargetNamespace).List(ctx, metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, cronJobs.Items, 1)
+	assert.Contains(t, cronJobs.Items[0].Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args, "--dry-run")
+}
+
+func TestPrunerResultsGuard(t *testing.T) {
+	t.Setenv(prunerContainerImageEnvKey, "pruner_image:tag-123")
+	ctx := context.Background()
+	client := getTestKubeClient()
+	tc := getTestTektonConfig()
+	keep := uint(3)
+	tc.Spec.Pruner.Keep = &keep
+
+	pruner, err := getPruner(ctx, client, tc)
+	assert.NoError(t, err)
+	assert.NoError(t, pruner.reconcile(ctx))
+	cronJobs, err := client.BatchV1().CronJobs(tc.Spec.TargetNamespace).List(ctx, metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, cronJobs.Items, 1)
+	assert.NotContains(t, cronJobs.Items[0].Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args, "--results-grace-period")
+
+	// the cron job is replaced to keep the runs not stored when results is installed
+	tc.Spec.Profile = v1alpha1.ProfileAll
+	assert.NoError(t, pruner.reconcile(ctx))
+	cronJobs, err = client.BatchV1().CronJobs(tc.Spec.TargetNamespace).List(ctx, metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, cronJobs.Items, 1)
+	args := cronJobs.Items[0].Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args
+	assert.Equal(t, []string{"--results-grace-period", "24h0m0s"}, args[len(args)-2:])
+
+	tc.Spec.ResultsGuard.Disabled = true
+	assert.NoError(t, pruner.reconcile(ctx))
+	cronJobs, err = client.BatchV1().CronJobs(tc.Spec.TargetNamespace).List(ctx, metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, cronJobs.Items, 1)
+	assert.NotContains(t, cronJobs.Items[0].Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args, "--results-grace-period")
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"slices"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/controller"
+)
+
+const (
+	// IssuedAtAnnotation records on a Secret when the operator issued its
+	// content
+	IssuedAtAnnotation = "operator.tekton.dev/issued-at"
+	// RotationRequestAnnotation records on a Secret the value of the rotate
+	// annotation its content was issued for
+	RotationRequestAnnotation = "operator.tekton.dev/rotation-request"
+	// RotatedAtAnnotation is set on the pod template of the workloads using
+	// generated material, a rotation changes it and rolls their pods
+	RotatedAtAnnotation = "operator.tekton.dev/rotated-at"
+)
+
+// IssueAnnotations returns the annotations recording that material was
+// issued at now, for the rotation requested on the owner if any
+func IssueAnnotations(owner metav1.Object, now time.Time) map[string]string {
+	annotations := map[string]string{
+		IssuedAtAnnotation: now.UTC().Format(time.RFC3339),
+	}
+	if request := owner.GetAnnotations()[v1alpha1.RotateAnnotation]; request != "" {
+		annotations[RotationRequestAnnotation] = request
+	}
+	return annotations
+}
+
+// MarkIssued records on the Secret that its content was issued at now
+func MarkIssued(secret *corev1.Secret, owner metav1.Object, now time.Time) {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	for key, value := range IssueAnnotations(owner, now) {
+		secret.Annotations[key] = value
+	}
+}
+
+// IssuedAt returns when the content of the Secret was issued, its creation
+// time when the operator did not record it
+func IssuedAt(secret *corev1.Secret) time.Time {
+	if issuedAt, err := time.Parse(time.RFC3339, secret.Annotations[IssuedAtAnnotation]); err == nil {
+		return issuedAt
+	}
+	return secret.CreationTimestamp.Time
+}
+
+// RotationRequested tells whether a rotation is requested on the owner which
+// the content of the Secret was not issued for
+func RotationRequested(owner metav1.Object, secret *corev1.Secret) bool {
+	request := owner.GetAnnotations()[v1alpha1.RotateAnnotation]
+	return request != "" && request != secret.Annotations[RotationRequestAnnotation]
+}
+
+// RotationDue tells whether material issued at issuedAt is to be rotated
+func RotationDue(policy v1alpha1.RotationPolicy, issuedAt, now time.Time) bool {
+	next := policy.NextRotation(issuedAt)
+	return !next.IsZero() && !now.Before(next)
+}
+
+// NewRotationStatus returns the rotation status of the material held by the
+// Secret, the next rotation is unset when it is zero
+func NewRotationStatus(name string, issuedAt, next time.Time) v1alpha1.RotationStatus {
+	status := v1alpha1.RotationStatus{
+		Name:             name,
+		LastRotationTime: &metav1.Time{Time: issuedAt},
+	}
+	if !next.IsZero() {
+		status.NextRotationTime = &metav1.Time{Time: next}
+	}
+	return status
+}
+
+// LastRotation returns when the most recent of the Secrets was rotated,
+// empty when none of them is managed by the operator
+func LastRotation(statuses []v1alpha1.RotationStatus, names ...string) string {
+	var last time.Time
+	for _, status := range statuses {
+		if slices.Contains(names, status.Name) && status.LastRotationTime != nil && status.LastRotationTime.After(last) {
+			last = status.LastRotationTime.Time
+		}
+	}
+	if last.IsZero() {
+		return ""
+	}
+	return last.UTC().Format(time.RFC3339)
+}
+
+// RequeueForRotation requeues the component at the next rotation of its
+// material, it returns nil when no rotation is scheduled
+func RequeueForRotation(statuses []v1alpha1.RotationStatus, now time.Time) error {
+	return RequeueAt(now, NextRotations(statuses)...)
+}
+
+// NextRotations returns when the material of the statuses is rotated next
+func NextRotations(statuses []v1alpha1.RotationStatus) []time.Time {
+	var next []time.Time
+	for _, status := range statuses {
+		if status.NextRotationTime != nil {
+			next = append(next, status.NextRotationTime.Time)
+		}
+	}
+	return next
+}
+
+// RequeueAt requeues the component at the earliest of the given times, zero
+// times are ignored and it returns nil when there is none
+func RequeueAt(now time.Time, times ...time.Time) error {
+	var next time.Time
+	for _, t := range times {
+		if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	if next.IsZero() {
+		return nil
+	}
+	return controller.NewRequeueAfter(max(next.Sub(now), v1alpha1.RequeueDelay))
+}
+
+// AddRotatedAtAnnotation annotates the pod template of the Deployments and
+// StatefulSets with the time the material they use was rotated
+func AddRotatedAtAnnotation(rotatedAt string, names ...string) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if rotatedAt == "" || (u.GetKind() != "Deployment" && u.GetKind() != "StatefulSet") || !slices.Contains(names, u.GetName()) {
+			return nil
+		}
+		annotations, _, err := unstructured.NestedStringMap(u.Object, "spec", "template", "metadata", "annotations")
+		if err != nil {
+			return err
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[RotatedAtAnnotation] = rotatedAt
+		return unstructured.SetNestedStringMap(u.Object, annotations, "spec", "template", "metadata", "annotations")
+	}
+}
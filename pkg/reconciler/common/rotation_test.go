@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+)
+
+func TestRotationRequested(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	owner := &v1alpha1.TektonResult{}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: now.Add(-time.Hour)}}}
+
+	assert.Equal(t, IssuedAt(secret), now.Add(-time.Hour))
+	assert.Assert(t, !RotationRequested(owner, secret))
+
+	owner.Annotations = map[string]string{v1alpha1.RotateAnnotation: "first"}
+	assert.Assert(t, RotationRequested(owner, secret))
+
+	MarkIssued(secret, owner, now)
+	assert.Equal(t, IssuedAt(secret), now)
+	assert.Equal(t, secret.Annotations[RotationRequestAnnotation], "first")
+	assert.Assert(t, !RotationRequested(owner, secret))
+
+	owner.Annotations[v1alpha1.RotateAnnotation] = "second"
+	assert.Assert(t, RotationRequested(owner, secret))
+}
+
+func TestRotationDue(t *testing.T) {
+	issuedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	policy := v1alpha1.RotationPolicy{}
+	assert.Assert(t, !RotationDue(policy, issuedAt, issuedAt.Add(1000*time.Hour)))
+
+	policy.Validity = &metav1.Duration{Duration: 30 * time.Hour}
+	assert.Assert(t, !RotationDue(policy, issuedAt, issuedAt.Add(19*time.Hour)))
+	assert.Assert(t, RotationDue(policy, issuedAt, issuedAt.Add(20*time.Hour)))
+}
+
+func TestRequeueForRotation(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	statuses := []v1alpha1.RotationStatus{NewRotationStatus("on-demand", now, time.Time{})}
+	assert.NilError(t, RequeueForRotation(statuses, now))
+	assert.Equal(t, LastRotation(statuses, "other"), "")
+	assert.Equal(t, LastRotation(statuses, "on-demand"), "2026-03-01T00:00:00Z")
+
+	statuses = append(statuses,
+		NewRotationStatus("later", now.Add(-time.Hour), now.Add(3*time.Hour)),
+		NewRotationStatus("sooner", now.Add(-2*time.Hour), now.Add(time.Hour)),
+	)
+	assert.Equal(t, LastRotation(statuses, "later", "sooner"), "2026-02-28T23:00:00Z")
+	ok, delay := controller.IsRequeueKey(RequeueForRotation(statuses, now))
+	assert.Assert(t, ok)
+	assert.Equal(t, delay, time.Hour)
+
+	ok, delay = controller.IsRequeueKey(RequeueForRotation(statuses, now.Add(2*time.Hour)))
+	assert.Assert(t, ok)
+	assert.Equal(t, delay, v1alpha1.RequeueDelay)
+}
+
+func TestRequeueAt(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.NilError(t, RequeueAt(now))
+	assert.NilError(t, RequeueAt(now, time.Time{}))
+
+	ok, delay := controller.IsRequeueKey(RequeueAt(now, time.Time{}, now.Add(2*time.Hour), now.Add(30*time.Minute)))
+	assert.Assert(t, ok)
+	assert.Equal(t, delay, 30*time.Minute)
+}
@@ -18,6 +18,10 @@ package common
 
 import (
 	"context"
+	"encoding/json"
+	"path"
+	"slices"
+	"sort"
 	"strings"
 
 	mf "github.com/manifestival/manifestival"
@@ -100,6 +104,10 @@ func (ot *OptionsTransformer) addInToManifest(manifest *mf.Manifest, additionalR
 }
 
 func (ot *OptionsTransformer) transform(u *unstructured.Unstructured) error {
+	if err := ot.updateIgnoreDifferences(u); err != nil {
+		return err
+	}
+
 	switch u.GetKind() {
 	case KindConfigMap:
 		return ot.updateConfigMaps(u)
@@ -126,6 +134,38 @@ func (ot *OptionsTransformer) transform(u *unstructured.Unstructured) error {
 	return nil
 }
 
+// updateIgnoreDifferences annotates the resource with the JSON pointers of the
+// fields which are not reconciled, the installer set keeps the values of these
+// fields found on the cluster
+func (ot *OptionsTransformer) updateIgnoreDifferences(u *unstructured.Unstructured) error {
+	pointers := []string{}
+	for _, ignoreDifference := range ot.options.IgnoreDifferences {
+		if ignoreDifference.Kind != u.GetKind() || ignoreDifference.Group != u.GroupVersionKind().Group {
+			continue
+		}
+		if ignoreDifference.Name != "" {
+			if matched, _ := path.Match(ignoreDifference.Name, u.GetName()); !matched {
+				continue
+			}
+		}
+		for _, pointer := range ignoreDifference.JSONPointers {
+			if !slices.Contains(pointers, pointer) {
+				pointers = append(pointers, pointer)
+			}
+		}
+	}
+	if len(pointers) == 0 {
+		return nil
+	}
+	sort.Strings(pointers)
+
+	value, err := json.Marshal(pointers)
+	if err != nil {
+		return err
+	}
+	return ot.updateAnnotations(u, map[string]string{v1alpha1.IgnoreDifferencesKey: string(value)})
+}
+
 func (ot *OptionsTransformer) updateLabels(u *unstructured.Unstructured, labels map[string]string) error {
 	return ot.updateMapField(u, labels, "metadata", "labels")
 }
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	mf "github.com/manifestival/manifestival"
 	"github.com/stretchr/testify/require"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/pipeline/test/diff"
@@ -1108,3 +1109,49 @@ func TestDeploymentStrategyDoesNotAffectSpecHash(t *testing.T) {
 	})
 	require.NotEqual(t, baseline, replicasChanged, "changing the replicas must change the spec hash")
 }
+
+func TestAdditionalOptionsIgnoreDifferences(t *testing.T) {
+	ctx := context.TODO()
+	deployment := func(name string) unstructured.Unstructured {
+		u := unstructured.Unstructured{}
+		u.SetAPIVersion("apps/v1")
+		u.SetKind("Deployment")
+		u.SetNamespace("tekton-pipelines")
+		u.SetName(name)
+		return u
+	}
+	configMap := unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetNamespace("tekton-pipelines")
+	configMap.SetName("feature-flags")
+
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		deployment("tekton-pipelines-controller"),
+		deployment("tekton-pipelines-webhook"),
+		configMap,
+	}))
+	require.NoError(t, err)
+
+	options := v1alpha1.AdditionalOptions{
+		IgnoreDifferences: []v1alpha1.IgnoreDifference{
+			{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+			{Group: "apps", Kind: "Deployment", Name: "*-controller", JSONPointers: []string{"/spec/template/spec/containers/0/resources", "/spec/replicas"}},
+			// group does not match
+			{Kind: "Deployment", JSONPointers: []string{"/spec/template/metadata/annotations"}},
+		},
+	}
+	err = ExecuteAdditionalOptionsTransformer(ctx, &manifest, "tekton-pipelines", options)
+	require.NoError(t, err)
+
+	want := map[string]string{
+		"tekton-pipelines-controller": `["/spec/replicas","/spec/template/spec/containers/0/resources"]`,
+		"tekton-pipelines-webhook":    `["/spec/replicas"]`,
+		"feature-flags":               "",
+	}
+	for _, u := range manifest.Resources() {
+		if got := u.GetAnnotations()[v1alpha1.IgnoreDifferencesKey]; got != want[u.GetName()] {
+			t.Errorf("%s: expected ignore differences annotation %q, got %q", u.GetName(), want[u.GetName()], got)
+		}
+	}
+}
@@ -61,7 +61,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			logger.Fatal(err)
 		}
 
-		metrics, _ := NewRecorder()
+		metrics, _ := common.NewRecorder(v1alpha1.KindManualApprovalGate)
 
 		tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
 
@@ -82,6 +82,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			platformParams:            params,
 		}
 		impl := manualapprovalgatereconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindManualApprovalGate, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for ManualApprovalGate")
 
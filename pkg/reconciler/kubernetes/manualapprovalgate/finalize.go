@@ -22,6 +22,7 @@ import (
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	manualapprovalgatereconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/manualapprovalgate"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
@@ -31,6 +32,7 @@ var _ manualapprovalgatereconciler.Finalizer = (*Reconciler)(nil)
 // FinalizeKind removes all resources after deletion of a ManualApprovalGate CR.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.ManualApprovalGate) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
+	common.ForgetEvents(original)
 
 	//Delete CRDs before deleting rest of resources so that any instance
 	//of CRDs which has finalizer set will get deleted before we remove
@@ -76,9 +76,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, mag *v1alpha1.ManualAppr
 		)
 		logger.Errorw("Invalid resource name", "expectedName", v1alpha1.ManualApprovalGates, "actualName", mag.GetName())
 		mag.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, mag, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, mag, &mag.Status); err != nil {
+		return err
+	}
+
 	// reconcile target namespace
 	logger.Debug("Reconciling target namespace")
 	if err := common.ReconcileTargetNamespace(ctx, nil, nil, mag, r.kubeClientSet); err != nil {
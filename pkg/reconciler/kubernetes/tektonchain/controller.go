@@ -65,7 +65,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 		}
 		tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
 
-		metrics, _ := common.NoMetrics()
+		metrics, _ := common.NewRecorder(v1alpha1.KindTektonChain)
 
 		params := networkpolicy.KubernetesPlatformDefaults()
 		if v1alpha1.IsOpenShiftPlatform() {
@@ -83,6 +83,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			platformParams:     params,
 		}
 		impl := tektonChainreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonChain, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for Tekton Chain")
 
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonchain
+
+import (
+	"path"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	kmsAuthTokenVolume = "kms-auth-token"
+	kmsAuthTokenDir    = "/etc/chains/kms-auth-token"
+	kmsAuthTokenFile   = "token"
+
+	mongoServerURLVolume = "mongo-server-url"
+	mongoServerURLDir    = "/etc/chains/mongo-server-url"
+	// Chains reads the URL from the MONGO_SERVER_URL file of
+	// storage.docdb.mongo-server-url-dir
+	mongoServerURLFile = "MONGO_SERVER_URL"
+)
+
+// chainProperties returns the properties set in chains-config, the
+// credentials referenced from Secrets are read from the files they are
+// mounted as
+func chainProperties(chain v1alpha1.Chain) v1alpha1.ChainProperties {
+	props := chain.ChainProperties
+	if chain.Credentials.KMSAuthToken != nil {
+		props.KMSAuthToken = ""
+		props.KMSAuthTokenPath = path.Join(kmsAuthTokenDir, kmsAuthTokenFile)
+	}
+	if chain.Credentials.MongoServerURL != nil {
+		props.StorageDocDBMongoServerURL = ""
+		props.StorageDocDBMongoServerURLDir = mongoServerURLDir
+	}
+	return props
+}
+
+// mountCredentials mounts the keys of the Secrets referenced by the
+// credentials in the Chains controller
+func mountCredentials(credentials v1alpha1.ChainCredentials) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "Deployment" || u.GetName() != chainsDeploymentName ||
+			(credentials.KMSAuthToken == nil && credentials.MongoServerURL == nil) {
+			return nil
+		}
+
+		d := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d); err != nil {
+			return err
+		}
+		if credentials.KMSAuthToken != nil {
+			mountSecretKey(&d.Spec.Template.Spec, kmsAuthTokenVolume, kmsAuthTokenDir, kmsAuthTokenFile, credentials.KMSAuthToken)
+		}
+		if credentials.MongoServerURL != nil {
+			mountSecretKey(&d.Spec.Template.Spec, mongoServerURLVolume, mongoServerURLDir, mongoServerURLFile, credentials.MongoServerURL)
+		}
+
+		unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(d)
+		if err != nil {
+			return err
+		}
+		u.SetUnstructuredContent(unstrObj)
+		return nil
+	}
+}
+
+// mountSecretKey mounts the key of the Secret as file in dir of the Chains
+// controller container
+func mountSecretKey(spec *corev1.PodSpec, volumeName, dir, file string, ref *corev1.SecretKeySelector) {
+	volume := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: ref.Name,
+				Items:      []corev1.KeyToPath{{Key: ref.Key, Path: file}},
+				Optional:   ref.Optional,
+			},
+		},
+	}
+	spec.Volumes = append(removeVolume(spec.Volumes, volumeName), volume)
+
+	for i, c := range spec.Containers {
+		if c.Name != chainsContainerName {
+			continue
+		}
+		mounts := []corev1.VolumeMount{}
+		for _, mount := range c.VolumeMounts {
+			if mount.Name != volumeName {
+				mounts = append(mounts, mount)
+			}
+		}
+		spec.Containers[i].VolumeMounts = append(mounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: dir,
+			ReadOnly:  true,
+		})
+	}
+}
+
+func removeVolume(volumes []corev1.Volume, name string) []corev1.Volume {
+	result := []corev1.Volume{}
+	for _, volume := range volumes {
+		if volume.Name != name {
+			result = append(result, volume)
+		}
+	}
+	return result
+}
@@ -19,6 +19,7 @@ package tektonchain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
@@ -42,6 +43,12 @@ func (r *Reconciler) createSecretInstallerSet(ctx context.Context, tc *v1alpha1.
 
 	// generate installer set
 	tis := makeInstallerSet(tc, manifest, secretChainInstallerset, "", r.operatorVersion)
+	// publish the public key of the generated signing key
+	manifests, err := withPublicKeys(tc, tis.Spec.Manifests, nil, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	tis.Spec.Manifests = manifests
 
 	// Add annoation to secret installer set in case the generate secret signing is set to true
 	if tc.Spec.GenerateSigningSecret {
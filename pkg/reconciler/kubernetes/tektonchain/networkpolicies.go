@@ -75,19 +75,24 @@ func (r *Reconciler) reconcileNetworkPolicies(ctx context.Context, tc *v1alpha1.
 	if tc.Spec.NetworkPolicy.Disabled {
 		return r.installerSetClient.CleanupCustomSet(ctx, "chain-network-policies")
 	}
+	manifest, err := networkPolicies(tc, r.platformParams)
+	if err != nil {
+		return err
+	}
+	return r.installerSetClient.CustomSet(ctx, tc, "chain-network-policies", &manifest, npPassthroughTransform, nil)
+}
+
+// networkPolicies generates the NetworkPolicy manifest for the given TektonChain
+func networkPolicies(tc *v1alpha1.TektonChain, params networkpolicy.PlatformParams) (mf.Manifest, error) {
 	defaults := append(
 		[]networkingv1.NetworkPolicy{chainsControllerDefaultDenyPolicy()},
-		chainsControllerDefaultPolicies(r.platformParams)...,
+		chainsControllerDefaultPolicies(params)...,
 	)
-	manifest, err := networkpolicy.Generate(
+	return networkpolicy.Generate(
 		tc.Spec.NetworkPolicy,
 		tc.Spec.GetTargetNamespace(),
 		defaults,
 	)
-	if err != nil {
-		return err
-	}
-	return r.installerSetClient.CustomSet(ctx, tc, "chain-network-policies", &manifest, npPassthroughTransform, nil)
 }
 
 // npPassthroughTransform is a no-op FilterAndTransform used for pre-built
@@ -0,0 +1,295 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonchain
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	v1 "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// signingSecretName is the Secret holding the signing key of Chains
+	signingSecretName = "signing-secrets"
+	// keyTypeAnnotation records on the signing Secret the type of its key
+	keyTypeAnnotation     = "operator.tekton.dev/key-type"
+	defaultSigningKeyType = "ecdsa-p256"
+
+	// publicKeysConfigMap publishes the public key of the signing key and
+	// the public keys of the retired signing keys, for the verifiers of the
+	// signed artifacts
+	publicKeysConfigMap = "chains-public-keys"
+	currentPublicKey    = "cosign.pub"
+	// retired public keys are named after the time they were retired
+	retiredPublicKeyPrefix     = "retired-"
+	retiredPublicKeySuffix     = ".pub"
+	retiredPublicKeyTimeFormat = "20060102T150405Z"
+)
+
+// signingKeyAlgorithms maps the signing key types to the algorithms cosign
+// generates them with
+var signingKeyAlgorithms = map[string]v1.PublicKeyDetails{
+	"ecdsa-p256": v1.PublicKeyDetails_PKIX_ECDSA_P256_SHA_256,
+	"ecdsa-p384": v1.PublicKeyDetails_PKIX_ECDSA_P384_SHA_384,
+	"ecdsa-p521": v1.PublicKeyDetails_PKIX_ECDSA_P521_SHA_512,
+	"rsa-2048":   v1.PublicKeyDetails_PKIX_RSA_PKCS1V15_2048_SHA256,
+	"rsa-3072":   v1.PublicKeyDetails_PKIX_RSA_PKCS1V15_3072_SHA256,
+	"rsa-4096":   v1.PublicKeyDetails_PKIX_RSA_PKCS1V15_4096_SHA256,
+	"ed25519":    v1.PublicKeyDetails_PKIX_ED25519_PH,
+}
+
+func signingKeyType(tc *v1alpha1.TektonChain) string {
+	if tc.Spec.SigningKeyType == "" {
+		return defaultSigningKeyType
+	}
+	return tc.Spec.SigningKeyType
+}
+
+func signingKeyAlgorithm(keyType string) (signature.AlgorithmDetails, error) {
+	algorithm, ok := signingKeyAlgorithms[keyType]
+	if !ok {
+		return signature.AlgorithmDetails{}, fmt.Errorf("unsupported signing key type %q", keyType)
+	}
+	return signature.GetAlgorithmDetails(algorithm)
+}
+
+// secretKeyType returns the type of the key of the signing Secret, keys
+// generated before the type was recorded are ecdsa-p256 keys
+func secretKeyType(secret *corev1.Secret) string {
+	if keyType := secret.Annotations[keyTypeAnnotation]; keyType != "" {
+		return keyType
+	}
+	return defaultSigningKeyType
+}
+
+// signingSecret returns the signing Secret applied by the secret installer
+// set, it was issued with the installer set when it does not record it
+func signingSecret(tis *v1alpha1.TektonInstallerSet) (*corev1.Secret, error) {
+	for _, u := range tis.Spec.Manifests {
+		if u.GetKind() != "Secret" || u.GetName() != signingSecretName {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, secret); err != nil {
+			return nil, err
+		}
+		if secret.CreationTimestamp.IsZero() {
+			secret.CreationTimestamp = tis.CreationTimestamp
+		}
+		return secret, nil
+	}
+	return nil, fmt.Errorf("secret %s not found in installer set %s", signingSecretName, tis.Name)
+}
+
+// signingKeysRotatedAt returns when the signing key was last rotated, a
+// change rolls the Chains controller
+func signingKeysRotatedAt(tc *v1alpha1.TektonChain) string {
+	return common.LastRotation(tc.Status.Rotations, signingSecretName)
+}
+
+func recordSigningKeysRotation(tc *v1alpha1.TektonChain, secret *corev1.Secret) {
+	issuedAt := common.IssuedAt(secret)
+	tc.Status.Rotations = v1alpha1.SetRotation(tc.Status.Rotations,
+		common.NewRotationStatus(signingSecretName, issuedAt, tc.Spec.Rotation.SigningKeys.NextRotation(issuedAt)))
+}
+
+// rotateSigningSecrets generates a new signing key in the secret installer
+// set when it is due or requested. It requeues after a rotation so that the
+// Chains controller is rolled.
+func (r *Reconciler) rotateSigningSecrets(ctx context.Context, tc *v1alpha1.TektonChain, tis *v1alpha1.TektonInstallerSet) error {
+	logger := logging.FromContext(ctx)
+
+	if !tc.Spec.GenerateSigningSecret {
+		tc.Status.Rotations = v1alpha1.RemoveRotation(tc.Status.Rotations, signingSecretName)
+		return nil
+	}
+	secret, err := signingSecret(tis)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if !common.RotationRequested(tc, secret) && !common.RotationDue(tc.Spec.Rotation.SigningKeys, common.IssuedAt(secret), now) &&
+		secretKeyType(secret) == signingKeyType(tc) {
+		recordSigningKeysRotation(tc, secret)
+		return r.publishPublicKeys(ctx, tc, tis, now)
+	}
+
+	logger.Infow("Rotating the signing key", "secret", signingSecretName, "installerSet", tis.Name, "keyType", signingKeyType(tc))
+	manifest := r.manifest.Filter(mf.ByKind("Secret"))
+	transformer := filterAndTransform(r.extension)
+	if _, err := transformer(ctx, &manifest, tc); err != nil {
+		return err
+	}
+	rotated := tis.DeepCopy()
+	// the public key of the current signing key is retired, so that the
+	// artifacts it signed can still be verified
+	if rotated.Spec.Manifests, err = withPublicKeys(tc, manifest.Resources(), tis.Spec.Manifests, now); err != nil {
+		return err
+	}
+	secret, err = signingSecret(rotated)
+	if err != nil {
+		return err
+	}
+	// keep the current key when a new one could not be generated
+	if len(secret.Data) == 0 {
+		return fmt.Errorf("failed to generate a new signing key for secret %s", signingSecretName)
+	}
+	if _, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
+		Update(ctx, rotated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	recordSigningKeysRotation(tc, secret)
+	return v1alpha1.REQUEUE_EVENT_AFTER
+}
+
+// publishPublicKeys adds the ConfigMap publishing the public keys to the
+// secret installer set and drops the retired keys out of their retention
+func (r *Reconciler) publishPublicKeys(ctx context.Context, tc *v1alpha1.TektonChain, tis *v1alpha1.TektonInstallerSet, now time.Time) error {
+	manifests, err := withPublicKeys(tc, tis.Spec.Manifests, tis.Spec.Manifests, now)
+	if err != nil {
+		return err
+	}
+	published, err := publicKeys(tis.Spec.Manifests)
+	if err != nil {
+		return err
+	}
+	expected, err := publicKeys(manifests)
+	if err != nil {
+		return err
+	}
+	if expected == nil || (published != nil && maps.Equal(published.Data, expected.Data)) {
+		return nil
+	}
+	logging.FromContext(ctx).Infow("Publishing the public keys", "configMap", publicKeysConfigMap, "installerSet", tis.Name)
+	updated := tis.DeepCopy()
+	updated.Spec.Manifests = manifests
+	_, err = r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().Update(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// withPublicKeys returns the manifests with the ConfigMap publishing the
+// public key of their signing Secret. The public keys retired in the
+// previous manifests are kept for their retention, and the previous public
+// key is retired when the signing key changed. The previous ConfigMap is
+// kept as is when the manifests have no signing key.
+func withPublicKeys(tc *v1alpha1.TektonChain, manifests, previous []unstructured.Unstructured, now time.Time) ([]unstructured.Unstructured, error) {
+	published, err := publicKeys(previous)
+	if err != nil {
+		return nil, err
+	}
+	current := ""
+	secret, err := signingSecret(&v1alpha1.TektonInstallerSet{Spec: v1alpha1.TektonInstallerSetSpec{Manifests: manifests}})
+	if err == nil {
+		current = string(secret.Data[currentPublicKey])
+	}
+	if current == "" {
+		if published == nil {
+			return manifests, nil
+		}
+		return setPublicKeys(manifests, published)
+	}
+
+	data := map[string]string{currentPublicKey: current}
+	previousKey := ""
+	if published != nil {
+		retention := tc.Spec.Rotation.RetiredKeysRetention
+		for key, value := range published.Data {
+			retiredAt, ok := retiredPublicKeyTime(key)
+			if ok && (retention == nil || now.Sub(retiredAt) < retention.Duration) {
+				data[key] = value
+			}
+		}
+		previousKey = published.Data[currentPublicKey]
+	} else if previousSecret, err := signingSecret(&v1alpha1.TektonInstallerSet{Spec: v1alpha1.TektonInstallerSetSpec{Manifests: previous}}); err == nil {
+		// the public keys were not published before
+		previousKey = string(previousSecret.Data[currentPublicKey])
+	}
+	if previousKey != "" && previousKey != current {
+		// keys retired within the same second get the next free name
+		retiredAt := now
+		for data[retiredPublicKeyName(retiredAt)] != "" {
+			retiredAt = retiredAt.Add(time.Second)
+		}
+		data[retiredPublicKeyName(retiredAt)] = previousKey
+	}
+	return setPublicKeys(manifests, &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      publicKeysConfigMap,
+			Namespace: secret.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "tekton-chains",
+			},
+		},
+		Data: data,
+	})
+}
+
+func retiredPublicKeyName(retiredAt time.Time) string {
+	return retiredPublicKeyPrefix + retiredAt.UTC().Format(retiredPublicKeyTimeFormat) + retiredPublicKeySuffix
+}
+
+func retiredPublicKeyTime(key string) (time.Time, bool) {
+	if !strings.HasPrefix(key, retiredPublicKeyPrefix) || !strings.HasSuffix(key, retiredPublicKeySuffix) {
+		return time.Time{}, false
+	}
+	retiredAt, err := time.Parse(retiredPublicKeyTimeFormat, strings.TrimSuffix(strings.TrimPrefix(key, retiredPublicKeyPrefix), retiredPublicKeySuffix))
+	return retiredAt, err == nil
+}
+
+// publicKeys returns the ConfigMap publishing the public keys in the
+// manifests, nil when there is none
+func publicKeys(manifests []unstructured.Unstructured) (*corev1.ConfigMap, error) {
+	for _, u := range manifests {
+		if u.GetKind() != "ConfigMap" || u.GetName() != publicKeysConfigMap {
+			continue
+		}
+		cm := &corev1.ConfigMap{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, cm); err != nil {
+			return nil, err
+		}
+		return cm, nil
+	}
+	return nil, nil
+}
+
+func setPublicKeys(manifests []unstructured.Unstructured, cm *corev1.ConfigMap) ([]unstructured.Unstructured, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+	if err != nil {
+		return nil, err
+	}
+	result := []unstructured.Unstructured{}
+	for _, u := range manifests {
+		if u.GetKind() != "ConfigMap" || u.GetName() != publicKeysConfigMap {
+			result = append(result, u)
+		}
+	}
+	return append(result, unstructured.Unstructured{Object: obj}), nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonchain
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func signingSecretManifest(t *testing.T) mf.Manifest {
+	t.Helper()
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": signingSecretName, "namespace": "tekton-pipelines"},
+	}}}))
+	assert.NilError(t, err)
+	return manifest
+}
+
+func TestRotateSigningSecrets(t *testing.T) {
+	ctx := context.Background()
+	tc := &v1alpha1.TektonChain{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ChainResourceName},
+		Spec: v1alpha1.TektonChainSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Chain:      v1alpha1.Chain{GenerateSigningSecret: true},
+		},
+	}
+	r := &Reconciler{manifest: signingSecretManifest(t), extension: common.NoExtension(ctx)}
+
+	manifest := r.manifest
+	_, err := filterAndTransform(r.extension)(ctx, &manifest, tc)
+	assert.NilError(t, err)
+	tis := &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "chain-secret-abc", CreationTimestamp: metav1.Now()},
+		Spec:       v1alpha1.TektonInstallerSetSpec{Manifests: manifest.Resources()},
+	}
+	operatorClient := operatorfake.NewSimpleClientset(tis)
+	r.operatorClientSet = operatorClient
+	issued, err := signingSecret(tis)
+	assert.NilError(t, err)
+	assert.Assert(t, issued.Annotations[common.IssuedAtAnnotation] != "")
+
+	// not due without validity
+	assert.NilError(t, r.rotateSigningSecrets(ctx, tc, tis))
+	assert.Equal(t, signingKeysRotatedAt(tc), common.IssuedAt(issued).UTC().Format(time.RFC3339))
+	assert.Assert(t, tc.Status.Rotations[0].NextRotationTime == nil)
+
+	// a request generates a new key in the installer set
+	tc.Annotations = map[string]string{v1alpha1.RotateAnnotation: "1"}
+	assert.Equal(t, r.rotateSigningSecrets(ctx, tc, tis), v1alpha1.REQUEUE_EVENT_AFTER)
+	updated, err := operatorClient.OperatorV1alpha1().TektonInstallerSets().Get(ctx, tis.Name, metav1.GetOptions{})
+	assert.NilError(t, err)
+	rotated, err := signingSecret(updated)
+	assert.NilError(t, err)
+	assert.Equal(t, rotated.Annotations[common.RotationRequestAnnotation], "1")
+	assert.Assert(t, string(rotated.Data["cosign.pub"]) != string(issued.Data["cosign.pub"]))
+	assert.NilError(t, r.rotateSigningSecrets(ctx, tc, updated))
+
+	// the previous public key stays published
+	published, err := publicKeys(updated.Spec.Manifests)
+	assert.NilError(t, err)
+	assert.Equal(t, len(published.Data), 2)
+	assert.Equal(t, published.Namespace, "tekton-pipelines")
+	assert.Equal(t, published.Data[currentPublicKey], string(rotated.Data["cosign.pub"]))
+	for key, value := range published.Data {
+		if key != currentPublicKey {
+			_, ok := retiredPublicKeyTime(key)
+			assert.Assert(t, ok, key)
+			assert.Equal(t, value, string(issued.Data["cosign.pub"]))
+		}
+	}
+
+	// a new key type rotates the key
+	tc.Spec.SigningKeyType = "ed25519"
+	assert.Equal(t, r.rotateSigningSecrets(ctx, tc, updated), v1alpha1.REQUEUE_EVENT_AFTER)
+	updated, err = operatorClient.OperatorV1alpha1().TektonInstallerSets().Get(ctx, tis.Name, metav1.GetOptions{})
+	assert.NilError(t, err)
+	rotated, err = signingSecret(updated)
+	assert.NilError(t, err)
+	assert.Equal(t, rotated.Annotations[keyTypeAnnotation], "ed25519")
+	key, err := cryptoutils.UnmarshalPEMToPublicKey(rotated.Data["cosign.pub"])
+	assert.NilError(t, err)
+	_, ok := key.(ed25519.PublicKey)
+	assert.Assert(t, ok)
+	published, err = publicKeys(updated.Spec.Manifests)
+	assert.NilError(t, err)
+	assert.Equal(t, len(published.Data), 3)
+	assert.NilError(t, r.rotateSigningSecrets(ctx, tc, updated))
+
+	// the validity schedules the next rotation
+	tc.Spec.Rotation.SigningKeys.Validity = &metav1.Duration{Duration: 90 * time.Hour}
+	assert.NilError(t, r.rotateSigningSecrets(ctx, tc, updated))
+	assert.Equal(t, tc.Status.Rotations[0].NextRotationTime.Time, common.IssuedAt(rotated).Add(60*time.Hour))
+
+	// nothing is reported once the key is not generated
+	tc.Spec.GenerateSigningSecret = false
+	assert.NilError(t, r.rotateSigningSecrets(ctx, tc, updated))
+	assert.Equal(t, len(tc.Status.Rotations), 0)
+}
+
+func TestRotatedAtAnnotationForChains(t *testing.T) {
+	ctx := context.Background()
+	manifest, err := common.Fetch("../../common/testdata/test-convert-chain-deployment-to-statefulset.yaml")
+	assert.NilError(t, err)
+	tc := &v1alpha1.TektonChain{
+		Spec: v1alpha1.TektonChainSpec{CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-chains"}},
+		Status: v1alpha1.TektonChainStatus{Rotations: []v1alpha1.RotationStatus{
+			common.NewRotationStatus(signingSecretName, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Time{}),
+		}},
+	}
+	transformed, err := filterAndTransform(common.NoExtension(ctx))(ctx, &manifest, tc)
+	assert.NilError(t, err)
+	controller := transformed.Filter(mf.ByKind("Deployment"), mf.ByName(chainControllerDeployment)).Resources()[0]
+	annotations, _, err := unstructured.NestedStringMap(controller.Object, "spec", "template", "metadata", "annotations")
+	assert.NilError(t, err)
+	assert.Equal(t, annotations[common.RotatedAtAnnotation], "2026-03-01T00:00:00Z")
+}
+
+func TestWithPublicKeys(t *testing.T) {
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	tc := &v1alpha1.TektonChain{}
+	secret := func(pub string) unstructured.Unstructured {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: signingSecretName, Namespace: "tekton-chains"},
+			Data:       map[string][]byte{"cosign.pub": []byte(pub)},
+		})
+		assert.NilError(t, err)
+		return unstructured.Unstructured{Object: obj}
+	}
+	previous, err := setPublicKeys([]unstructured.Unstructured{secret("current")}, &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: publicKeysConfigMap, Namespace: "tekton-chains"},
+		Data: map[string]string{
+			currentPublicKey:               "current",
+			"retired-20260301T000000Z.pub": "old",
+			"retired-20260101T000000Z.pub": "older",
+			"unrelated":                    "dropped",
+		},
+	})
+	assert.NilError(t, err)
+
+	manifests, err := withPublicKeys(tc, []unstructured.Unstructured{secret("new")}, previous, now)
+	assert.NilError(t, err)
+	published, err := publicKeys(manifests)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, published.Data, map[string]string{
+		currentPublicKey:               "new",
+		"retired-20260310T000000Z.pub": "current",
+		"retired-20260301T000000Z.pub": "old",
+		"retired-20260101T000000Z.pub": "older",
+	})
+
+	// retired keys are dropped after the retention
+	tc.Spec.Rotation.RetiredKeysRetention = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	manifests, err = withPublicKeys(tc, manifests, manifests, now)
+	assert.NilError(t, err)
+	published, err = publicKeys(manifests)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, published.Data, map[string]string{
+		currentPublicKey:               "new",
+		"retired-20260310T000000Z.pub": "current",
+		"retired-20260301T000000Z.pub": "old",
+	})
+	assert.Equal(t, len(manifests), 2)
+
+	// the published keys are kept once no key is generated
+	manifests, err = withPublicKeys(tc, []unstructured.Unstructured{secret("")}, manifests, now)
+	assert.NilError(t, err)
+	kept, err := publicKeys(manifests)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, kept.Data, published.Data)
+}
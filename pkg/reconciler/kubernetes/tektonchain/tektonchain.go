@@ -22,6 +22,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/sigstore/cosign/v2/pkg/cosign"
 
@@ -134,9 +135,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 		)
 		logger.Errorw("Invalid resource name", "expectedName", v1alpha1.ConfigResourceName, "actualName", tc.GetName())
 		tc.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, tc, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, tc, &tc.Status); err != nil {
+		return err
+	}
+
 	// find a valid TektonPipeline installation
 	if _, err := common.PipelineReady(r.pipelineInformer); err != nil {
 		if err.Error() == common.PipelineNotReady || err == v1alpha1.DEPENDENCY_UPGRADE_PENDING_ERR {
@@ -438,12 +444,15 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 		// Hash of TektonChain Spec plus platform-data-hash annotation so that
 		// changes driven by TektonConfig (e.g. enableMetricsMTLS) re-apply
 		// extension transformers even when the spec itself hasn't changed.
+		// The rotation of the signing key rolls the controller.
 		expectedSpecHash, err := hash.Compute(struct {
-			Spec             v1alpha1.TektonChainSpec
-			PlatformDataHash string
+			Spec                 v1alpha1.TektonChainSpec
+			PlatformDataHash     string
+			SigningKeysRotatedAt string
 		}{
-			Spec:             tc.Spec,
-			PlatformDataHash: tc.Annotations[v1alpha1.PlatformDataHashKey],
+			Spec:                 tc.Spec,
+			PlatformDataHash:     tc.Annotations[v1alpha1.PlatformDataHashKey],
+			SigningKeysRotatedAt: signingKeysRotatedAt(tc),
 		})
 		if err != nil {
 			logger.Errorw("Failed to compute spec hash", "error", err)
@@ -599,17 +608,29 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 		// update the installer set annotation
 		installedSecretTIS.Annotations[secretTISSigningAnnotation] = strconv.FormatBool(tc.Spec.GenerateSigningSecret)
 
-		// Update the manifests
-		installedSecretTIS.Spec.Manifests = manifest.Resources()
+		// Update the manifests, keeping the published public keys
+		if installedSecretTIS.Spec.Manifests, err = withPublicKeys(tc, manifest.Resources(), installedSecretTIS.Spec.Manifests, time.Now()); err != nil {
+			logger.Errorw("Failed to publish the public keys", "error", err)
+			return err
+		}
 
-		if _, err = r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
+		if installedSecretTIS, err = r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
 			Update(ctx, installedSecretTIS, metav1.UpdateOptions{}); err != nil {
-			logger.Errorw("Failed to update Secret InstallerSet", "name", installedSecretTIS.Name, "error", err)
+			logger.Errorw("Failed to update Secret InstallerSet", "name", existingSecretInstallerSet, "error", err)
 			return err
 		}
 		logger.Infow("Secret InstallerSet successfully updated", "name", installedSecretTIS.Name)
 	}
 
+	if err := r.rotateSigningSecrets(ctx, tc, installedSecretTIS); err != nil {
+		if err == v1alpha1.REQUEUE_EVENT_AFTER {
+			logger.Infow("Signing key rotated, rolling the controller", "name", installedSecretTIS.Name)
+			return err
+		}
+		logger.Errorw("Failed to rotate the signing key", "error", err)
+		return err
+	}
+
 	// Mark InstallerSetAvailable
 	tc.Status.MarkInstallerSetAvailable()
 
@@ -660,12 +681,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 		return err
 	}
 
-	return nil
+	// reconcile again when the signing key is due for rotation
+	return common.RequeueForRotation(tc.Status.Rotations, time.Now())
 }
 
 // FinalizeKind removes all resources after deletion of a TektonChain.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonChain) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
+	common.ForgetEvents(original)
 
 	// Delete CRDs before deleting rest of resources so that any instance
 	// of CRDs which has finalizer set will get deleted before we remove
@@ -802,9 +825,15 @@ func generateRandomPassword(ctx context.Context) (string, error) {
 	return pass, nil
 }
 
-func generateSigningSecrets(ctx context.Context) map[string][]byte {
+func generateSigningSecrets(ctx context.Context, keyType string) map[string][]byte {
 	logger := logging.FromContext(ctx)
 
+	algorithm, err := signingKeyAlgorithm(keyType)
+	if err != nil {
+		logger.Error("Error selecting the signing key algorithm:", err)
+		return nil
+	}
+
 	randomPassword, err := generateRandomPassword(ctx)
 	if err != nil {
 		logger.Error("Error generating random password %w:", err)
@@ -816,7 +845,7 @@ func generateSigningSecrets(ctx context.Context) map[string][]byte {
 		return []byte(randomPassword), nil
 	}
 
-	keys, err := cosign.GenerateKeyPair(passFunc)
+	keys, err := cosign.GenerateKeyPairWithAlgorithm(&algorithm, passFunc)
 	if err != nil {
 		logger.Error("Error generating cosign key pair:", err)
 		return nil
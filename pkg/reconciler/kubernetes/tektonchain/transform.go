@@ -18,6 +18,7 @@ package tektonchain
 
 import (
 	"context"
+	"time"
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
@@ -45,16 +46,19 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			common.DeploymentImages(chainImages),
 			common.DeploymentEnvVarKubernetesMinVersion(),
 			common.AddConfiguration(chainCR.Spec.Config),
-			common.AddConfigMapValues(ChainsConfig, chainCR.Spec.Chain.ChainProperties),
+			common.AddConfigMapValues(ChainsConfig, chainProperties(chainCR.Spec.Chain)),
 			common.AddDeploymentRestrictedPSA(),
 			AddControllerEnv(chainCR.Spec.Chain.ControllerEnvs),
+			mountCredentials(chainCR.Spec.Chain.Credentials),
 			common.AddConfigMapValues(leaderElectionChainConfig, chainCR.Spec.Chain.Performance.PerformanceLeaderElectionConfig),
 			common.UpdatePerformanceFlagsInDeploymentAndLeaderConfigMap(&chainCR.Spec.Performance, leaderElectionChainConfig, chainControllerDeployment, chainControllerContainer),
+			common.AddRotatedAtAnnotation(signingKeysRotatedAt(chainCR), chainControllerDeployment),
 		}
 		if chainCR.Spec.GenerateSigningSecret {
-			extra = append(extra, common.AddSecretData(generateSigningSecrets(ctx), map[string]string{
-				secretTISSigningAnnotation: "true",
-			}))
+			annotations := common.IssueAnnotations(chainCR, time.Now())
+			annotations[secretTISSigningAnnotation] = "true"
+			annotations[keyTypeAnnotation] = signingKeyType(chainCR)
+			extra = append(extra, common.AddSecretData(generateSigningSecrets(ctx, signingKeyType(chainCR)), annotations))
 		}
 
 		if chainCR.Spec.Performance.StatefulsetOrdinals != nil && *chainCR.Spec.Performance.StatefulsetOrdinals {
@@ -32,7 +32,9 @@ import (
 // Registers eventhandlers to enqueue events
 func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	logger := logging.FromContext(ctx)
-	ctrl := tektonconfig.NewExtensibleController(KubernetesExtension)(ctx, cmw)
+	ctrl := tektonconfig.NewExtensibleController(KubernetesExtension, map[string]tektonconfig.ComponentLister{
+		v1alpha1.KindTektonDashboard: tektonconfig.NewComponentLister(tektonDashboardinformer.Get(ctx).Lister().Get, v1alpha1.DashboardResourceName),
+	})(ctx, cmw)
 	if _, err := tektonDashboardinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.FilterControllerGVK(v1alpha1.SchemeGroupVersion.WithKind("TektonConfig")),
 		Handler:    controller.HandleAll(ctrl.EnqueueControllerOf),
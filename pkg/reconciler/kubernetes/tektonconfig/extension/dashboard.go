@@ -62,9 +62,13 @@ func GetDashboard(ctx context.Context, clients op.TektonDashboardInterface, name
 }
 
 func createDashboard(ctx context.Context, clients op.TektonDashboardInterface, config *v1alpha1.TektonConfig) (*v1alpha1.TektonDashboard, error) {
+	return clients.Create(ctx, GetTektonDashboardCR(config), metav1.CreateOptions{})
+}
+
+func GetTektonDashboardCR(config *v1alpha1.TektonConfig) *v1alpha1.TektonDashboard {
 	ownerRef := *metav1.NewControllerRef(config, config.GroupVersionKind())
 
-	tdCR := &v1alpha1.TektonDashboard{
+	return &v1alpha1.TektonDashboard{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            v1alpha1.DashboardResourceName,
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
@@ -77,7 +81,6 @@ func createDashboard(ctx context.Context, clients op.TektonDashboardInterface, c
 			Dashboard: config.Spec.Dashboard,
 		},
 	}
-	return clients.Create(ctx, tdCR, metav1.CreateOptions{})
 }
 
 func updateDashboard(ctx context.Context, tdCR *v1alpha1.TektonDashboard, config *v1alpha1.TektonConfig,
@@ -65,7 +65,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			logger.Fatal(err)
 		}
 
-		metrics, err := common.NoMetrics()
+		metrics, err := common.NewRecorder(v1alpha1.KindTektonDashboard)
 		if err != nil {
 			logger.Errorf("Failed to create dashboard metrics recorder %v", err)
 		}
@@ -82,6 +82,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			operatorVersion:    operatorVer,
 		}
 		impl := tektonDashboardreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonDashboard, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for tekton-dashboard")
 
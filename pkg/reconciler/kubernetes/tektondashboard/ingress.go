@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektondashboard
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common/ingress"
+)
+
+const (
+	ingressSetName       = "dashboard-ingress"
+	dashboardServiceName = "tekton-dashboard"
+	dashboardServicePort = 9097
+)
+
+// reconcileIngress creates the Ingress or the HTTPRoute exposing the
+// Dashboard, and removes it once it is disabled
+func (r *Reconciler) reconcileIngress(ctx context.Context, td *v1alpha1.TektonDashboard) error {
+	if !td.Spec.Ingress.Enabled {
+		return r.installerSetClient.CleanupCustomSet(ctx, ingressSetName)
+	}
+	manifest, err := dashboardIngress(td)
+	if err != nil {
+		return err
+	}
+	return r.installerSetClient.CustomSet(ctx, td, ingressSetName, &manifest, passthroughTransform, nil)
+}
+
+func dashboardIngress(td *v1alpha1.TektonDashboard) (mf.Manifest, error) {
+	return ingress.Generate(td.Spec.Ingress, td.Spec.GetTargetNamespace(), ingress.Backend{
+		Name:    dashboardServiceName,
+		Service: dashboardServiceName,
+		Port:    dashboardServicePort,
+	})
+}
+
+// passthroughTransform is a no-op FilterAndTransform used for pre-built manifests
+// where the namespace is already set by Generate.
+func passthroughTransform(_ context.Context, m *mf.Manifest, _ v1alpha1.TektonComponent) (*mf.Manifest, error) {
+	return m, nil
+}
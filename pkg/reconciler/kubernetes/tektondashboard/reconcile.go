@@ -76,9 +76,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, td *v1alpha1.TektonDashb
 		)
 		logger.Errorw("Invalid resource name", "expectedName", v1alpha1.DashboardResourceName, "actualName", td.GetName())
 		td.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, td, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, td, &td.Status); err != nil {
+		return err
+	}
+
 	// find the valid tekton-pipeline installation
 	logger.Debug("Checking Tekton Pipeline dependency")
 	if _, err := common.PipelineReady(r.pipelineInformer); err != nil {
@@ -143,6 +148,16 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, td *v1alpha1.TektonDashb
 	}
 	logger.Info("Main manifest applied successfully")
 
+	if err := r.reconcileIngress(ctx, td); err != nil {
+		if err == v1alpha1.REQUEUE_EVENT_AFTER {
+			return err
+		}
+		msg := fmt.Sprintf("Ingress reconciliation failed: %s", err.Error())
+		logger.Errorw("Ingress reconciliation failed", "error", err)
+		td.Status.MarkInstallerSetNotReady(msg)
+		return nil
+	}
+
 	logger.Debug("Executing post-reconciliation")
 	if err := r.extension.PostReconcile(ctx, td); err != nil {
 		msg := fmt.Sprintf("PostReconciliation failed: %s", err.Error())
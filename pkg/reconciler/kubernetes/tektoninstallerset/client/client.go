@@ -34,6 +34,9 @@ const (
 	InstallerTypePre    = "pre"
 	InstallerTypePost   = "post"
 	InstallerTypeCustom = "custom"
+	// InstallerTypeSnapshot sets keep the main sets of the previous release
+	// during an upgrade, they are never applied
+	InstallerTypeSnapshot = "snapshot"
 )
 
 var (
@@ -24,6 +24,7 @@ import (
 	v1alpha12 "github.com/tektoncd/operator/pkg/client/clientset/versioned/typed/operator/v1alpha1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
@@ -84,8 +85,15 @@ func (f fakeClient) Get(ctx context.Context, name string, opts metav1.GetOptions
 }
 
 func (f fakeClient) List(ctx context.Context, opts metav1.ListOptions) (*v1alpha1.TektonInstallerSetList, error) {
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
 	list := []v1alpha1.TektonInstallerSet{}
 	for i := range f.resource {
+		if !selector.Matches(labels.Set(f.resource[i].GetLabels())) {
+			continue
+		}
 		list = append(list, *f.resource[i])
 	}
 	return &v1alpha1.TektonInstallerSetList{Items: list}, nil
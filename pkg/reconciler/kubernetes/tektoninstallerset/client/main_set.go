@@ -23,7 +23,9 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/logging"
 )
@@ -62,16 +64,27 @@ func (i *InstallerSetClient) MainSet(ctx context.Context, comp v1alpha1.TektonCo
 		}
 		if comp.GetStatus().GetCondition(v1alpha1.InstallerSetAvailable).IsUnknown() {
 			i.metrics.LogMetrics(metricsNew, i.componentVersion, logger)
+			common.EmitEvent(ctx, comp, corev1.EventTypeNormal, common.ReasonInstallStarted,
+				"Installing %s %s", i.resourceKind, i.componentVersion)
 		}
 
 	case ErrInvalidState, ErrNsDifferent, ErrVersionDifferent:
 		logger.Debugf("%v/%v: installer set not in valid state : %v, cleaning up!", i.resourceKind, setType, err)
+		if err == ErrVersionDifferent {
+			// keep the sets of the previous release, to roll back if the upgrade fails
+			if err := i.snapshotMainSets(ctx, sets); err != nil {
+				logger.Errorf("%v/%v: failed to snapshot main installer set: %v", i.resourceKind, setType, err)
+				return err
+			}
+		}
 		if err := i.CleanupMainSet(ctx); err != nil {
 			logger.Errorf("%v/%v: failed to cleanup main installer set: %v", i.resourceKind, setType, err)
 			return err
 		}
 		if err == ErrVersionDifferent {
 			i.metrics.LogMetrics(metricsUpgrade, i.componentVersion, logger)
+			common.EmitEvent(ctx, comp, corev1.EventTypeNormal, common.ReasonUpgradeStarted,
+				"Upgrading %s from release %s to %s", i.resourceKind, releaseVersionOf(sets), i.releaseVersion)
 			markComponentStatus(comp, v1alpha1.UpgradePending)
 		} else {
 			markComponentStatus(comp, v1alpha1.Reinstalling)
@@ -99,11 +112,52 @@ func (i *InstallerSetClient) MainSet(ctx context.Context, comp v1alpha1.TektonCo
 	}
 
 	//Mark InstallerSet Ready
+	wasReady := comp.GetStatus().GetCondition(v1alpha1.InstallerSetReady).IsTrue()
 	comp.GetStatus().MarkInstallerSetReady()
 
+	if !wasReady {
+		if err := i.emitCompleted(ctx, comp); err != nil {
+			logger.Errorf("%v/%v: failed to list snapshot installer set: %v", i.resourceKind, setType, err)
+			return err
+		}
+	}
+
+	// the upgrade succeeded, the sets of the previous release are not needed anymore
+	if err := i.CleanupSnapshotSets(ctx); err != nil {
+		logger.Errorf("%v/%v: failed to cleanup snapshot installer set: %v", i.resourceKind, setType, err)
+		return err
+	}
+
 	return nil
 }
 
+// emitCompleted emits the event of a completed install, or of a completed
+// upgrade when the sets of the previous release were kept
+func (i *InstallerSetClient) emitCompleted(ctx context.Context, comp v1alpha1.TektonComponent) error {
+	snapshots, err := i.ListSnapshotSets(ctx)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) != 0 {
+		common.EmitEvent(ctx, comp, corev1.EventTypeNormal, common.ReasonUpgradeCompleted,
+			"Upgraded %s from release %s to %s", i.resourceKind, releaseVersionOf(snapshots), i.releaseVersion)
+		return nil
+	}
+	common.EmitEvent(ctx, comp, corev1.EventTypeNormal, common.ReasonInstallCompleted,
+		"Installed %s %s", i.resourceKind, i.componentVersion)
+	return nil
+}
+
+// releaseVersionOf returns the operator release of installer sets
+func releaseVersionOf(sets []v1alpha1.TektonInstallerSet) string {
+	for _, set := range sets {
+		if version := set.GetLabels()[v1alpha1.ReleaseVersionKey]; version != "" {
+			return version
+		}
+	}
+	return common.ReleaseVersionUnknown
+}
+
 func (i *InstallerSetClient) statusCheck(logger *zap.SugaredLogger, setType string, sets []v1alpha1.TektonInstallerSet) error {
 	for _, set := range sets {
 		ready := set.Status.GetCondition(apis.ConditionReady)
@@ -25,6 +25,9 @@ import (
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
 	testing2 "knative.dev/pkg/reconciler/testing"
 )
 
@@ -41,6 +44,10 @@ var (
 
 func TestInstallerSetClient_MainSet_NewInstallation(t *testing.T) {
 	ctx, _ := testing2.SetupFakeContext(t)
+	recorder := record.NewFakeRecorder(10)
+	ctx = controller.WithEventRecorder(ctx, recorder)
+	trigger := comp.DeepCopy()
+	trigger.UID = types.UID("new-installation")
 	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount, deployment}))
 	assert.NilError(t, err)
 
@@ -51,8 +58,9 @@ func TestInstallerSetClient_MainSet_NewInstallation(t *testing.T) {
 	fakeClient := fake2.NewFakeISClient()
 	client := NewInstallerSetClient(fakeClient, "releaseVersion", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
 
-	err = client.MainSet(ctx, comp, &manifest, filterAndTransform(nil))
+	err = client.MainSet(ctx, trigger, &manifest, filterAndTransform(nil))
 	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Equal(t, <-recorder.Events, "Normal InstallStarted Installing TektonTrigger test-version")
 
 	// set installer sets as false
 	createdSets, err := fakeClient.List(ctx, metav1.ListOptions{})
@@ -65,7 +73,7 @@ func TestInstallerSetClient_MainSet_NewInstallation(t *testing.T) {
 		assert.NilError(t, err)
 	}
 
-	err = client.MainSet(ctx, comp, &manifest, filterAndTransform(nil))
+	err = client.MainSet(ctx, trigger, &manifest, filterAndTransform(nil))
 	assert.Assert(t, err != nil)
 
 	// set installer sets as false
@@ -78,8 +86,14 @@ func TestInstallerSetClient_MainSet_NewInstallation(t *testing.T) {
 		assert.NilError(t, err)
 	}
 
-	err = client.MainSet(ctx, comp, &manifest, filterAndTransform(nil))
+	err = client.MainSet(ctx, trigger, &manifest, filterAndTransform(nil))
 	assert.NilError(t, err)
+	assert.Equal(t, <-recorder.Events, "Normal InstallCompleted Installed TektonTrigger test-version")
+
+	// the install is reported once
+	err = client.MainSet(ctx, trigger, &manifest, filterAndTransform(nil))
+	assert.NilError(t, err)
+	assert.Equal(t, len(recorder.Events), 0)
 }
 
 func markStatusReady(is *v1alpha1.TektonInstallerSet) {
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+)
+
+// IsSnapshot returns true if the installer set only keeps the manifests of a
+// previous release, its resources must not be applied nor deleted
+func IsSnapshot(set *v1alpha1.TektonInstallerSet) bool {
+	return set.GetLabels()[v1alpha1.InstallerSetType] == InstallerTypeSnapshot
+}
+
+// snapshotMainSets keeps a copy of the main sets which are replaced by the
+// ones of a new release, so that they can be restored if the upgrade fails.
+// Only ready sets are kept, and an existing snapshot is preserved as it is the
+// last known good installation when the replaced sets never became ready
+func (i *InstallerSetClient) snapshotMainSets(ctx context.Context, sets []v1alpha1.TektonInstallerSet) error {
+	logger := logging.FromContext(ctx).With("kind", i.resourceKind, "type", InstallerTypeSnapshot)
+
+	snapshots, err := i.ListSnapshotSets(ctx)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) != 0 {
+		logger.Debugf("keeping the existing snapshot of %d installer sets", len(snapshots))
+		return nil
+	}
+
+	for _, set := range sets {
+		if !set.Status.GetCondition(apis.ConditionReady).IsTrue() {
+			logger.Debugf("installer set %s is not ready, not taking a snapshot", set.GetName())
+			return nil
+		}
+	}
+
+	kind := strings.ToLower(strings.TrimPrefix(i.resourceKind, "Tekton"))
+	for _, set := range sets {
+		snapshot := &v1alpha1.TektonInstallerSet{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName:    fmt.Sprintf("%s-%s-%s-", kind, InstallerTypeSnapshot, subTypeOf(set.GetName())),
+				Labels:          map[string]string{},
+				Annotations:     map[string]string{},
+				OwnerReferences: set.GetOwnerReferences(),
+			},
+			Spec: *set.Spec.DeepCopy(),
+		}
+		for key, value := range set.GetLabels() {
+			snapshot.Labels[key] = value
+		}
+		for key, value := range set.GetAnnotations() {
+			snapshot.Annotations[key] = value
+		}
+		snapshot.Labels[v1alpha1.InstallerSetType] = InstallerTypeSnapshot
+		snapshot.Annotations[v1alpha1.SnapshotOfKey] = set.GetName()
+
+		if _, err := i.clientSet.Create(ctx, snapshot, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create snapshot of installer set %s: %v", set.GetName(), err)
+		}
+		logger.Debugf("created snapshot of installer set %s", set.GetName())
+	}
+	return nil
+}
+
+// ListSnapshotSets returns the snapshot of the main sets of the previous release
+func (i *InstallerSetClient) ListSnapshotSets(ctx context.Context) ([]v1alpha1.TektonInstallerSet, error) {
+	list, err := i.clientSet.List(ctx, metav1.ListOptions{LabelSelector: i.getSetLabels(InstallerTypeSnapshot)})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// CleanupSnapshotSets deletes the snapshot, once the upgraded sets are ready
+// or have been rolled back
+func (i *InstallerSetClient) CleanupSnapshotSets(ctx context.Context) error {
+	return i.cleanup(ctx, InstallerTypeSnapshot)
+}
+
+// RestoreSnapshot replaces the main sets with the ones kept by the snapshot.
+// The main sets are deleted first and an event requeuing the caller is
+// returned until they are gone, so that their finalizer doesn't delete the
+// restored resources. The restored sets are owned by owner, so that they are
+// still applied while the component is paused after the rollback
+func (i *InstallerSetClient) RestoreSnapshot(ctx context.Context, owner metav1.OwnerReference) error {
+	logger := logging.FromContext(ctx).With("kind", i.resourceKind, "type", InstallerTypeSnapshot)
+
+	snapshots, err := i.ListSnapshotSets(ctx)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return ErrNotFound
+	}
+
+	list, err := i.clientSet.List(ctx, metav1.ListOptions{LabelSelector: i.getSetLabels(InstallerTypeMain)})
+	if err != nil {
+		return err
+	}
+	if len(list.Items) != 0 {
+		logger.Debugf("deleting %d main installer sets before restoring the snapshot", len(list.Items))
+		if err := i.CleanupMainSet(ctx); err != nil {
+			return err
+		}
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
+	kind := strings.ToLower(strings.TrimPrefix(i.resourceKind, "Tekton"))
+	for _, snapshot := range snapshots {
+		snapshotOf := snapshot.GetAnnotations()[v1alpha1.SnapshotOfKey]
+		set := &v1alpha1.TektonInstallerSet{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName:    fmt.Sprintf("%s-%s-%s-", kind, InstallerTypeMain, subTypeOf(snapshotOf)),
+				Labels:          map[string]string{},
+				Annotations:     map[string]string{},
+				OwnerReferences: []metav1.OwnerReference{owner},
+			},
+			Spec: *snapshot.Spec.DeepCopy(),
+		}
+		for key, value := range snapshot.GetLabels() {
+			set.Labels[key] = value
+		}
+		for key, value := range snapshot.GetAnnotations() {
+			set.Annotations[key] = value
+		}
+		set.Labels[v1alpha1.InstallerSetType] = InstallerTypeMain
+		delete(set.Annotations, v1alpha1.SnapshotOfKey)
+
+		if _, err := i.clientSet.Create(ctx, set, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to restore installer set %s: %v", snapshotOf, err)
+		}
+		logger.Infow("restored installer set", "snapshotOf", snapshotOf, "releaseVersion", snapshot.GetLabels()[v1alpha1.ReleaseVersionKey])
+	}
+	return i.CleanupSnapshotSets(ctx)
+}
+
+// subTypeOf returns the sub type of a main installer set from its name
+func subTypeOf(name string) string {
+	for _, subType := range []string{InstallerSubTypeDeployment, InstallerSubTypeStatefulset} {
+		if strings.Contains(name, subType) {
+			return subType
+		}
+	}
+	return InstallerSubTypeStatic
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientSet "github.com/tektoncd/operator/pkg/client/clientset/versioned/typed/operator/v1alpha1"
+	fake2 "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client/fake"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	testing2 "knative.dev/pkg/reconciler/testing"
+)
+
+func TestInstallerSetClient_MainSet_SnapshotOnUpgrade(t *testing.T) {
+	ctx, _ := testing2.SetupFakeContext(t)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount, deployment}))
+	assert.NilError(t, err)
+
+	fakeClient := fake2.NewFakeISClient(mainSets(t, "v0.1.0", true)...)
+
+	// upgrading keeps the ready sets of the previous release
+	newClient := NewInstallerSetClient(fakeClient, "v0.2.0", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	err = newClient.MainSet(ctx, comp, &manifest, filterAndTransform(nil))
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+
+	snapshots, err := newClient.ListSnapshotSets(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(snapshots), 2)
+	for _, s := range snapshots {
+		assert.Equal(t, s.GetLabels()[v1alpha1.ReleaseVersionKey], "v0.1.0")
+		assert.Assert(t, IsSnapshot(&s))
+	}
+	assert.Equal(t, snapshotOf(snapshots), "trigger-main-deployment-v0.1.0-test,trigger-main-static-v0.1.0-test")
+
+	// the new sets are created, the snapshot is kept until they are ready
+	err = newClient.MainSet(ctx, comp, &manifest, filterAndTransform(nil))
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	snapshots, err = newClient.ListSnapshotSets(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(snapshots), 2)
+
+	markAllReady(t, fakeClient)
+	assert.NilError(t, newClient.MainSet(ctx, comp, &manifest, filterAndTransform(nil)))
+	snapshots, err = newClient.ListSnapshotSets(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(snapshots), 0)
+}
+
+func TestInstallerSetClient_RestoreSnapshot(t *testing.T) {
+	ctx, _ := testing2.SetupFakeContext(t)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount, deployment}))
+	assert.NilError(t, err)
+
+	fakeClient := fake2.NewFakeISClient(mainSets(t, "v0.1.0", true)...)
+	newClient := NewInstallerSetClient(fakeClient, "v0.2.0", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	err = newClient.MainSet(ctx, comp, &manifest, filterAndTransform(nil))
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	// the upgraded sets which never became ready
+	for _, s := range mainSets(t, "v0.2.0", false) {
+		_, err := fakeClient.Create(ctx, s, metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+
+	owner := metav1.OwnerReference{Kind: v1alpha1.KindTektonConfig, Name: v1alpha1.ConfigResourceName}
+
+	// the upgraded sets are deleted first
+	err = newClient.RestoreSnapshot(ctx, owner)
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	list, err := fakeClient.List(ctx, metav1.ListOptions{LabelSelector: newClient.getSetLabels(InstallerTypeMain)})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 0)
+
+	assert.NilError(t, newClient.RestoreSnapshot(ctx, owner))
+	list, err = fakeClient.List(ctx, metav1.ListOptions{LabelSelector: newClient.getSetLabels(InstallerTypeMain)})
+	assert.NilError(t, err)
+	assert.NilError(t, verifyMainInstallerSets(list.Items))
+	for _, s := range list.Items {
+		assert.Equal(t, s.GetLabels()[v1alpha1.ReleaseVersionKey], "v0.1.0")
+		assert.DeepEqual(t, s.GetOwnerReferences(), []metav1.OwnerReference{owner})
+		_, ok := s.GetAnnotations()[v1alpha1.SnapshotOfKey]
+		assert.Assert(t, !ok)
+	}
+
+	snapshots, err := newClient.ListSnapshotSets(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(snapshots), 0)
+	assert.Equal(t, newClient.RestoreSnapshot(ctx, owner), ErrNotFound)
+}
+
+func TestInstallerSetClient_MainSet_NoSnapshotOfSetsNotReady(t *testing.T) {
+	ctx, _ := testing2.SetupFakeContext(t)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount, deployment}))
+	assert.NilError(t, err)
+
+	fakeClient := fake2.NewFakeISClient(mainSets(t, "v0.1.0", false)...)
+	newClient := NewInstallerSetClient(fakeClient, "v0.2.0", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	err = newClient.MainSet(ctx, comp, &manifest, filterAndTransform(nil))
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+
+	snapshots, err := newClient.ListSnapshotSets(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(snapshots), 0)
+}
+
+// mainSets returns the static and deployment main sets of a release
+func mainSets(t *testing.T, releaseVersion string, ready bool) []*v1alpha1.TektonInstallerSet {
+	t.Helper()
+	c := NewInstallerSetClient(nil, releaseVersion, "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount}))
+	assert.NilError(t, err)
+
+	sets := []*v1alpha1.TektonInstallerSet{}
+	for _, subType := range []string{InstallerSubTypeStatic, InstallerSubTypeDeployment} {
+		name := fmt.Sprintf("trigger-main-%s-%s-", subType, releaseVersion)
+		is, err := c.makeInstallerSet(context.Background(), comp, &manifest, name, InstallerTypeMain, nil)
+		assert.NilError(t, err)
+		is.SetName(name + "test")
+		if ready {
+			markStatusReady(is)
+		}
+		sets = append(sets, is)
+	}
+	return sets
+}
+
+func markAllReady(t *testing.T, c clientSet.TektonInstallerSetInterface) {
+	t.Helper()
+	ctx := context.Background()
+	sets, err := c.List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	for _, s := range sets.Items {
+		is := s
+		markStatusReady(&is)
+		_, err := c.Update(ctx, &is, metav1.UpdateOptions{})
+		assert.NilError(t, err)
+	}
+}
+
+func snapshotOf(sets []v1alpha1.TektonInstallerSet) string {
+	names := []string{}
+	for _, s := range sets {
+		names = append(names, s.GetAnnotations()[v1alpha1.SnapshotOfKey])
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
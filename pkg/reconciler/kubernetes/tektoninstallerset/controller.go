@@ -27,6 +27,7 @@ import (
 	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
 	tektonInstallerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektoninstallerset"
 	tektonInstallerReconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektoninstallerset"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
 	statefulsetinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/statefulset"
@@ -61,6 +62,7 @@ func NewExtendedController() injection.ControllerConstructor {
 			kubeClientSet:     kubeclient.Get(ctx),
 		}
 		impl := tektonInstallerReconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonInstallerSet, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for TektonInstallerSet")
 
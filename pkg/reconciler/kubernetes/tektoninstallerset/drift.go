@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// maxDriftFields bounds the number of fields reported for a drifted resource
+const maxDriftFields = 20
+
+// driftFields returns the fields compared to detect the drift of a resource,
+// the drift is not detected for the kinds without fields
+func driftFields(kind string) []string {
+	switch kind {
+	case "Deployment", "StatefulSet":
+		return []string{annotationsPath, labelsPath, "spec"}
+	case "ConfigMap":
+		return []string{annotationsPath, labelsPath, "data", "binaryData"}
+	default:
+		return nil
+	}
+}
+
+// driftedFields lists the paths of the fields of existing which differ from
+// expected. Only the fields set in expected are compared, the fields added by
+// the api server defaulting or by other controllers are not reported
+func driftedFields(expected, existing *unstructured.Unstructured) []string {
+	fields := []string{}
+	for _, fieldKey := range driftFields(expected.GetKind()) {
+		nestedKeys := strings.Split(fieldKey, ".")
+		expectedValue, found, _ := unstructured.NestedFieldNoCopy(expected.Object, nestedKeys...)
+		if !found {
+			continue
+		}
+		existingValue, found, _ := unstructured.NestedFieldNoCopy(existing.Object, nestedKeys...)
+		if !found {
+			if !isEmptyValue(expectedValue) {
+				fields = append(fields, fieldKey)
+			}
+			continue
+		}
+		fields = compareFields(fieldKey, expectedValue, existingValue, fields)
+	}
+	if len(fields) > maxDriftFields {
+		fields = append(fields[:maxDriftFields], fmt.Sprintf("... %d more", len(fields)-maxDriftFields))
+	}
+	return fields
+}
+
+// compareFields appends to fields the paths under path where existing
+// differs from expected
+func compareFields(path string, expected, existing interface{}, fields []string) []string {
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		existingValue, ok := existing.(map[string]interface{})
+		if !ok {
+			return append(fields, path)
+		}
+		keys := make([]string, 0, len(expectedValue))
+		for key := range expectedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value, found := existingValue[key]
+			if !found {
+				if !isEmptyValue(expectedValue[key]) {
+					fields = append(fields, path+"."+key)
+				}
+				continue
+			}
+			fields = compareFields(path+"."+key, expectedValue[key], value, fields)
+		}
+		return fields
+
+	case []interface{}:
+		existingValue, ok := existing.([]interface{})
+		if !ok || len(existingValue) != len(expectedValue) {
+			return append(fields, path)
+		}
+		for index := range expectedValue {
+			fields = compareFields(fmt.Sprintf("%s[%d]", path, index), expectedValue[index], existingValue[index], fields)
+		}
+		return fields
+
+	default:
+		if !isEqualValue(expected, existing) {
+			return append(fields, path)
+		}
+		return fields
+	}
+}
+
+// isEqualValue compares two scalar values, numbers and quantities are
+// compared by value as the api server may store them in another format
+func isEqualValue(expected, existing interface{}) bool {
+	if expectedNumber, ok := toFloat(expected); ok {
+		existingNumber, ok := toFloat(existing)
+		return ok && expectedNumber == existingNumber
+	}
+	if expectedString, ok := expected.(string); ok {
+		existingString, ok := existing.(string)
+		if !ok {
+			return false
+		}
+		if expectedString == existingString {
+			return true
+		}
+		expectedQuantity, err := resource.ParseQuantity(expectedString)
+		if err != nil {
+			return false
+		}
+		existingQuantity, err := resource.ParseQuantity(existingString)
+		return err == nil && expectedQuantity.Cmp(existingQuantity) == 0
+	}
+	return equality.Semantic.DeepEqual(expected, existing)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), true
+	case int32:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case float64:
+		return number, true
+	default:
+		return 0, false
+	}
+}
+
+// isEmptyValue returns true for the values which the api server drops
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	default:
+		number, ok := toFloat(value)
+		return ok && number == 0
+	}
+}
+
+// newResourceDrift describes the drift of a resource
+func newResourceDrift(u *unstructured.Unstructured, fields []string, reverted bool) v1alpha1.ResourceDrift {
+	return v1alpha1.ResourceDrift{
+		APIVersion: u.GetAPIVersion(),
+		Kind:       u.GetKind(),
+		Namespace:  u.GetNamespace(),
+		Name:       u.GetName(),
+		Fields:     fields,
+		Reverted:   reverted,
+		DetectedAt: metav1.Now(),
+	}
+}
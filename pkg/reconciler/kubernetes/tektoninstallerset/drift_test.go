@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/test/diff"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDriftedFields(t *testing.T) {
+	deployment := func(spec map[string]interface{}, labels map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "tekton-pipelines-controller",
+				"namespace": "tekton-pipelines",
+				"labels":    labels,
+			},
+			"spec": spec,
+		}}
+		return u
+	}
+	container := func(image string, extra map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{"name": "controller", "image": image}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return c
+	}
+	podSpec := func(replicas interface{}, containers ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": containers,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		expected *unstructured.Unstructured
+		existing *unstructured.Unstructured
+		want     []string
+	}{
+		{
+			name:     "no drift",
+			expected: deployment(podSpec(int64(1), container("image:v1", nil)), map[string]interface{}{"app": "controller"}),
+			existing: deployment(podSpec(int64(1), container("image:v1", nil)), map[string]interface{}{"app": "controller"}),
+			want:     []string{},
+		},
+		{
+			name:     "fields added by the api server are ignored",
+			expected: deployment(podSpec(int64(1), container("image:v1", map[string]interface{}{"env": []interface{}{}})), nil),
+			existing: deployment(podSpec(float64(1), container("image:v1", map[string]interface{}{"imagePullPolicy": "IfNotPresent"})), map[string]interface{}{"extra": "label"}),
+			want:     []string{},
+		},
+		{
+			name: "quantities compared by value",
+			expected: deployment(podSpec(int64(1), container("image:v1", map[string]interface{}{
+				"resources": map[string]interface{}{"requests": map[string]interface{}{"cpu": "1000m"}},
+			})), nil),
+			existing: deployment(podSpec(int64(1), container("image:v1", map[string]interface{}{
+				"resources": map[string]interface{}{"requests": map[string]interface{}{"cpu": "1"}},
+			})), nil),
+			want: []string{},
+		},
+		{
+			name:     "changed fields",
+			expected: deployment(podSpec(int64(1), container("image:v1", nil)), map[string]interface{}{"app": "controller"}),
+			existing: deployment(podSpec(int64(3), container("image:v2", nil)), map[string]interface{}{"app": "other"}),
+			want: []string{
+				"metadata.labels.app",
+				"spec.replicas",
+				"spec.template.spec.containers[0].image",
+			},
+		},
+		{
+			name:     "removed fields",
+			expected: deployment(podSpec(int64(1), container("image:v1", nil), container("image:v1", nil)), map[string]interface{}{"app": "controller"}),
+			existing: deployment(map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{container("image:v1", nil)}}}}, nil),
+			want: []string{
+				"metadata.labels.app",
+				"spec.replicas",
+				"spec.template.spec.containers",
+			},
+		},
+		{
+			name: "configmap data",
+			expected: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap",
+				"metadata": map[string]interface{}{"name": "feature-flags"},
+				"data":     map[string]interface{}{"enable-api-fields": "beta", "enable-step-actions": "true"},
+			}},
+			existing: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap",
+				"metadata": map[string]interface{}{"name": "feature-flags"},
+				"data":     map[string]interface{}{"enable-api-fields": "alpha", "enable-step-actions": "true", "extra": "value"},
+			}},
+			want: []string{"data.enable-api-fields"},
+		},
+		{
+			name: "drift of other kinds is not detected",
+			expected: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1", "kind": "ServiceAccount",
+				"metadata": map[string]interface{}{"name": "controller", "labels": map[string]interface{}{"app": "controller"}},
+			}},
+			existing: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1", "kind": "ServiceAccount",
+				"metadata": map[string]interface{}{"name": "controller"},
+			}},
+			want: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := driftedFields(test.expected, test.existing)
+			if d := cmp.Diff(test.want, got); d != "" {
+				t.Errorf("driftedFields() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
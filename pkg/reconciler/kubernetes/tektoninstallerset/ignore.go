@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ignoredFields returns the JSON pointers of the fields of a resource which are
+// not reconciled, set by the options transformer from the ignoreDifferences
+func ignoredFields(u *unstructured.Unstructured) []string {
+	value := u.GetAnnotations()[v1alpha1.IgnoreDifferencesKey]
+	if value == "" {
+		return nil
+	}
+	pointers := []string{}
+	if err := json.Unmarshal([]byte(value), &pointers); err != nil {
+		return nil
+	}
+	return pointers
+}
+
+// preserveIgnoredFields copies the ignored fields of existing into expected,
+// so that these fields are neither compared nor updated
+func preserveIgnoredFields(expected, existing *unstructured.Unstructured) {
+	for _, pointer := range ignoredFields(expected) {
+		tokens, ok := parseJSONPointer(pointer)
+		if !ok {
+			continue
+		}
+		if value, found := getJSONPointer(existing.Object, tokens); found {
+			setJSONPointer(expected.Object, tokens, runtime.DeepCopyJSONValue(value))
+		} else {
+			removeJSONPointer(expected.Object, tokens)
+		}
+	}
+}
+
+// parseJSONPointer splits a RFC 6901 JSON pointer into its unescaped tokens
+func parseJSONPointer(pointer string) ([]string, bool) {
+	if !strings.HasPrefix(pointer, "/") || pointer == "/" {
+		return nil, false
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, true
+}
+
+func getJSONPointer(obj interface{}, tokens []string) (interface{}, bool) {
+	current := obj
+	for _, token := range tokens {
+		switch value := current.(type) {
+		case map[string]interface{}:
+			next, found := value[token]
+			if !found {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(value) {
+				return nil, false
+			}
+			current = value[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// setJSONPointer sets the value at the pointer, the missing objects on the way
+// are created but lists are never extended
+func setJSONPointer(obj map[string]interface{}, tokens []string, fieldValue interface{}) {
+	var current interface{} = obj
+	for i, token := range tokens {
+		last := i == len(tokens)-1
+		switch value := current.(type) {
+		case map[string]interface{}:
+			if last {
+				value[token] = fieldValue
+				return
+			}
+			next, found := value[token]
+			if !found || next == nil {
+				next = map[string]interface{}{}
+				value[token] = next
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(value) {
+				return
+			}
+			if last {
+				value[index] = fieldValue
+				return
+			}
+			current = value[index]
+		default:
+			return
+		}
+	}
+}
+
+// removeJSONPointer removes the field at the pointer, list items are not removed
+func removeJSONPointer(obj map[string]interface{}, tokens []string) {
+	parent, found := getJSONPointer(obj, tokens[:len(tokens)-1])
+	if !found {
+		return
+	}
+	if value, ok := parent.(map[string]interface{}); ok {
+		delete(value, tokens[len(tokens)-1])
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pipeline/test/diff"
+	"go.uber.org/zap"
+	zapobserver "go.uber.org/zap/zaptest/observer"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPreserveIgnoredFields(t *testing.T) {
+	newObject := func(replicas int64, annotations map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name": "tekton-pipelines-controller",
+				"annotations": map[string]interface{}{
+					v1alpha1.IgnoreDifferencesKey: `["/spec/replicas","/spec/template/metadata/annotations/sidecar.istio.io~1status","/spec/template/spec/containers/0/resources","/spec/template/spec/containers/5/resources","/spec/missing/field"]`,
+				},
+			},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{"annotations": annotations},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "controller", "image": "controller:v1"},
+						},
+					},
+				},
+			},
+		}}
+	}
+
+	expected := newObject(1, map[string]interface{}{"keep": "me"})
+	existing := newObject(4, map[string]interface{}{"keep": "me", "sidecar.istio.io/status": "injected"})
+	containers, _, err := unstructured.NestedSlice(existing.Object, "spec", "template", "spec", "containers")
+	assert.NilError(t, err)
+	containers[0].(map[string]interface{})["resources"] = map[string]interface{}{"requests": map[string]interface{}{"cpu": "200m"}}
+	assert.NilError(t, unstructured.SetNestedSlice(existing.Object, containers, "spec", "template", "spec", "containers"))
+
+	preserveIgnoredFields(expected, existing)
+
+	if d := cmp.Diff(existing.Object["spec"], expected.Object["spec"]); d != "" {
+		t.Errorf("expected the ignored fields to be copied %s", diff.PrintWantGot(d))
+	}
+
+	// the ignored fields missing on the cluster are removed
+	expected = newObject(1, map[string]interface{}{"keep": "me", "sidecar.istio.io/status": "stale"})
+	existing = newObject(1, map[string]interface{}{"keep": "me"})
+	unstructured.RemoveNestedField(existing.Object, "spec", "replicas")
+	preserveIgnoredFields(expected, existing)
+	if d := cmp.Diff(existing.Object["spec"], expected.Object["spec"]); d != "" {
+		t.Errorf("expected the ignored fields to be removed %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestEnsureResourceIgnoreDifferences(t *testing.T) {
+	ctx := context.TODO()
+	k8sClient := k8sfake.NewSimpleClientset()
+	observer, _ := zapobserver.New(zap.InfoLevel)
+	logger := zap.New(observer).Sugar()
+
+	dep1 := getDeployment("foo-1", "bar", 1)
+	dep1.APIVersion = "apps/v1"
+	dep1.Annotations[v1alpha1.IgnoreDifferencesKey] = `["/spec/replicas"]`
+	mfClient := fake.New(dep1)
+	i := installer{
+		mfClient:      mfClient,
+		kubeClientSet: k8sClient,
+		logger:        logger,
+		detectDrift:   true,
+	}
+
+	_dep1Object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dep1)
+	assert.NilError(t, err)
+	expected := &unstructured.Unstructured{Object: _dep1Object}
+
+	// an external autoscaler scales the deployment
+	existing, err := mfClient.Get(expected)
+	assert.NilError(t, err)
+	assert.NilError(t, unstructured.SetNestedField(existing.Object, int64(5), "spec", "replicas"))
+	assert.NilError(t, mfClient.Update(existing))
+
+	err = i.ensureResource(ctx, expected.DeepCopy())
+	assert.NilError(t, err)
+
+	// replicas are kept and not reported as drift
+	existing, err = mfClient.Get(expected)
+	assert.NilError(t, err)
+	replicas, _, err := unstructured.NestedInt64(existing.Object, "spec", "replicas")
+	assert.NilError(t, err)
+	assert.Equal(t, replicas, int64(5))
+	assert.Equal(t, len(i.DetectedDrift()), 0)
+}
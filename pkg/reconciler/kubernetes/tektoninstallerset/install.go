@@ -58,6 +58,10 @@ type installer struct {
 	deployment      []unstructured.Unstructured
 	statefulset     []unstructured.Unstructured
 	job             []unstructured.Unstructured
+	// detectDrift enables the detection of the changes made to the resources
+	// outside of the operator, the detected drifts are collected in drift
+	detectDrift bool
+	drift       []v1alpha1.ResourceDrift
 }
 
 func NewInstaller(manifest *mf.Manifest, mfClient mf.Client, kubeClientSet kubernetes.Interface, logger *zap.SugaredLogger) *installer {
@@ -240,6 +244,16 @@ func (i *installer) ensureResources(resources []unstructured.Unstructured, insta
 		hashOnResource := res.GetAnnotations()[v1alpha1.LastAppliedHashKey]
 
 		if expectedHash == hashOnResource {
+			// the resource is not updated when the manifest is unchanged,
+			// changes made outside of the operator are only reported
+			if i.detectDrift {
+				desired := r.DeepCopy()
+				preserveIgnoredFields(desired, res)
+				if fields := driftedFields(desired, res); len(fields) > 0 {
+					ressourceLogger.Infow("drift detected, resource changed outside of the operator", "fields", fields)
+					i.drift = append(i.drift, newResourceDrift(res, fields, false))
+				}
+			}
 			ressourceLogger.Debug("resource is up-to-date, no changes needed")
 			continue
 		}
@@ -254,6 +268,8 @@ func (i *installer) ensureResources(resources []unstructured.Unstructured, insta
 		}
 		anno[v1alpha1.LastAppliedHashKey] = expectedHash
 		r.SetAnnotations(anno)
+		// keep the fields owned by other controllers
+		preserveIgnoredFields(&r, res)
 
 		installManifests, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{r}), mf.UseClient(i.mfClient))
 		if err != nil {
@@ -272,6 +288,11 @@ func (i *installer) ensureResources(resources []unstructured.Unstructured, insta
 	return nil
 }
 
+// DetectedDrift returns the drifts detected while ensuring the resources
+func (i *installer) DetectedDrift() []v1alpha1.ResourceDrift {
+	return i.drift
+}
+
 func (i *installer) EnsureCRDs(installerSetName string) error {
 	return i.ensureResources(i.crds, installerSetName)
 }
@@ -498,6 +519,9 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 		return v1alpha1.RECONCILE_AGAIN_ERR
 	}
 
+	// keep the fields owned by other controllers, they are neither compared nor updated
+	preserveIgnoredFields(expected, existing)
+
 	// get list of reconcile fields
 	reconcileFields := i.resourceReconcileFields(expected)
 
@@ -541,6 +565,12 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 			return v1alpha1.RECONCILE_AGAIN_ERR
 		}
 
+		// collect the drifted fields before they are reverted
+		var drifted []string
+		if i.detectDrift {
+			drifted = driftedFields(expected, existing)
+		}
+
 		err = i.copyResourceFields(expected, existing, reconcileFields...)
 		if err != nil {
 			loggerWithContext.Errorw("failed to copy resource fields", "error", err)
@@ -553,6 +583,11 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 			return v1alpha1.RECONCILE_AGAIN_ERR
 		}
 
+		if len(drifted) > 0 {
+			loggerWithContext.Infow("drift detected and reverted, resource changed outside of the operator", "fields", drifted)
+			i.drift = append(i.drift, newResourceDrift(existing, drifted, true))
+		}
+
 		loggerWithContext.Debug("resource updated successfully")
 		return nil
 	}
@@ -938,3 +938,98 @@ func getHPA(name, namespace string, desiredReplicas int32, targetKind, targetNam
 		},
 	}
 }
+
+func TestEnsureResourceDrift(t *testing.T) {
+	ctx := context.TODO()
+	k8sClient := k8sfake.NewSimpleClientset()
+	observer, _ := zapobserver.New(zap.InfoLevel)
+	logger := zap.New(observer).Sugar()
+
+	dep1 := getDeployment("foo-1", "bar", 1)
+	dep1.APIVersion = "apps/v1"
+	mfClient := fake.New(dep1)
+	i := installer{
+		mfClient:      mfClient,
+		kubeClientSet: k8sClient,
+		logger:        logger,
+		detectDrift:   true,
+	}
+
+	_dep1Object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dep1)
+	assert.NilError(t, err)
+	expected := &unstructured.Unstructured{Object: _dep1Object}
+
+	// no drift when the resource matches the manifest
+	err = i.ensureResource(ctx, expected.DeepCopy())
+	assert.NilError(t, err)
+	assert.Equal(t, len(i.DetectedDrift()), 0)
+
+	// user scales the deployment by hand
+	existing, err := mfClient.Get(expected)
+	assert.NilError(t, err)
+	err = unstructured.SetNestedField(existing.Object, int64(3), "spec", "replicas")
+	assert.NilError(t, err)
+	assert.NilError(t, mfClient.Update(existing))
+
+	err = i.ensureResource(ctx, expected.DeepCopy())
+	assert.NilError(t, err)
+	drift := i.DetectedDrift()
+	assert.Equal(t, len(drift), 1)
+	assert.Equal(t, drift[0].Kind, "Deployment")
+	assert.Equal(t, drift[0].Namespace, "bar")
+	assert.Equal(t, drift[0].Name, "foo-1")
+	assert.DeepEqual(t, drift[0].Fields, []string{"spec.replicas"})
+	assert.Equal(t, drift[0].Reverted, true)
+
+	// the change is reverted
+	existing, err = mfClient.Get(expected)
+	assert.NilError(t, err)
+	replicas, _, err := unstructured.NestedInt64(existing.Object, "spec", "replicas")
+	assert.NilError(t, err)
+	assert.Equal(t, replicas, int64(1))
+}
+
+func TestEnsureResources_ConfigMapDrift(t *testing.T) {
+	k8sClient := k8sfake.NewSimpleClientset()
+	fakeClient := fake.New()
+	observer, _ := zapobserver.New(zap.InfoLevel)
+	logger := zap.New(observer).Sugar()
+
+	configMap := namespacedResource("v1", "ConfigMap", "test", "feature-flags")
+	err := unstructured.SetNestedStringMap(configMap.Object, map[string]string{"enable-api-fields": "beta"}, "data")
+	assert.NilError(t, err)
+
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*configMap.DeepCopy()}))
+	assert.NilError(t, err)
+	i := NewInstaller(&manifest, fakeClient, k8sClient, logger)
+	i.detectDrift = true
+
+	// created from the manifest
+	assert.NilError(t, i.EnsureNamespaceScopedResources("test-installerset"))
+	assert.Equal(t, len(i.DetectedDrift()), 0)
+
+	// user edits the configmap by hand
+	existing, err := fakeClient.Get(&configMap)
+	assert.NilError(t, err)
+	err = unstructured.SetNestedField(existing.Object, "alpha", "data", "enable-api-fields")
+	assert.NilError(t, err)
+	assert.NilError(t, fakeClient.Update(existing))
+
+	// the drift is reported but the configmap is not updated as the manifest is unchanged
+	manifest, err = mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*configMap.DeepCopy()}))
+	assert.NilError(t, err)
+	i = NewInstaller(&manifest, fakeClient, k8sClient, logger)
+	i.detectDrift = true
+	assert.NilError(t, i.EnsureNamespaceScopedResources("test-installerset"))
+	drift := i.DetectedDrift()
+	assert.Equal(t, len(drift), 1)
+	assert.Equal(t, drift[0].Kind, "ConfigMap")
+	assert.DeepEqual(t, drift[0].Fields, []string{"data.enable-api-fields"})
+	assert.Equal(t, drift[0].Reverted, false)
+
+	existing, err = fakeClient.Get(&configMap)
+	assert.NilError(t, err)
+	value, _, err := unstructured.NestedString(existing.Object, "data", "enable-api-fields")
+	assert.NilError(t, err)
+	assert.Equal(t, value, "alpha")
+}
@@ -19,14 +19,19 @@ package tektoninstallerset
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	tektonInstallerreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektoninstallerset"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
@@ -46,6 +51,11 @@ var _ tektonInstallerreconciler.Finalizer = (*Reconciler)(nil)
 func (r *Reconciler) FinalizeKind(ctx context.Context, installerSet *v1alpha1.TektonInstallerSet) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
 
+	// snapshots don't own the resources of their manifests
+	if client.IsSnapshot(installerSet) {
+		return nil
+	}
+
 	deleteManifests, err := mf.ManifestFrom(installerSet.Spec.Manifests, mf.UseClient(r.mfClient))
 	if err != nil {
 		logger.Error("Error creating initial manifest: ", err)
@@ -77,6 +87,15 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 		"resourceVersion", installerSet.ResourceVersion,
 		"status", installerSet.Status.GetCondition(apis.ConditionReady))
 
+	// snapshots only keep the manifests of a previous release for a rollback
+	if client.IsSnapshot(installerSet) {
+		return nil
+	}
+
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, installerSet, &installerSet.Status); err != nil {
+		return err
+	}
+
 	installManifests, err := mf.ManifestFrom(installerSet.Spec.Manifests, mf.UseClient(r.mfClient))
 	if err != nil {
 		msg := fmt.Sprintf("Internal Error: failed to create manifest: %s", err.Error())
@@ -104,6 +123,13 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	}
 
 	installer := NewInstaller(&installManifests, r.mfClient, r.kubeClientSet, logger)
+	// changes to the resources are drifts only once the current spec has
+	// been reconciled, before that they are expected. The observed generation
+	// is bumped by the generated reconciler after each reconcile
+	installer.detectDrift = installerSet.Status.ObservedGeneration == installerSet.Generation
+	defer func() {
+		r.recordDrift(ctx, installerSet, installer.DetectedDrift())
+	}()
 
 	// Install CRDs
 	logger.Debug("Installing CRDs")
@@ -111,7 +137,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	if err != nil {
 		logger.Errorw("CRD installation failed", "error", err)
 		installerSet.Status.MarkCRDsInstallationFailed(err.Error())
-		return r.handleError(err, installerSet)
+		return r.handleError(ctx, err, installerSet)
 	}
 
 	// Update Status for CRD condition
@@ -124,7 +150,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	if err != nil {
 		logger.Errorw("Cluster-scoped resources installation failed", "error", err)
 		installerSet.Status.MarkClustersScopedInstallationFailed(err.Error())
-		return r.handleError(err, installerSet)
+		return r.handleError(ctx, err, installerSet)
 	}
 
 	// Update Status for ClustersScope Condition
@@ -137,7 +163,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	if err != nil {
 		logger.Errorw("Namespace-scoped resources installation failed", "error", err)
 		installerSet.Status.MarkNamespaceScopedInstallationFailed(err.Error())
-		return r.handleError(err, installerSet)
+		return r.handleError(ctx, err, installerSet)
 	}
 
 	// Update Status for NamespaceScope Condition
@@ -150,7 +176,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	if err != nil {
 		logger.Errorw("Job resources installation failed", "error", err)
 		installerSet.Status.MarkJobsInstallationFailed(err.Error())
-		return r.handleError(err, installerSet)
+		return r.handleError(ctx, err, installerSet)
 	}
 
 	// Update Status for Job Resources
@@ -163,7 +189,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	if err != nil {
 		logger.Errorw("Deployment resources installation failed", "error", err)
 		installerSet.Status.MarkDeploymentsAvailableFailed(err.Error())
-		return r.handleError(err, installerSet)
+		return r.handleError(ctx, err, installerSet)
 	}
 
 	// Update Status for Deployment Resources
@@ -181,11 +207,13 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 		// Knative's exponential backoff push retry intervals to 128s+, which causes
 		// profile transitions (e.g. lite → basic) to time out waiting for postgres.
 		// Real API errors on the ensureResource path are also safe to retry at 10s.
+		common.RecordInstallerSetApply(ctx, installerSet.GetLabels()[v1alpha1.CreatedByKey], err)
 		return v1alpha1.REQUEUE_EVENT_AFTER
 	}
 
 	// Update Status for StatefulSet Resources
 	installerSet.Status.MarkStatefulSetReady()
+	common.RecordInstallerSetApply(ctx, installerSet.GetLabels()[v1alpha1.CreatedByKey], nil)
 	logger.Debug("StatefulSet resources installed successfully")
 
 	// Check if webhook is ready
@@ -245,9 +273,36 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	return nil
 }
 
-func (r *Reconciler) handleError(err error, installerSet *v1alpha1.TektonInstallerSet) error {
+// recordDrift records the drifted resources in the status and emits an event
+// for each new drift
+func (r *Reconciler) recordDrift(ctx context.Context, installerSet *v1alpha1.TektonInstallerSet, drifts []v1alpha1.ResourceDrift) {
+	recorder := controller.GetEventRecorder(ctx)
+	for _, drift := range drifts {
+		if !installerSet.Status.RecordDrift(drift) {
+			continue
+		}
+		common.RecordDrift(ctx, installerSet.GetLabels()[v1alpha1.CreatedByKey], drift.Kind, drift.Reverted)
+		if recorder == nil {
+			continue
+		}
+		action := "reported"
+		if drift.Reverted {
+			action = "reverted"
+		}
+		name := drift.Name
+		if drift.Namespace != "" {
+			name = drift.Namespace + "/" + drift.Name
+		}
+		recorder.Eventf(installerSet, corev1.EventTypeWarning, "DriftDetected",
+			"%s %s changed outside of the operator (%s), fields: %s",
+			drift.Kind, name, action, strings.Join(drift.Fields, ", "))
+	}
+}
+
+func (r *Reconciler) handleError(ctx context.Context, err error, installerSet *v1alpha1.TektonInstallerSet) error {
 	if err == v1alpha1.RECONCILE_AGAIN_ERR {
-		return v1alpha1.REQUEUE_EVENT_AFTER
+		err = v1alpha1.REQUEUE_EVENT_AFTER
 	}
+	common.RecordInstallerSetApply(ctx, installerSet.GetLabels()[v1alpha1.CreatedByKey], err)
 	return err
 }
@@ -68,7 +68,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 		}
 
 		tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
-		metrics, _ := NewRecorder()
+		metrics, _ := common.NewRecorder(v1alpha1.KindTektonMulticlusterProxyAAE)
 		c := &Reconciler{
 			operatorClientSet:           operatorclient.Get(ctx),
 			kubeClientSet:               kubeclient.Get(ctx),
@@ -81,6 +81,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			operatorVersion:             operatorVer,
 		}
 		impl := proxyAAEreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonMulticlusterProxyAAE, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for TektonMulticlusterProxyAAE")
 
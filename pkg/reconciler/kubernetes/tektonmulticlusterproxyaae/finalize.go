@@ -22,6 +22,7 @@ import (
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	proxyAAEreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonmulticlusterproxyaae"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
@@ -31,6 +32,7 @@ var _ proxyAAEreconciler.Finalizer = (*Reconciler)(nil)
 // FinalizeKind removes all resources after deletion of a TektonMulticlusterProxyAAE CR.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonMulticlusterProxyAAE) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
+	common.ForgetEvents(original)
 
 	if err := r.manifest.Filter(mf.CRDs).Delete(); err != nil {
 		logger.Error("Failed to delete CRDs for TektonMulticlusterProxyAAE", "error", err)
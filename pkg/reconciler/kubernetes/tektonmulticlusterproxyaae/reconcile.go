@@ -63,9 +63,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, proxy *v1alpha1.TektonMu
 		)
 		logger.Error(msg)
 		proxy.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, proxy, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, proxy, &proxy.Status); err != nil {
+		return err
+	}
+
 	if err := common.ReconcileTargetNamespace(ctx, nil, nil, proxy, r.kubeClientSet); err != nil {
 		return err
 	}
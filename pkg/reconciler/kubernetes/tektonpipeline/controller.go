@@ -55,7 +55,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		manifest, pipelineVer := ctrl.InitController(ctx, common.PayloadOptions{})
 
-		metrics, _ := common.NoMetrics()
+		metrics, _ := common.NewRecorder(v1alpha1.KindTektonPipeline)
 
 		operatorVer, err := common.OperatorVersion(ctx)
 		if err != nil {
@@ -70,6 +70,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		c := &Reconciler{
 			kubeClientSet:      kubeclient.Get(ctx),
+			operatorClientSet:  operatorclient.Get(ctx),
 			extension:          generator(ctx),
 			manifest:           manifest,
 			pipelineVersion:    pipelineVer,
@@ -77,6 +78,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			platformParams:     params,
 		}
 		impl := tektonPipelineReconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonPipeline, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for TektonPipeline")
 
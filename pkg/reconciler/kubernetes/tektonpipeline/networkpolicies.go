@@ -174,22 +174,27 @@ func (r *Reconciler) reconcileNetworkPolicies(ctx context.Context, tp *v1alpha1.
 	if tp.Spec.NetworkPolicy.Disabled {
 		return r.installerSetClient.CleanupCustomSet(ctx, "pipeline-network-policies")
 	}
+	manifest, err := networkPolicies(tp, r.platformParams)
+	if err != nil {
+		return err
+	}
+	return r.installerSetClient.CustomSet(ctx, tp, "pipeline-network-policies", &manifest, passthroughTransform, nil)
+}
+
+// networkPolicies generates the NetworkPolicy manifest for the given TektonPipeline
+func networkPolicies(tp *v1alpha1.TektonPipeline, params networkpolicy.PlatformParams) (mf.Manifest, error) {
 	defaults := []networkingv1.NetworkPolicy{
 		proxyWebhookDefaultDenyPolicy(),
 		pipelineDefaultDenyPolicy(),
 	}
-	defaults = append(defaults, proxyWebhookDefaultPolicies(r.platformParams)...)
-	defaults = append(defaults, pipelineDefaultPolicies(r.platformParams)...)
+	defaults = append(defaults, proxyWebhookDefaultPolicies(params)...)
+	defaults = append(defaults, pipelineDefaultPolicies(params)...)
 
-	manifest, err := networkpolicy.Generate(
+	return networkpolicy.Generate(
 		tp.Spec.NetworkPolicy,
 		tp.Spec.GetTargetNamespace(),
 		defaults,
 	)
-	if err != nil {
-		return err
-	}
-	return r.installerSetClient.CustomSet(ctx, tp, "pipeline-network-policies", &manifest, passthroughTransform, nil)
 }
 
 // passthroughTransform is a no-op FilterAndTransform used for pre-built manifests
@@ -22,6 +22,7 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	tektonpipelinereconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonpipeline"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/common/networkpolicy"
@@ -47,6 +48,8 @@ type Reconciler struct {
 	extension common.Extension
 	// kube client to interact with core k8s resources
 	kubeClientSet kubernetes.Interface
+	// operator client to interact with the operator resources
+	operatorClientSet clientset.Interface
 	// version of pipelines which we are installing
 	pipelineVersion string
 	// platformParams holds platform-specific values for building NetworkPolicy rules
@@ -76,9 +79,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tp *v1alpha1.TektonPipel
 			v1alpha1.PipelineResourceName, tp.GetName())
 		logger.Errorw("Invalid resource name", "expectedName", v1alpha1.PipelineResourceName, "actualName", tp.GetName())
 		tp.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, tp, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, tp, &tp.Status); err != nil {
+		return err
+	}
+
 	// Pass the object through defaulting
 	tp.SetDefaults(ctx)
 
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonpipeline
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/common/networkpolicy"
+)
+
+// Render returns the manifests the reconciler applies for the given TektonPipeline:
+// the transformed payload followed by the generated NetworkPolicies.
+func Render(ctx context.Context, manifest mf.Manifest, tp *v1alpha1.TektonPipeline, extension common.Extension, params networkpolicy.PlatformParams) (mf.Manifest, error) {
+	manifest = manifest.Filter(mf.Not(mf.ByKind("Namespace")))
+	transformed, err := filterAndTransform(extension)(ctx, &manifest, tp)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	policies, err := networkPolicies(tp, params)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	return transformed.Append(policies), nil
+}
@@ -21,8 +21,10 @@ import (
 
 	tektonInstallerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektoninstallerset"
 	tektonPipelineinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpipeline"
+	"github.com/tektoncd/operator/pkg/jobpruner"
 	"github.com/tektoncd/operator/pkg/reconciler/common/networkpolicy"
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 
@@ -62,7 +64,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 		}
 
 		tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
-		metrics, _ := NewRecorder()
+		metrics, _ := common.NewRecorder(v1alpha1.KindTektonPruner)
 
 		params := networkpolicy.KubernetesPlatformDefaults()
 		if v1alpha1.IsOpenShiftPlatform() {
@@ -79,8 +81,10 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			prunerVersion:      prunerVer,
 			operatorVersion:    operatorVer,
 			platformParams:     params,
+			dryRunPruner:       jobpruner.New(dynamic.NewForConfigOrDie(injection.GetConfig(ctx)), logger),
 		}
 		impl := tektonPrunerreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonPruner, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for TektonPruner")
 
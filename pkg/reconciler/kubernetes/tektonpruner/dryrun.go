@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonpruner
+
+import (
+	"context"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/jobpruner"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	"github.com/tektoncd/pruner/pkg/config"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	prunerControllerDeployment = "tekton-pruner-controller"
+	// dryRunReportName is the ConfigMap listing the runs the global config
+	// would prune in dry-run
+	dryRunReportName = "tekton-pruner-dry-run"
+	// dryRunInterval is how often the global config is evaluated in dry-run
+	dryRunInterval = time.Hour
+)
+
+// scaleDownController stops the pruner controller in dry-run, so that no run
+// is deleted
+func scaleDownController(dryRun bool) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if !dryRun || u.GetKind() != "Deployment" || u.GetName() != prunerControllerDeployment {
+			return nil
+		}
+		return unstructured.SetNestedField(u.Object, int64(0), "spec", "replicas")
+	}
+}
+
+// reconcileDryRun evaluates the global config in dry-run and reports the runs
+// it would prune, it returns when to evaluate it again. The evaluation is
+// repeated when the global config or the results guard changes and after
+// dryRunInterval.
+func (r *Reconciler) reconcileDryRun(ctx context.Context, tp *v1alpha1.TektonPruner, now time.Time) (time.Time, error) {
+	namespace := tp.Spec.GetTargetNamespace()
+	if !tp.Spec.DryRun {
+		tp.Status.DryRun = nil
+		err := r.kubeClientSet.CoreV1().ConfigMaps(namespace).Delete(ctx, dryRunReportName, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return time.Time{}, err
+		}
+		return time.Time{}, nil
+	}
+
+	gracePeriod, err := r.resultsGracePeriod(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	configHash, err := hash.Compute(struct {
+		GlobalConfig       *config.GlobalConfig
+		ResultsGracePeriod time.Duration
+	}{tp.Spec.GlobalConfig, gracePeriod})
+	if err != nil {
+		return time.Time{}, err
+	}
+	report, err := r.kubeClientSet.CoreV1().ConfigMaps(namespace).Get(ctx, dryRunReportName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return time.Time{}, err
+	}
+	if err == nil && report.Annotations[v1alpha1.LastAppliedHashKey] == configHash &&
+		tp.Status.DryRun != nil && tp.Status.DryRun.CompletionTime != nil {
+		if next := tp.Status.DryRun.CompletionTime.Add(dryRunInterval); now.Before(next) {
+			return next, nil
+		}
+	}
+
+	summary, candidates, err := r.dryRunPruner.WithResultsGuard(gracePeriod).Evaluate(ctx, tp.Spec.GlobalConfig)
+	if err != nil {
+		return time.Time{}, err
+	}
+	owner := *metav1.NewControllerRef(tp, tp.GetGroupVersionKind())
+	annotations := map[string]string{v1alpha1.LastAppliedHashKey: configHash}
+	if err := jobpruner.WriteReport(ctx, r.kubeClientSet, namespace, dryRunReportName, owner, annotations, candidates); err != nil {
+		return time.Time{}, err
+	}
+	summary.Report = dryRunReportName
+	tp.Status.DryRun = &summary
+	return summary.CompletionTime.Add(dryRunInterval), nil
+}
+
+// resultsGracePeriod returns the grace period of the results guard of
+// TektonConfig, or 0 when it is not active
+func (r *Reconciler) resultsGracePeriod(ctx context.Context) (time.Duration, error) {
+	tc, err := r.operatorClientSet.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if !tc.Spec.ResultsGuardActive() {
+		return 0, nil
+	}
+	return tc.Spec.ResultsGuard.GetGracePeriod(), nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonpruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorFake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/jobpruner"
+	"github.com/tektoncd/pruner/pkg/config"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+)
+
+func TestScaleDownController(t *testing.T) {
+	deployment := func(name string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetKind("Deployment")
+		u.SetName(name)
+		_ = unstructured.SetNestedField(u.Object, int64(1), "spec", "replicas")
+		return u
+	}
+	replicas := func(u *unstructured.Unstructured) int64 {
+		r, _, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+		return r
+	}
+
+	controller := deployment(prunerControllerDeployment)
+	assert.NilError(t, scaleDownController(false)(controller))
+	assert.Equal(t, int64(1), replicas(controller))
+	assert.NilError(t, scaleDownController(true)(controller))
+	assert.Equal(t, int64(0), replicas(controller))
+
+	webhook := deployment("tekton-pruner-webhook")
+	assert.NilError(t, scaleDownController(true)(webhook))
+	assert.Equal(t, int64(1), replicas(webhook))
+}
+
+func completedPipelineRun(namespace, name string, created time.Time) runtime.Object {
+	run := &unstructured.Unstructured{}
+	run.SetAPIVersion("tekton.dev/v1")
+	run.SetKind("PipelineRun")
+	run.SetNamespace(namespace)
+	run.SetName(name)
+	run.SetCreationTimestamp(metav1.NewTime(created))
+	_ = unstructured.SetNestedField(run.Object, created.Add(time.Minute).Format(time.RFC3339), "status", "completionTime")
+	_ = unstructured.SetNestedSlice(run.Object, []interface{}{
+		map[string]interface{}{"type": "Succeeded", "status": "True"},
+	}, "status", "conditions")
+	return run
+}
+
+func TestReconcileDryRun(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	dynamicClient := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: "tekton.dev", Version: "v1", Resource: "pipelineruns"}: "PipelineRunList",
+			{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}:     "TaskRunList",
+		},
+		completedPipelineRun("ns-one", "pr-1", now.Add(-2*time.Hour)),
+		completedPipelineRun("ns-one", "pr-2", now.Add(-time.Hour)),
+	)
+	kube := fake.NewSimpleClientset()
+	operator := operatorFake.NewSimpleClientset()
+	r := &Reconciler{kubeClientSet: kube, operatorClientSet: operator, dryRunPruner: jobpruner.New(dynamicClient, zap.NewNop().Sugar())}
+	tp := &v1alpha1.TektonPruner{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.TektonPrunerResourceName},
+		Spec: v1alpha1.TektonPrunerSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner: v1alpha1.Pruner{
+				DryRun: true,
+				TektonPrunerConfig: v1alpha1.TektonPrunerConfig{
+					GlobalConfig: &config.GlobalConfig{PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(1)}},
+				},
+			},
+		},
+	}
+
+	next, err := r.reconcileDryRun(ctx, tp, now)
+	assert.NilError(t, err)
+	assert.Assert(t, tp.Status.DryRun != nil)
+	assert.Equal(t, 1, tp.Status.DryRun.Candidates)
+	assert.Equal(t, 1, tp.Status.DryRun.Kept)
+	assert.Equal(t, dryRunReportName, tp.Status.DryRun.Report)
+	assert.Assert(t, len(tp.Status.DryRun.NotEvaluated) > 0)
+	assert.Equal(t, tp.Status.DryRun.CompletionTime.Add(dryRunInterval), next)
+	report, err := kube.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, dryRunReportName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, map[string]string{"ns-one": "pipelinerun/pr-1\n"}, report.Data)
+	assert.Equal(t, "TektonPruner", report.OwnerReferences[0].Kind)
+
+	// the report is kept until the interval elapses
+	completed := tp.Status.DryRun.CompletionTime
+	next, err = r.reconcileDryRun(ctx, tp, now.Add(time.Minute))
+	assert.NilError(t, err)
+	assert.Equal(t, completed, tp.Status.DryRun.CompletionTime)
+	assert.Equal(t, completed.Add(dryRunInterval), next)
+
+	// a change of the global config is evaluated again
+	tp.Spec.GlobalConfig.HistoryLimit = ptr.Int32(2)
+	_, err = r.reconcileDryRun(ctx, tp, now.Add(time.Minute))
+	assert.NilError(t, err)
+	assert.Equal(t, 0, tp.Status.DryRun.Candidates)
+
+	// the runs not stored in Tekton Results are kept by the results guard
+	tp.Spec.GlobalConfig.HistoryLimit = ptr.Int32(1)
+	_, err = operator.OperatorV1alpha1().TektonConfigs().Create(ctx, &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec:       v1alpha1.TektonConfigSpec{Profile: v1alpha1.ProfileAll},
+	}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	_, err = r.reconcileDryRun(ctx, tp, now.Add(time.Minute))
+	assert.NilError(t, err)
+	assert.Equal(t, 0, tp.Status.DryRun.Candidates)
+	assert.Equal(t, 2, tp.Status.DryRun.Kept)
+
+	// the report is removed when dry-run is turned off
+	tp.Spec.DryRun = false
+	next, err = r.reconcileDryRun(ctx, tp, now)
+	assert.NilError(t, err)
+	assert.Assert(t, next.IsZero())
+	assert.Assert(t, tp.Status.DryRun == nil)
+	_, err = kube.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, dryRunReportName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
@@ -19,12 +19,14 @@ package tektonpruner
 import (
 	"context"
 	"fmt"
+	"time"
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	operatorclient "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	pipelineinformer "github.com/tektoncd/operator/pkg/client/informers/externalversions/operator/v1alpha1"
 	tektonprunerreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonpruner"
+	"github.com/tektoncd/operator/pkg/jobpruner"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/common/networkpolicy"
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
@@ -53,6 +55,8 @@ type Reconciler struct {
 	operatorVersion string
 	// platformParams holds platform-specific values for building NetworkPolicy rules
 	platformParams networkpolicy.PlatformParams
+	// dryRunPruner evaluates the global config in dry-run
+	dryRunPruner *jobpruner.Pruner
 }
 
 // Check that our Reconciler implements controller.Reconciler
@@ -72,9 +76,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tp *v1alpha1.TektonPrune
 		)
 		logger.Error(msg)
 		tp.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, tp, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, tp, &tp.Status); err != nil {
+		return err
+	}
+
 	// reconcile target namespace
 	if err := common.ReconcileTargetNamespace(ctx, nil, nil, tp, r.kubeClientSet); err != nil {
 		return err
@@ -138,5 +147,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tp *v1alpha1.TektonPrune
 
 	// Mark PostReconcile Complete
 	tp.Status.MarkPostReconcilerComplete()
-	return nil
+
+	now := time.Now()
+	nextDryRun, err := r.reconcileDryRun(ctx, tp, now)
+	if err != nil {
+		logger.Errorw("dry-run evaluation failed", "error", err)
+		return err
+	}
+	return common.RequeueAt(now, nextDryRun)
 }
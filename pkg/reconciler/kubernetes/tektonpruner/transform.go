@@ -49,6 +49,12 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			return &mf.Manifest{}, err
 		}
 
-		return manifest, nil
+		// the controller stays stopped in dry-run whatever the options
+		transformed, err := manifest.Transform(scaleDownController(prunerCR.Spec.DryRun))
+		if err != nil {
+			return &mf.Manifest{}, err
+		}
+
+		return &transformed, nil
 	}
 }
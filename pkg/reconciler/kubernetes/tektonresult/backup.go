@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/robfig/cron"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// dbBackupName names the CronJob backing up the bundled database, and
+	// labels the pods of its Jobs and of the restore Job
+	dbBackupName             = "tekton-results-postgres-backup"
+	dbBackupDir              = "/backups"
+	dbBackupPrefix           = "tekton-results-backups"
+	dbBackupBackoffLimit     = 3
+	defaultDBBackupRetention = 7
+	defaultDBName            = "tekton-results"
+	// dbBackupPollInterval is how often a running backup is checked to report
+	// its completion in the status
+	dbBackupPollInterval = time.Minute
+	// dbBackupFSGroup owns the files of the backup PersistentVolumeClaim, so
+	// that the non-root user of the dump can write to it
+	dbBackupFSGroup = 65532
+
+	backupUploaderImageEnvKey = "IMAGE_JOB_RESULTS_BACKUP_UPLOADER"
+)
+
+// dbBackupScript dumps the database in the backup directory and keeps the
+// most recent dumps
+const dbBackupScript = `set -eu
+file="/backups/tekton-results-$(date -u +%Y%m%dT%H%M%SZ).dump"
+pg_dump --format=custom --file="$file.partial"
+mv "$file.partial" "$file"
+ls -1 /backups/tekton-results-*.dump | sort -r | tail -n +$((BACKUP_RETENTION + 1)) | xargs -r rm -f`
+
+// dbBackupUploadScript uploads the dump to the object storage and keeps the
+// most recent backups of the bucket
+const dbBackupUploadScript = `set -eu
+set --
+if [ -n "${S3_ENDPOINT:-}" ]; then set -- --endpoint-url "$S3_ENDPOINT"; fi
+target="s3://$S3_BUCKET_NAME/$BACKUP_PREFIX"
+for file in /backups/tekton-results-*.dump; do
+  aws "$@" s3 cp "$file" "$target/"
+done
+aws "$@" s3 ls "$target/" | while read -r _ _ _ name; do echo "$name"; done |
+  grep '^tekton-results-.*\.dump$' | sort -r | tail -n +$((BACKUP_RETENTION + 1)) |
+  while read -r name; do aws "$@" s3 rm "$target/$name"; done`
+
+// reconcileBackup creates the CronJob backing up the bundled database on the
+// schedule of the backup policy, and removes it once backups are disabled.
+// It reports the last successful backup and returns when to check again.
+func (r *Reconciler) reconcileBackup(ctx context.Context, tr *v1alpha1.TektonResult, now time.Time) (time.Time, error) {
+	logger := logging.FromContext(ctx)
+	cronJobs := r.kubeClientSet.BatchV1().CronJobs(tr.Spec.TargetNamespace)
+
+	if tr.Spec.IsExternalDB || tr.Spec.Backup.Schedule == "" {
+		if err := cronJobs.Delete(ctx, dbBackupName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return time.Time{}, err
+		}
+		return time.Time{}, nil
+	}
+
+	schedule, err := cron.ParseStandard(tr.Spec.Backup.Schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid backup schedule %q: %w", tr.Spec.Backup.Schedule, err)
+	}
+	image, err := r.postgresImage(ctx, tr.Spec.TargetNamespace)
+	if err != nil {
+		return time.Time{}, err
+	}
+	desired, err := dbBackupCronJob(tr, image, os.Getenv(backupUploaderImageEnvKey))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	existing, err := cronJobs.Get(ctx, dbBackupName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		logger.Infof("Creating cronjob %s backing up the database", dbBackupName)
+		if existing, err = cronJobs.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return time.Time{}, err
+		}
+	case err != nil:
+		return time.Time{}, err
+	case existing.Annotations[v1alpha1.LastAppliedHashKey] != desired.Annotations[v1alpha1.LastAppliedHashKey]:
+		logger.Infof("Updating cronjob %s backing up the database", dbBackupName)
+		desired.ResourceVersion = existing.ResourceVersion
+		if existing, err = cronJobs.Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	if last := existing.Status.LastSuccessfulTime; last != nil {
+		tr.Status.LastBackupTime = last.DeepCopy()
+	}
+	if len(existing.Status.Active) > 0 {
+		return now.Add(dbBackupPollInterval), nil
+	}
+	return schedule.Next(now).Add(dbBackupPollInterval), nil
+}
+
+// dbBackupCronJob returns the CronJob dumping the database with pg_dump to the
+// PersistentVolumeClaim of the backup policy, or to an emptyDir uploaded to
+// the object storage by a second container
+func dbBackupCronJob(tr *v1alpha1.TektonResult, image, uploaderImage string) (*batchv1.CronJob, error) {
+	backup := tr.Spec.Backup
+	labels := map[string]string{
+		"app":                    dbBackupName,
+		"app.kubernetes.io/name": dbBackupName,
+		v1alpha1.CreatedByKey:    createdByValue,
+	}
+	retention := uint(defaultDBBackupRetention)
+	if backup.Retention != nil {
+		retention = *backup.Retention
+	}
+	dbSecret, userKey, passwordKey := DefaultDbSecretName, postgresUserKey, postgresPasswordKey
+	if tr.Spec.DBSecretName != "" {
+		dbSecret = tr.Spec.DBSecretName
+	}
+	if tr.Spec.DBSecretUserKey != "" {
+		userKey = tr.Spec.DBSecretUserKey
+	}
+	if tr.Spec.DBSecretPasswordKey != "" {
+		passwordKey = tr.Spec.DBSecretPasswordKey
+	}
+	dbName := tr.Spec.DBName
+	if dbName == "" {
+		dbName = defaultDBName
+	}
+	securityContext := &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.Bool(false),
+		RunAsNonRoot:             ptr.Bool(true),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+		SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	retentionEnv := corev1.EnvVar{Name: "BACKUP_RETENTION", Value: fmt.Sprint(retention)}
+	volumeMounts := []corev1.VolumeMount{{Name: "backups", MountPath: dbBackupDir}}
+
+	dump := corev1.Container{
+		Name:    "dump",
+		Image:   image,
+		Command: []string{"/bin/sh", "-c", dbBackupScript},
+		Env: []corev1.EnvVar{
+			{Name: "PGHOST", Value: fmt.Sprintf("%s.%s.svc.cluster.local", servicePostgresDB, tr.Spec.TargetNamespace)},
+			{Name: "PGPORT", Value: fmt.Sprint(portOrDefault(tr.Spec.DBPort, defaultDBPort))},
+			{Name: "PGDATABASE", Value: dbName},
+			secretEnv("PGUSER", dbSecret, userKey, false),
+			secretEnv("PGPASSWORD", dbSecret, passwordKey, false),
+			retentionEnv,
+		},
+		VolumeMounts:    volumeMounts,
+		SecurityContext: securityContext,
+	}
+	podSpec := corev1.PodSpec{RestartPolicy: corev1.RestartPolicyNever}
+
+	if backup.PVC != "" {
+		podSpec.Containers = []corev1.Container{dump}
+		podSpec.Volumes = []corev1.Volume{{Name: "backups", VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: backup.PVC},
+		}}}
+		// on OpenShift the SCC of the namespace assigns the fsGroup
+		if !v1alpha1.IsOpenShiftPlatform() {
+			podSpec.SecurityContext = &corev1.PodSecurityContext{FSGroup: ptr.Int64(dbBackupFSGroup)}
+		}
+	} else {
+		if uploaderImage == "" {
+			return nil, fmt.Errorf("backup uploader image '%s' environment variable is not set", backupUploaderImageEnvKey)
+		}
+		podSpec.InitContainers = []corev1.Container{dump}
+		podSpec.Containers = []corev1.Container{{
+			Name:    "upload",
+			Image:   uploaderImage,
+			Command: []string{"/bin/sh", "-c", dbBackupUploadScript},
+			Env: []corev1.EnvVar{
+				{Name: "HOME", Value: "/tmp"},
+				{Name: "BACKUP_PREFIX", Value: dbBackupPrefix},
+				secretEnv("S3_BUCKET_NAME", backup.SecretName, "S3_BUCKET_NAME", false),
+				secretEnv("S3_ENDPOINT", backup.SecretName, "S3_ENDPOINT", true),
+				secretEnv("AWS_DEFAULT_REGION", backup.SecretName, "S3_REGION", true),
+				secretEnv("AWS_ACCESS_KEY_ID", backup.SecretName, "S3_ACCESS_KEY_ID", false),
+				secretEnv("AWS_SECRET_ACCESS_KEY", backup.SecretName, "S3_SECRET_ACCESS_KEY", false),
+				retentionEnv,
+			},
+			VolumeMounts: volumeMounts,
+			// the image of the uploader can run as root, only drop what it
+			// does not need
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.Bool(false),
+				Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+				SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			},
+		}}
+		podSpec.Volumes = []corev1.Volume{{Name: "backups", VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}}}
+	}
+
+	spec := batchv1.CronJobSpec{
+		Schedule:                   backup.Schedule,
+		ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+		SuccessfulJobsHistoryLimit: ptr.Int32(1),
+		FailedJobsHistoryLimit:     ptr.Int32(1),
+		JobTemplate: batchv1.JobTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Spec: batchv1.JobSpec{
+				BackoffLimit: ptr.Int32(dbBackupBackoffLimit),
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec:       podSpec,
+				},
+			},
+		},
+	}
+	specHash, err := hash.Compute(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            dbBackupName,
+			Namespace:       tr.Spec.TargetNamespace,
+			Labels:          labels,
+			Annotations:     map[string]string{v1alpha1.LastAppliedHashKey: specHash},
+			OwnerReferences: []metav1.OwnerReference{getOwnerRef(tr)},
+		},
+		Spec: spec,
+	}, nil
+}
+
+// secretEnv returns an environment variable set from the key of a secret
+func secretEnv(name, secret, key string, optional bool) corev1.EnvVar {
+	selector := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secret}, Key: key}
+	if optional {
+		selector.Optional = ptr.Bool(true)
+	}
+	return corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: selector}}
+}
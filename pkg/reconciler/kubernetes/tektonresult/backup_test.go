@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReconcileBackup_PVC(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset(dbRotationObjects()...)
+	r := newTestReconciler(kube)
+	now := time.Date(2026, 3, 1, 1, 30, 0, 0, time.UTC)
+
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	tr.Spec.Backup = v1alpha1.ResultsBackup{Schedule: "0 2 * * *", PVC: "results-backups"}
+	next, err := r.reconcileBackup(ctx, tr, now)
+	assert.NilError(t, err)
+	assert.Equal(t, next, time.Date(2026, 3, 1, 2, 1, 0, 0, time.UTC))
+
+	cronJob, err := kube.BatchV1().CronJobs("tekton-pipelines").Get(ctx, dbBackupName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cronJob.Spec.Schedule, "0 2 * * *")
+	assert.Equal(t, cronJob.Spec.ConcurrencyPolicy, batchv1.ForbidConcurrent)
+	assert.Equal(t, cronJob.OwnerReferences[0].Name, tr.Name)
+	pod := cronJob.Spec.JobTemplate.Spec.Template
+	assert.Equal(t, pod.Labels["app"], dbBackupName)
+	assert.Equal(t, len(pod.Spec.InitContainers), 0)
+	assert.Equal(t, len(pod.Spec.Containers), 1)
+	assert.Equal(t, pod.Spec.Containers[0].Image, "postgres:15")
+	assert.Equal(t, pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName, "results-backups")
+	assert.Equal(t, *pod.Spec.SecurityContext.FSGroup, int64(dbBackupFSGroup))
+	assert.Equal(t, envValue(pod.Spec.Containers[0].Env, "PGDATABASE"), defaultDBName)
+	assert.Equal(t, envValue(pod.Spec.Containers[0].Env, "BACKUP_RETENTION"), "7")
+
+	// the CronJob follows the policy
+	retention := uint(3)
+	tr.Spec.Backup.Retention = &retention
+	_, err = r.reconcileBackup(ctx, tr, now)
+	assert.NilError(t, err)
+	cronJob, err = kube.BatchV1().CronJobs("tekton-pipelines").Get(ctx, dbBackupName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, envValue(cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env, "BACKUP_RETENTION"), "3")
+
+	// the last successful backup is reported, a running backup is polled
+	lastBackup := metav1.NewTime(now.Add(-time.Hour).Truncate(time.Second))
+	cronJob.Status.LastSuccessfulTime = &lastBackup
+	cronJob.Status.Active = []corev1.ObjectReference{{Name: "backup"}}
+	_, err = kube.BatchV1().CronJobs("tekton-pipelines").UpdateStatus(ctx, cronJob, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	next, err = r.reconcileBackup(ctx, tr, now)
+	assert.NilError(t, err)
+	assert.Equal(t, next, now.Add(dbBackupPollInterval))
+	assert.Assert(t, tr.Status.LastBackupTime.Equal(&lastBackup))
+
+	// the CronJob is removed once backups are disabled
+	tr.Spec.Backup = v1alpha1.ResultsBackup{}
+	next, err = r.reconcileBackup(ctx, tr, now)
+	assert.NilError(t, err)
+	assert.Assert(t, next.IsZero())
+	_, err = kube.BatchV1().CronJobs("tekton-pipelines").Get(ctx, dbBackupName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func TestDBBackupCronJob_PVCOnOpenShift(t *testing.T) {
+	t.Setenv("PLATFORM", "openshift")
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	tr.Spec.Backup = v1alpha1.ResultsBackup{Schedule: "0 2 * * *", PVC: "results-backups"}
+
+	cronJob, err := dbBackupCronJob(tr, "postgres:15", "")
+	assert.NilError(t, err)
+	// the fsGroup is assigned by the SCC
+	assert.Assert(t, cronJob.Spec.JobTemplate.Spec.Template.Spec.SecurityContext == nil)
+}
+
+func TestReconcileBackup_ObjectStorage(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset(dbRotationObjects()...)
+	r := newTestReconciler(kube)
+
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	tr.Spec.Backup = v1alpha1.ResultsBackup{Schedule: "@daily", SecretName: "backup-storage"}
+	_, err := r.reconcileBackup(ctx, tr, time.Now())
+	assert.ErrorContains(t, err, backupUploaderImageEnvKey)
+
+	t.Setenv(backupUploaderImageEnvKey, "amazon/aws-cli")
+	_, err = r.reconcileBackup(ctx, tr, time.Now())
+	assert.NilError(t, err)
+	cronJob, err := kube.BatchV1().CronJobs("tekton-pipelines").Get(ctx, dbBackupName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	pod := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	assert.Equal(t, pod.InitContainers[0].Image, "postgres:15")
+	assert.Equal(t, pod.Containers[0].Image, "amazon/aws-cli")
+	assert.Assert(t, pod.Volumes[0].EmptyDir != nil)
+	for _, env := range pod.Containers[0].Env {
+		if env.ValueFrom != nil {
+			assert.Equal(t, env.ValueFrom.SecretKeyRef.Name, "backup-storage")
+		}
+	}
+
+	// an external database is not backed up
+	tr.Spec.IsExternalDB = true
+	_, err = r.reconcileBackup(ctx, tr, time.Now())
+	assert.NilError(t, err)
+	_, err = kube.BatchV1().CronJobs("tekton-pipelines").Get(ctx, dbBackupName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func envValue(env []corev1.EnvVar, name string) string {
+	for _, e := range env {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common/certmanager"
+)
+
+const (
+	certificateSetName = "results-certificate"
+	apiCertificateName = "tekton-results-api"
+)
+
+// reconcileCertificate has cert-manager issue the certificate of the Results
+// API in the TLS secret mounted by the API, in place of the self-signed one
+func (r *Reconciler) reconcileCertificate(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	if err := certmanager.Available(r.kubeClientSet.Discovery()); err != nil {
+		tr.Status.MarkDependencyMissing(err.Error())
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+	manifest, err := resultsCertificate(tr)
+	if err != nil {
+		return err
+	}
+	return r.installerSetClient.CustomSet(ctx, tr, certificateSetName, &manifest, passthroughTransform, nil)
+}
+
+func resultsCertificate(tr *v1alpha1.TektonResult) (mf.Manifest, error) {
+	return certmanager.Generate(tr.Spec.TLS, tr.Spec.GetTargetNamespace(), certmanager.Certificate{
+		Name:       apiCertificateName,
+		SecretName: TlsSecretName,
+		DNSNames:   append([]string{apiServiceHostname(tr.Spec.GetTargetNamespace())}, routeHosts(tr)...),
+		Rotation:   tr.Spec.Rotation.TLS,
+	})
+}
+
+// routeHosts returns the hosts the Results API is exposed on, its
+// certificate is also issued for them
+func routeHosts(tr *v1alpha1.TektonResult) []string {
+	if cfg := tr.Spec.ResultsAPIProperties.IngressConfig(); cfg.Enabled && cfg.Host != "" {
+		return []string{cfg.Host}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"testing"
+
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestResultsCertificate(t *testing.T) {
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{RouteHost: "results.example.com"})
+	tr.Spec.TLS = v1alpha1.TLSConfig{
+		Provider:  v1alpha1.TLSProviderCertManager,
+		IssuerRef: &v1alpha1.IssuerReference{Name: "ca", Kind: "ClusterIssuer"},
+	}
+	m, err := resultsCertificate(tr)
+	assert.NilError(t, err)
+	assert.Equal(t, len(m.Resources()), 1)
+
+	cert := &certv1.Certificate{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(m.Resources()[0].Object, cert))
+	assert.Equal(t, cert.Namespace, "tekton-pipelines")
+	// the API mounts the secret the certificate is issued in
+	assert.Equal(t, cert.Spec.SecretName, TlsSecretName)
+	assert.DeepEqual(t, cert.Spec.DNSNames, []string{apiServiceHostname("tekton-pipelines"), "results.example.com"})
+	assert.Equal(t, cert.Spec.IssuerRef.Name, "ca")
+	assert.Equal(t, cert.Spec.IssuerRef.Kind, "ClusterIssuer")
+	assert.Equal(t, cert.Spec.IssuerRef.Group, v1alpha1.CertManagerGroup)
+}
+
+func TestCreateTLSSecret_CertManagerMissing(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	r := newTestReconciler(kube)
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	tr.Spec.TLS = v1alpha1.TLSConfig{Provider: v1alpha1.TLSProviderCertManager, IssuerRef: &v1alpha1.IssuerReference{Name: "ca"}}
+
+	err := r.createTLSSecret(context.Background(), tr)
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	// no self-signed certificate is generated in the meantime
+	_, err = kube.CoreV1().Secrets("tekton-pipelines").Get(context.Background(), TlsSecretName, metav1.GetOptions{})
+	assert.Assert(t, err != nil)
+}
+
+func TestCreateTLSSecret_LeftByCertManager(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        TlsSecretName,
+			Namespace:   "tekton-pipelines",
+			Annotations: map[string]string{certManagerCertificateAnnotation: apiCertificateName},
+		},
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("issued"), "ca.crt": []byte("ca")},
+	})
+	r := newTestReconciler(kube)
+
+	// the selfSigned provider takes the secret over as cert-manager stops renewing it
+	assert.NilError(t, r.createTLSSecret(ctx, resultWithRoute(v1alpha1.ResultsAPIProperties{})))
+	secret, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, secret.Labels[v1alpha1.CreatedByKey], createdByValue)
+	assert.Assert(t, !isIssuedByCertManager(secret))
+	_, err = parseCertificate(secret)
+	assert.NilError(t, err)
+}
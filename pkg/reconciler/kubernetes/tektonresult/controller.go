@@ -65,7 +65,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			logger.Fatal(err)
 		}
 
-		metrics, _ := common.NoMetrics()
+		metrics, _ := common.NewRecorder(v1alpha1.KindTektonResult)
 
 		tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
 
@@ -86,6 +86,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			platformParams:     params,
 		}
 		impl := tektonResultReconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonResult, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for tekton-results")
 
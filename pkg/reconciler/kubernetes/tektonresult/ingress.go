@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common/ingress"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ingressSetName       = "results-ingress"
+	apiIngressName       = "tekton-results-api"
+	apiServiceName       = "tekton-results-api-service"
+	apiCAConfigMapName   = "tekton-results-api-ca"
+	apiCAConfigMapKey    = "ca.crt"
+	backendProtocolKey   = "nginx.ingress.kubernetes.io/backend-protocol"
+	backendProtocolGRPCS = "GRPCS"
+)
+
+// reconcileIngress creates the Ingress or the Gateway API route exposing the
+// Results API on Kubernetes, and removes it once it is disabled. OpenShift
+// exposes the API with the Route of the platform extension.
+func (r *Reconciler) reconcileIngress(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	cfg := tr.Spec.ResultsAPIProperties.IngressConfig()
+	if !cfg.Enabled || v1alpha1.IsOpenShiftPlatform() {
+		if err := r.deleteAPICAConfigMap(ctx, tr); err != nil {
+			return err
+		}
+		return r.installerSetClient.CleanupCustomSet(ctx, ingressSetName)
+	}
+	if cfg.GetType() == v1alpha1.IngressTypeIngress {
+		if err := r.deleteAPICAConfigMap(ctx, tr); err != nil {
+			return err
+		}
+	} else if err := r.reconcileAPICAConfigMap(ctx, tr); err != nil {
+		return err
+	}
+	manifest, err := resultsIngress(tr)
+	if err != nil {
+		return err
+	}
+	return r.installerSetClient.CustomSet(ctx, tr, ingressSetName, &manifest, passthroughTransform, nil)
+}
+
+func resultsIngress(tr *v1alpha1.TektonResult) (mf.Manifest, error) {
+	props := tr.Spec.ResultsAPIProperties
+	cfg := props.IngressConfig()
+	// the certificate of the Results API is the default certificate of the
+	// host, the operator adds the host to it when it generated it
+	if cfg.TLSSecret == "" && cfg.Host != "" && cfg.GetType() == v1alpha1.IngressTypeIngress {
+		cfg.TLSSecret = TlsSecretName
+	}
+	return ingress.Generate(cfg, tr.Spec.GetTargetNamespace(), ingress.Backend{
+		Name:    apiIngressName,
+		Service: apiServiceName,
+		Port:    portOrDefault(props.ServerPort, defaultAPIPort),
+		// the API serves gRPC and its REST gateway over TLS only
+		Annotations: map[string]string{backendProtocolKey: backendProtocolGRPCS},
+		TLS: &ingress.BackendTLS{
+			Hostname:    apiServiceHostname(tr.Spec.GetTargetNamespace()),
+			CAConfigMap: apiCAConfigMapName,
+		},
+	})
+}
+
+// reconcileAPICAConfigMap publishes the CA of the certificate of the Results
+// API, the Gateway validates the backend with it. It is kept out of the
+// installer set so that it follows the content of the TLS secret.
+func (r *Reconciler) reconcileAPICAConfigMap(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	namespace := tr.Spec.GetTargetNamespace()
+	secret, err := r.kubeClientSet.CoreV1().Secrets(namespace).Get(ctx, TlsSecretName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get TLS secret %s of the Results API: %w", TlsSecretName, err)
+	}
+	ca := secret.Data[apiCAConfigMapKey]
+	if len(ca) == 0 {
+		// a self-signed certificate is its own CA
+		ca = secret.Data[corev1.TLSCertKey]
+	}
+	data := map[string]string{apiCAConfigMapKey: string(ca)}
+
+	configMaps := r.kubeClientSet.CoreV1().ConfigMaps(namespace)
+	existing, err := configMaps.Get(ctx, apiCAConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            apiCAConfigMapName,
+				Namespace:       namespace,
+				Labels:          map[string]string{v1alpha1.CreatedByKey: createdByValue},
+				OwnerReferences: []metav1.OwnerReference{getOwnerRef(tr)},
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(existing.Data, data) {
+		return nil
+	}
+	existing.Data = data
+	_, err = configMaps.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *Reconciler) deleteAPICAConfigMap(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	err := r.kubeClientSet.CoreV1().ConfigMaps(tr.Spec.GetTargetNamespace()).Delete(ctx, apiCAConfigMapName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// apiServiceHostname is the in-cluster name the certificate of the Results
+// API is issued for
+func apiServiceHostname(namespace string) string {
+	return fmt.Sprintf("%s.%s.svc.cluster.local", apiServiceName, namespace)
+}
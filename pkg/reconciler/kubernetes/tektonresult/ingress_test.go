@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func resultWithRoute(props v1alpha1.ResultsAPIProperties) *v1alpha1.TektonResult {
+	props.RouteEnabled = ptr.Bool(true)
+	return &v1alpha1.TektonResult{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ResultResourceName},
+		Spec: v1alpha1.TektonResultSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Result:     v1alpha1.Result{ResultsAPIProperties: props},
+		},
+	}
+}
+
+func newTestReconciler(kube *fake.Clientset) *Reconciler {
+	return &Reconciler{
+		kubeClientSet: kube,
+		installerSetClient: client.NewInstallerSetClient(
+			operatorfake.NewSimpleClientset().OperatorV1alpha1().TektonInstallerSets(),
+			"v0.0.1", "v0.0.1", v1alpha1.KindTektonResult, nil),
+	}
+}
+
+func TestResultsIngress(t *testing.T) {
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{RouteHost: "results.example.com", RouteClassName: "nginx"})
+	m, err := resultsIngress(tr)
+	assert.NilError(t, err)
+	assert.Equal(t, len(m.Resources()), 1)
+
+	u := m.Resources()[0]
+	assert.DeepEqual(t, u.GetAnnotations(), map[string]string{backendProtocolKey: backendProtocolGRPCS})
+	ing := &networkingv1.Ingress{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, ing))
+	// the host is served with the certificate of the Results API by default
+	assert.DeepEqual(t, ing.Spec.TLS, []networkingv1.IngressTLS{{Hosts: []string{"results.example.com"}, SecretName: TlsSecretName}})
+	backend := ing.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+	assert.Equal(t, backend.Name, apiServiceName)
+	assert.Equal(t, backend.Port.Number, defaultAPIPort)
+}
+
+func TestResultsIngress_GRPCRoute(t *testing.T) {
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{
+		RouteType:    v1alpha1.IngressTypeGRPCRoute,
+		RouteGateway: &v1alpha1.GatewayReference{Name: "public"},
+		ServerPort:   ptr.Int64(8443),
+	})
+	m, err := resultsIngress(tr)
+	assert.NilError(t, err)
+	assert.Equal(t, len(m.Resources()), 2)
+	route := &gatewayv1.GRPCRoute{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(m.Resources()[0].Object, route))
+	assert.Equal(t, *route.Spec.Rules[0].BackendRefs[0].Port, int32(8443))
+
+	policy := &gatewayv1.BackendTLSPolicy{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(m.Resources()[1].Object, policy))
+	assert.Equal(t, string(policy.Spec.Validation.CACertificateRefs[0].Name), apiCAConfigMapName)
+	assert.Equal(t, string(policy.Spec.Validation.Hostname), "tekton-results-api-service.tekton-pipelines.svc.cluster.local")
+}
+
+func TestCreateTLSSecret_RouteHost(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset()
+	r := newTestReconciler(kube)
+
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	assert.NilError(t, r.createTLSSecret(ctx, tr))
+	secret, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, secret.Labels[v1alpha1.CreatedByKey], createdByValue)
+	assert.Assert(t, !certificateCovers(secret, []string{"results.example.com"}))
+
+	// the generated certificate is reissued once a host is configured
+	tr.Spec.RouteHost = "results.example.com"
+	assert.NilError(t, r.createTLSSecret(ctx, tr))
+	secret, err = kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, certificateCovers(secret, []string{"results.example.com"}))
+	cert, err := parseCertificate(secret)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cert.DNSNames, []string{apiServiceHostname("tekton-pipelines"), "results.example.com"})
+}
+
+func TestCreateTLSSecret_UserSecret(t *testing.T) {
+	ctx := context.Background()
+	userCert, userKey, err := generateTLSCertificate("elsewhere", defaultTLSValidity)
+	assert.NilError(t, err)
+	kube := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: TlsSecretName, Namespace: "tekton-pipelines"},
+		Data:       map[string][]byte{corev1.TLSCertKey: userCert, corev1.TLSPrivateKeyKey: userKey},
+	})
+	r := newTestReconciler(kube)
+
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{RouteHost: "results.example.com"})
+	assert.NilError(t, r.createTLSSecret(ctx, tr))
+	secret, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, secret.Data[corev1.TLSCertKey], userCert)
+}
+
+func TestReconcileAPICAConfigMap(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: TlsSecretName, Namespace: "tekton-pipelines"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("cert")},
+	})
+	r := &Reconciler{kubeClientSet: kube}
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+
+	assert.NilError(t, r.reconcileAPICAConfigMap(ctx, tr))
+	cm, err := kube.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, apiCAConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cm.Data, map[string]string{apiCAConfigMapKey: "cert"})
+
+	// the CA of the secret is preferred over its certificate
+	secret, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	secret.Data[apiCAConfigMapKey] = []byte("ca")
+	_, err = kube.CoreV1().Secrets("tekton-pipelines").Update(ctx, secret, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.reconcileAPICAConfigMap(ctx, tr))
+	cm, err = kube.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, apiCAConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cm.Data, map[string]string{apiCAConfigMapKey: "ca"})
+
+	assert.NilError(t, r.deleteAPICAConfigMap(ctx, tr))
+	assert.NilError(t, r.deleteAPICAConfigMap(ctx, tr))
+}
@@ -145,6 +145,16 @@ func resultsDefaultPolicies(params networkpolicy.PlatformParams, props v1alpha1.
 									MatchLabels: map[string]string{"app": "tekton-results-retention-policy-agent"},
 								},
 							},
+							{
+								PodSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": dbRotationName},
+								},
+							},
+							{
+								PodSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": dbBackupName},
+								},
+							},
 						},
 						Ports: []networkingv1.NetworkPolicyPort{
 							{Protocol: &tcp, Port: &postgresPort},
@@ -156,6 +166,43 @@ func resultsDefaultPolicies(params networkpolicy.PlatformParams, props v1alpha1.
 				},
 			},
 		},
+		{
+			// The Job changing the password of the database on rotation
+			ObjectMeta: metav1.ObjectMeta{Name: "results-postgres-rotation"},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": dbRotationName},
+				},
+				PolicyTypes: []networkingv1.PolicyType{
+					networkingv1.PolicyTypeIngress,
+					networkingv1.PolicyTypeEgress,
+				},
+				Egress: []networkingv1.NetworkPolicyEgressRule{
+					networkpolicy.DNSEgressRule(params),
+					dbEgress,
+				},
+			},
+		},
+		{
+			// The Jobs backing up the database, and the Job restoring it
+			ObjectMeta: metav1.ObjectMeta{Name: "results-postgres-backup"},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": dbBackupName},
+				},
+				PolicyTypes: []networkingv1.PolicyType{
+					networkingv1.PolicyTypeIngress,
+					networkingv1.PolicyTypeEgress,
+				},
+				Egress: []networkingv1.NetworkPolicyEgressRule{
+					networkpolicy.DNSEgressRule(params),
+					dbEgress,
+					// The object storage the backups are uploaded to can be
+					// anywhere, in or outside of the cluster.
+					{},
+				},
+			},
+		},
 	}
 }
 
@@ -185,6 +232,8 @@ func defaultDenyPolicy() networkingv1.NetworkPolicy {
 						"tekton-results-watcher",
 						"tekton-results-retention-policy-agent",
 						"tekton-results-postgres",
+						dbRotationName,
+						dbBackupName,
 					},
 				},
 			},
@@ -196,19 +245,24 @@ func (r *Reconciler) reconcileNetworkPolicies(ctx context.Context, tr *v1alpha1.
 	if tr.Spec.NetworkPolicy.Disabled {
 		return r.installerSetClient.CleanupCustomSet(ctx, "results-network-policies")
 	}
+	manifest, err := networkPolicies(tr, r.platformParams)
+	if err != nil {
+		return err
+	}
+	return r.installerSetClient.CustomSet(ctx, tr, "results-network-policies", &manifest, passthroughTransform, nil)
+}
+
+// networkPolicies generates the NetworkPolicy manifest for the given TektonResult
+func networkPolicies(tr *v1alpha1.TektonResult, params networkpolicy.PlatformParams) (mf.Manifest, error) {
 	defaults := append(
 		[]networkingv1.NetworkPolicy{defaultDenyPolicy()},
-		resultsDefaultPolicies(r.platformParams, tr.Spec.ResultsAPIProperties)...,
+		resultsDefaultPolicies(params, tr.Spec.ResultsAPIProperties)...,
 	)
-	manifest, err := networkpolicy.Generate(
+	return networkpolicy.Generate(
 		tr.Spec.NetworkPolicy,
 		tr.Spec.GetTargetNamespace(),
 		defaults,
 	)
-	if err != nil {
-		return err
-	}
-	return r.installerSetClient.CustomSet(ctx, tr, "results-network-policies", &manifest, passthroughTransform, nil)
 }
 
 func passthroughTransform(_ context.Context, m *mf.Manifest, _ v1alpha1.TektonComponent) (*mf.Manifest, error) {
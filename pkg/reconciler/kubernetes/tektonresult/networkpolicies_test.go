@@ -34,6 +34,8 @@ func TestResultsDefaultPolicies(t *testing.T) {
 		"results-watcher",
 		"results-retention-policy-agent",
 		"results-postgres",
+		"results-postgres-rotation",
+		"results-postgres-backup",
 	}
 	if len(policies) != len(wantNames) {
 		t.Fatalf("expected %d policies, got %d", len(wantNames), len(policies))
@@ -67,6 +69,17 @@ func TestResultsDefaultPolicies(t *testing.T) {
 	assertIngressHasPort(t, "results-postgres", postgres.Spec.Ingress, 5432)
 	assertIngressFromApp(t, "results-postgres", postgres.Spec.Ingress, "tekton-results-api")
 	assertIngressFromApp(t, "results-postgres", postgres.Spec.Ingress, "tekton-results-retention-policy-agent")
+	assertIngressFromApp(t, "results-postgres", postgres.Spec.Ingress, "tekton-results-postgres-rotation")
+	assertIngressFromApp(t, "results-postgres", postgres.Spec.Ingress, "tekton-results-postgres-backup")
+
+	rotation := byName["results-postgres-rotation"]
+	assertEgressHasDNS(t, "results-postgres-rotation", rotation.Spec.Egress, 5353)
+	assertEgressHasDBPort(t, "results-postgres-rotation", rotation.Spec.Egress, 5432)
+
+	backup := byName["results-postgres-backup"]
+	assertEgressHasDNS(t, "results-postgres-backup", backup.Spec.Egress, 5353)
+	assertEgressHasDBPort(t, "results-postgres-backup", backup.Spec.Egress, 5432)
+	assertEgressHasAllowAll(t, "results-postgres-backup", backup.Spec.Egress)
 }
 
 func TestResultsDefaultPoliciesUsesSpecPorts(t *testing.T) {
@@ -123,8 +136,8 @@ func TestGenerateResultsNetworkPolicies(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Generate: %v", err)
 	}
-	if got := len(m.Resources()); got != 5 {
-		t.Errorf("expected 5 resources (deny + 4 defaults), got %d", got)
+	if got := len(m.Resources()); got != 7 {
+		t.Errorf("expected 7 resources (deny + 6 defaults), got %d", got)
 	}
 
 	disabled, err := networkpolicy.Generate(
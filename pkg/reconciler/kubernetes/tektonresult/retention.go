@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"fmt"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// configRetentionPolicy is the ConfigMap of the retention policy agent
+	configRetentionPolicy = "tekton-results-config-results-retention-policy"
+
+	retentionRunAtKey            = "runAt"
+	retentionDefaultRetentionKey = "defaultRetention"
+	retentionPoliciesKey         = "policies"
+)
+
+// retentionPolicy is a policy of the retention policy agent, the first
+// policy matching a record sets its retention
+type retentionPolicy struct {
+	Name      string                  `json:"name"`
+	Selector  retentionPolicySelector `json:"selector"`
+	Retention string                  `json:"retention"`
+}
+
+type retentionPolicySelector struct {
+	MatchNamespaces []string `json:"matchNamespaces"`
+}
+
+// updateRetentionPolicyConfig renders the retention policy of the
+// TektonResult in the ConfigMap of the retention policy agent, the values
+// shipped by Results are kept for the fields which are unset
+func updateRetentionPolicyConfig(policy v1alpha1.ResultsRetentionPolicy) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ConfigMap" || u.GetName() != configRetentionPolicy {
+			return nil
+		}
+		cm := &corev1.ConfigMap{}
+		if err := k8sruntime.DefaultUnstructuredConverter.FromUnstructured(u.Object, cm); err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+
+		if policy.RunAt != "" {
+			cm.Data[retentionRunAtKey] = policy.RunAt
+		}
+		if policy.MaxAge != nil {
+			cm.Data[retentionDefaultRetentionKey] = retentionDuration(policy.MaxAge.Duration)
+		}
+		if len(policy.Namespaces) > 0 {
+			policies := make([]retentionPolicy, 0, len(policy.Namespaces))
+			for i, override := range policy.Namespaces {
+				policies = append(policies, retentionPolicy{
+					Name:      fmt.Sprintf("namespaces-%d", i),
+					Selector:  retentionPolicySelector{MatchNamespaces: override.Names},
+					Retention: retentionDuration(override.MaxAge.Duration),
+				})
+			}
+			out, err := yaml.Marshal(policies)
+			if err != nil {
+				return err
+			}
+			cm.Data[retentionPoliciesKey] = string(out)
+		}
+
+		obj, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(cm)
+		if err != nil {
+			return err
+		}
+		u.SetUnstructuredContent(obj)
+		return nil
+	}
+}
+
+// retentionDuration formats a retention in days when it is a whole number of
+// days, as the defaults of Results are
+func retentionDuration(d time.Duration) string {
+	day := 24 * time.Hour
+	if d%day == 0 {
+		return fmt.Sprintf("%dd", d/day)
+	}
+	return d.String()
+}
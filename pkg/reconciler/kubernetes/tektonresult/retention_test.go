@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestUpdateRetentionPolicyConfig(t *testing.T) {
+	testData := path.Join("testdata", "retention-policy-config.yaml")
+
+	tests := []struct {
+		name   string
+		policy v1alpha1.ResultsRetentionPolicy
+		want   map[string]string
+	}{
+		{
+			name: "defaults of results",
+			want: map[string]string{"runAt": "7 7 * * 7", "defaultRetention": "30d"},
+		},
+		{
+			name: "policy",
+			policy: v1alpha1.ResultsRetentionPolicy{
+				MaxAge: &metav1.Duration{Duration: 90 * 24 * time.Hour},
+				RunAt:  "0 3 * * *",
+				Namespaces: []v1alpha1.ResultsNamespaceRetention{
+					{Names: []string{"prod", "prod-east"}, MaxAge: metav1.Duration{Duration: 365 * 24 * time.Hour}},
+					{Names: []string{"dev"}, MaxAge: metav1.Duration{Duration: 36 * time.Hour}},
+				},
+			},
+			want: map[string]string{
+				"runAt":            "0 3 * * *",
+				"defaultRetention": "90d",
+				"policies": `- name: namespaces-0
+  retention: 365d
+  selector:
+    matchNamespaces:
+    - prod
+    - prod-east
+- name: namespaces-1
+  retention: 36h0m0s
+  selector:
+    matchNamespaces:
+    - dev
+`,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest, err := mf.ManifestFrom(mf.Recursive(testData))
+			assert.NilError(t, err)
+			manifest, err = manifest.Transform(updateRetentionPolicyConfig(test.policy))
+			assert.NilError(t, err)
+
+			cm := &corev1.ConfigMap{}
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, cm)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, cm.Data, test.want)
+		})
+	}
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// defaultTLSValidity is the validity of the self-signed certificate when
+	// the rotation policy sets none
+	defaultTLSValidity = 365 * 24 * time.Hour
+
+	// dbRotationName names the Secret holding the new password of the
+	// database during a rotation, and the Job setting it in the database
+	dbRotationName         = "tekton-results-postgres-rotation"
+	postgresStatefulSet    = "tekton-results-postgres"
+	postgresContainer      = "postgres"
+	retentionAgentName     = "tekton-results-retention-policy-agent"
+	postgresPasswordKey    = "POSTGRES_PASSWORD"
+	postgresUserKey        = "POSTGRES_USER"
+	dbRotationPasswordEnv  = "NEW_PASSWORD"
+	dbRotationBackoffLimit = 3
+)
+
+// dbRotationScript changes the password of the database user, it succeeds
+// when an earlier attempt already changed it
+const dbRotationScript = `PGPASSWORD="$NEW_PASSWORD" psql -c 'SELECT 1' >/dev/null 2>&1 && exit 0
+psql -v ON_ERROR_STOP=1 -v user="$PGUSER" -v password="$NEW_PASSWORD" <<'SQL'
+ALTER ROLE :"user" WITH PASSWORD :'password';
+SQL`
+
+// tlsValidity returns the validity of the self-signed certificate
+func tlsValidity(tr *v1alpha1.TektonResult) time.Duration {
+	if validity := tr.Spec.Rotation.TLS.Validity; validity != nil {
+		return validity.Duration
+	}
+	return defaultTLSValidity
+}
+
+// tlsNextRotation returns when the certificate is renewed, before it expires
+// or once the validity of the policy elapsed
+func tlsNextRotation(policy v1alpha1.RotationPolicy, cert *x509.Certificate) time.Time {
+	validity := cert.NotAfter.Sub(cert.NotBefore)
+	if policy.Validity != nil && policy.Validity.Duration < validity {
+		validity = policy.Validity.Duration
+	}
+	return cert.NotBefore.Add(validity - policy.GetRenewBefore(validity))
+}
+
+// tlsRotationDue tells whether the generated certificate is to be renewed
+func tlsRotationDue(tr *v1alpha1.TektonResult, secret *corev1.Secret, now time.Time) bool {
+	if common.RotationRequested(tr, secret) {
+		return true
+	}
+	cert, err := parseCertificate(secret)
+	if err != nil {
+		return true
+	}
+	return !now.Before(tlsNextRotation(tr.Spec.Rotation.TLS, cert))
+}
+
+// recordTLSRotation reports the rotation of the generated certificate
+func recordTLSRotation(tr *v1alpha1.TektonResult, secret *corev1.Secret) error {
+	cert, err := parseCertificate(secret)
+	if err != nil {
+		return err
+	}
+	tr.Status.Rotations = v1alpha1.SetRotation(tr.Status.Rotations,
+		common.NewRotationStatus(secret.Name, cert.NotBefore, tlsNextRotation(tr.Spec.Rotation.TLS, cert)))
+	return nil
+}
+
+// recordDBRotation reports the rotation of the password of the database
+func recordDBRotation(tr *v1alpha1.TektonResult, secret *corev1.Secret) {
+	issuedAt := common.IssuedAt(secret)
+	tr.Status.Rotations = v1alpha1.SetRotation(tr.Status.Rotations,
+		common.NewRotationStatus(secret.Name, issuedAt, tr.Spec.Rotation.Database.NextRotation(issuedAt)))
+}
+
+// apiRotatedAt returns when the material used by the Results API was last
+// rotated, a change rolls the API
+func apiRotatedAt(tr *v1alpha1.TektonResult) string {
+	return common.LastRotation(tr.Status.Rotations, TlsSecretName, DefaultDbSecretName)
+}
+
+// databaseRotatedAt returns when the password of the database was last
+// rotated, a change rolls the workloads using it
+func databaseRotatedAt(tr *v1alpha1.TektonResult) string {
+	return common.LastRotation(tr.Status.Rotations, DefaultDbSecretName)
+}
+
+// rotateDBPassword rotates the password of the bundled database when it is
+// due or requested. The new password is set in the database by a Job before
+// it replaces the password in the secret used by the workloads.
+func (r *Reconciler) rotateDBPassword(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	logger := logging.FromContext(ctx)
+	secrets := r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace)
+
+	secret, err := secrets.Get(ctx, DefaultDbSecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	pending, err := secrets.Get(ctx, dbRotationName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		now := time.Now()
+		if !common.RotationRequested(tr, secret) && !common.RotationDue(tr.Spec.Rotation.Database, common.IssuedAt(secret), now) {
+			recordDBRotation(tr, secret)
+			return nil
+		}
+		logger.Infof("Rotating the password of the database in secret %s", DefaultDbSecretName)
+		password, err := generateRandomBaseString(20)
+		if err != nil {
+			return err
+		}
+		pending = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            dbRotationName,
+				Namespace:       tr.Spec.TargetNamespace,
+				Labels:          map[string]string{v1alpha1.CreatedByKey: createdByValue},
+				Annotations:     common.IssueAnnotations(tr, now),
+				OwnerReferences: []metav1.OwnerReference{getOwnerRef(tr)},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{postgresPasswordKey: []byte(password)},
+		}
+		if pending, err = secrets.Create(ctx, pending, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	return r.applyDBPassword(ctx, tr, secret, pending)
+}
+
+// applyDBPassword runs the Job setting the pending password in the database,
+// and hands the password to the workloads once the Job succeeded
+func (r *Reconciler) applyDBPassword(ctx context.Context, tr *v1alpha1.TektonResult, secret, pending *corev1.Secret) error {
+	logger := logging.FromContext(ctx)
+	jobs := r.kubeClientSet.BatchV1().Jobs(tr.Spec.TargetNamespace)
+	background := metav1.DeletePropagationBackground
+
+	job, err := jobs.Get(ctx, dbRotationName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		image, err := r.postgresImage(ctx, tr.Spec.TargetNamespace)
+		if err != nil {
+			return err
+		}
+		if _, err := jobs.Create(ctx, dbRotationJob(tr, image), metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case jobFinished(job, batchv1.JobFailed):
+		// the Job is created again on the next reconciliation
+		if err := jobs.Delete(ctx, dbRotationName, metav1.DeleteOptions{PropagationPolicy: &background}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return fmt.Errorf("failed to change the password of the database, see the logs of job %s", dbRotationName)
+	case !jobFinished(job, batchv1.JobComplete):
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
+	// the database expects the new password, hand it to the workloads
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[postgresPasswordKey] = pending.Data[postgresPasswordKey]
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	for _, key := range []string{common.IssuedAtAnnotation, common.RotationRequestAnnotation} {
+		if value, ok := pending.Annotations[key]; ok {
+			secret.Annotations[key] = value
+		}
+	}
+	secret, err = r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	if err := jobs.Delete(ctx, dbRotationName, metav1.DeleteOptions{PropagationPolicy: &background}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Delete(ctx, dbRotationName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	logger.Infof("Rotated the password of the database in secret %s", DefaultDbSecretName)
+	recordDBRotation(tr, secret)
+	return nil
+}
+
+// postgresImage returns the image of the bundled database, it ships psql
+func (r *Reconciler) postgresImage(ctx context.Context, namespace string) (string, error) {
+	sts, err := r.kubeClientSet.AppsV1().StatefulSets(namespace).Get(ctx, postgresStatefulSet, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	for _, container := range sts.Spec.Template.Spec.Containers {
+		if container.Name == postgresContainer {
+			return container.Image, nil
+		}
+	}
+	return "", fmt.Errorf("container %s not found in statefulset %s", postgresContainer, postgresStatefulSet)
+}
+
+func dbRotationJob(tr *v1alpha1.TektonResult, image string) *batchv1.Job {
+	labels := map[string]string{
+		"app":                    dbRotationName,
+		"app.kubernetes.io/name": dbRotationName,
+		v1alpha1.CreatedByKey:    createdByValue,
+	}
+	port := portOrDefault(tr.Spec.DBPort, defaultDBPort)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            dbRotationName,
+			Namespace:       tr.Spec.TargetNamespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{getOwnerRef(tr)},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.Int32(dbRotationBackoffLimit),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    "rotate-password",
+						Image:   image,
+						Command: []string{"/bin/sh", "-c", dbRotationScript},
+						Env: []corev1.EnvVar{
+							{Name: "PGHOST", Value: fmt.Sprintf("%s.%s.svc.cluster.local", servicePostgresDB, tr.Spec.TargetNamespace)},
+							{Name: "PGPORT", Value: fmt.Sprint(port)},
+							{Name: "PGDATABASE", Value: "postgres"},
+							secretEnv("PGUSER", DefaultDbSecretName, postgresUserKey, false),
+							secretEnv("PGPASSWORD", DefaultDbSecretName, postgresPasswordKey, false),
+							secretEnv(dbRotationPasswordEnv, dbRotationName, postgresPasswordKey, false),
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.Bool(false),
+							RunAsNonRoot:             ptr.Bool(true),
+							Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+							SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func jobFinished(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestTLSRotationDue(t *testing.T) {
+	certPEM, keyPEM, err := generateTLSCertificate("tekton-pipelines", defaultTLSValidity)
+	assert.NilError(t, err)
+	secret := &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM}}
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	now := time.Now()
+
+	// renewed a third of the validity before it expires
+	assert.Assert(t, !tlsRotationDue(tr, secret, now))
+	assert.Assert(t, !tlsRotationDue(tr, secret, now.Add(240*24*time.Hour)))
+	assert.Assert(t, tlsRotationDue(tr, secret, now.Add(245*24*time.Hour)))
+
+	// a shorter validity of the policy applies to the existing certificate
+	tr.Spec.Rotation.TLS = v1alpha1.RotationPolicy{
+		Validity:    &metav1.Duration{Duration: 720 * time.Hour},
+		RenewBefore: &metav1.Duration{Duration: 24 * time.Hour},
+	}
+	assert.Assert(t, !tlsRotationDue(tr, secret, now.Add(690*time.Hour)))
+	assert.Assert(t, tlsRotationDue(tr, secret, now.Add(700*time.Hour)))
+
+	// a rotation is requested once for every value of the annotation
+	tr.Annotations = map[string]string{v1alpha1.RotateAnnotation: "1"}
+	assert.Assert(t, tlsRotationDue(tr, secret, now))
+	secret.Annotations = map[string]string{common.RotationRequestAnnotation: "1"}
+	assert.Assert(t, !tlsRotationDue(tr, secret, now))
+}
+
+func TestCreateTLSSecret_Rotation(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset()
+	r := newTestReconciler(kube)
+
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	tr.Spec.Rotation.TLS.Validity = &metav1.Duration{Duration: 720 * time.Hour}
+	assert.NilError(t, r.createTLSSecret(ctx, tr))
+	secret, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	cert, err := parseCertificate(secret)
+	assert.NilError(t, err)
+	assert.Equal(t, cert.NotAfter.Sub(cert.NotBefore), 720*time.Hour)
+	assert.Equal(t, len(tr.Status.Rotations), 1)
+	assert.Equal(t, tr.Status.Rotations[0].Name, TlsSecretName)
+	assert.Equal(t, tr.Status.Rotations[0].NextRotationTime.Time, cert.NotBefore.Add(480*time.Hour))
+
+	// the certificate is reissued on request
+	tr.Annotations = map[string]string{v1alpha1.RotateAnnotation: "now"}
+	assert.NilError(t, r.createTLSSecret(ctx, tr))
+	rotated, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, string(rotated.Data[corev1.TLSCertKey]) != string(secret.Data[corev1.TLSCertKey]))
+	assert.Equal(t, rotated.Annotations[common.RotationRequestAnnotation], "now")
+
+	// and only once
+	assert.NilError(t, r.createTLSSecret(ctx, tr))
+	same, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, same.Data, rotated.Data)
+}
+
+func dbRotationObjects() []runtime.Object {
+	return []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              DefaultDbSecretName,
+				Namespace:         "tekton-pipelines",
+				CreationTimestamp: metav1.Now(),
+			},
+			Data: map[string][]byte{postgresUserKey: []byte(PostgresUser), postgresPasswordKey: []byte("old")},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: postgresStatefulSet, Namespace: "tekton-pipelines"},
+			Spec: appsv1.StatefulSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: postgresContainer, Image: "postgres:15"}},
+			}}},
+		},
+	}
+}
+
+func completeJob(t *testing.T, kube *fake.Clientset, conditionType batchv1.JobConditionType) {
+	t.Helper()
+	ctx := context.Background()
+	job, err := kube.BatchV1().Jobs("tekton-pipelines").Get(ctx, dbRotationName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	job.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}}
+	_, err = kube.BatchV1().Jobs("tekton-pipelines").UpdateStatus(ctx, job, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+}
+
+func TestRotateDBPassword(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset(dbRotationObjects()...)
+	r := newTestReconciler(kube)
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+
+	// nothing to rotate without validity nor request
+	assert.NilError(t, r.rotateDBPassword(ctx, tr))
+	assert.Equal(t, len(tr.Status.Rotations), 1)
+	assert.Assert(t, tr.Status.Rotations[0].NextRotationTime == nil)
+	_, err := kube.BatchV1().Jobs("tekton-pipelines").Get(ctx, dbRotationName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	// the Job sets the new password in the database first
+	tr.Annotations = map[string]string{v1alpha1.RotateAnnotation: "1"}
+	assert.Equal(t, r.rotateDBPassword(ctx, tr), v1alpha1.REQUEUE_EVENT_AFTER)
+	job, err := kube.BatchV1().Jobs("tekton-pipelines").Get(ctx, dbRotationName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, job.Spec.Template.Spec.Containers[0].Image, "postgres:15")
+	pending, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, dbRotationName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, r.rotateDBPassword(ctx, tr), v1alpha1.REQUEUE_EVENT_AFTER)
+
+	// then the workloads get it
+	completeJob(t, kube, batchv1.JobComplete)
+	assert.NilError(t, r.rotateDBPassword(ctx, tr))
+	secret, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, DefaultDbSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, secret.Data[postgresPasswordKey], pending.Data[postgresPasswordKey])
+	assert.Equal(t, secret.Annotations[common.RotationRequestAnnotation], "1")
+	assert.Equal(t, tr.Status.Rotations[0].LastRotationTime.Time, common.IssuedAt(pending))
+	_, err = kube.BatchV1().Jobs("tekton-pipelines").Get(ctx, dbRotationName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	_, err = kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, dbRotationName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	// the request is handled
+	assert.NilError(t, r.rotateDBPassword(ctx, tr))
+}
+
+func TestRotateDBPassword_JobFailed(t *testing.T) {
+	ctx := context.Background()
+	kube := fake.NewSimpleClientset(dbRotationObjects()...)
+	r := newTestReconciler(kube)
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	tr.Spec.Rotation.Database.Validity = &metav1.Duration{Duration: time.Hour}
+	// issued long ago, the rotation is due
+	secret, err := kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, DefaultDbSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	common.MarkIssued(secret, tr, time.Now().Add(-2*time.Hour))
+	_, err = kube.CoreV1().Secrets("tekton-pipelines").Update(ctx, secret, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+
+	assert.Equal(t, r.rotateDBPassword(ctx, tr), v1alpha1.REQUEUE_EVENT_AFTER)
+	completeJob(t, kube, batchv1.JobFailed)
+	assert.ErrorContains(t, r.rotateDBPassword(ctx, tr), "failed to change the password of the database")
+
+	// the password in use is kept until the database accepts the new one
+	secret, err = kube.CoreV1().Secrets("tekton-pipelines").Get(ctx, DefaultDbSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, secret.Data[postgresPasswordKey], []byte("old"))
+	_, err = kube.BatchV1().Jobs("tekton-pipelines").Get(ctx, dbRotationName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func TestRotatedAtAnnotation(t *testing.T) {
+	tr := resultWithRoute(v1alpha1.ResultsAPIProperties{})
+	assert.Equal(t, apiRotatedAt(tr), "")
+
+	tls := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	db := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	tr.Status.Rotations = []v1alpha1.RotationStatus{
+		common.NewRotationStatus(TlsSecretName, tls, time.Time{}),
+		common.NewRotationStatus(DefaultDbSecretName, db, time.Time{}),
+	}
+	assert.Equal(t, apiRotatedAt(tr), "2026-02-01T00:00:00Z")
+	assert.Equal(t, databaseRotatedAt(tr), "2026-02-01T00:00:00Z")
+
+	tr.Status.Rotations = tr.Status.Rotations[:1]
+	assert.Equal(t, apiRotatedAt(tr), "2026-01-01T00:00:00Z")
+	assert.Equal(t, databaseRotatedAt(tr), "")
+}
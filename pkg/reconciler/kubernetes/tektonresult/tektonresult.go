@@ -28,6 +28,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
@@ -59,6 +60,9 @@ const (
 	ECPrivateKeyBlockType        = "EC PRIVATE KEY"
 	tektonResultStatefulSetLabel = "statefulset"
 	tektonResultDeploymentLabel  = "deployment"
+	// certManagerCertificateAnnotation names the Certificate a secret was
+	// issued for by cert-manager
+	certManagerCertificateAnnotation = "cert-manager.io/certificate-name"
 )
 
 // Reconciler implements controller.Reconciler for TektonResult resources.
@@ -103,6 +107,7 @@ const createdByValue = "TektonResult"
 // FinalizeKind removes all resources after deletion of a TektonResult.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonResult) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
+	common.ForgetEvents(original)
 
 	labelSelector, err := common.LabelSelector(ls)
 	if err != nil {
@@ -121,6 +126,16 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.Tekton
 		return err
 	}
 
+	if err := r.installerSetClient.CleanupCustomSet(ctx, ingressSetName); err != nil {
+		logger.Error("failed to cleanup results ingress installerset: ", err)
+		return err
+	}
+
+	if err := r.installerSetClient.CleanupCustomSet(ctx, certificateSetName); err != nil {
+		logger.Error("failed to cleanup results certificate installerset: ", err)
+		return err
+	}
+
 	if err := r.extension.Finalize(ctx, original); err != nil {
 		logger.Error("Failed to finalize platform resources", err)
 	}
@@ -150,9 +165,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 		msg := fmt.Sprintf("Resource ignored, Expected Name: %s, Got Name: %s",
 			v1alpha1.ResultResourceName, tr.GetName())
 		tr.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, tr, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, tr, &tr.Status); err != nil {
+		return err
+	}
+
 	// find the valid tekton-pipeline installation
 	tp, err := common.PipelineReady(r.pipelineInformer)
 	if err != nil {
@@ -192,6 +212,8 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 		}
 		logger.Infow("Successfully created database and TLS secrets")
 	} else {
+		// the operator only manages the password of the bundled database
+		tr.Status.Rotations = v1alpha1.RemoveRotation(tr.Status.Rotations, DefaultDbSecretName)
 		customDbSecretName := DefaultDbSecretName
 		if tr.Spec.DBSecretName != "" {
 			customDbSecretName = tr.Spec.DBSecretName
@@ -339,12 +361,17 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 		// TektonInstallerSet with computing new hash of TektonResult Spec
 		logger.Debug("Checking for spec changes in TektonResult")
 		// Hash of TektonResult Spec including platform-specific data (e.g., TLS config)
+		// and the rotation of the generated material, which rolls the workloads
 		hashInput := struct {
-			Spec      v1alpha1.TektonResultSpec
-			ExtraData string
+			Spec              v1alpha1.TektonResultSpec
+			ExtraData         string
+			APIRotatedAt      string
+			DatabaseRotatedAt string
 		}{
-			Spec:      tr.Spec,
-			ExtraData: tr.Annotations[v1alpha1.PlatformDataHashKey],
+			Spec:              tr.Spec,
+			ExtraData:         tr.Annotations[v1alpha1.PlatformDataHashKey],
+			APIRotatedAt:      apiRotatedAt(tr),
+			DatabaseRotatedAt: databaseRotatedAt(tr),
 		}
 		expectedSpecHash, err := hash.Compute(hashInput)
 		if err != nil {
@@ -423,6 +450,38 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 		return nil
 	}
 
+	if err := r.reconcileIngress(ctx, tr); err != nil {
+		if err == v1alpha1.REQUEUE_EVENT_AFTER {
+			return err
+		}
+		msg := fmt.Sprintf("Ingress reconciliation failed: %s", err.Error())
+		logger.Errorw("Ingress reconciliation failed", "error", err)
+		tr.Status.MarkInstallerSetNotReady(msg)
+		return nil
+	}
+
+	if !tr.Spec.IsExternalDB && tr.Spec.DBSecretName == "" {
+		if err := r.rotateDBPassword(ctx, tr); err != nil {
+			if err == v1alpha1.REQUEUE_EVENT_AFTER {
+				logger.Info("Waiting for the rotation of the database password")
+				return err
+			}
+			msg := fmt.Sprintf("Database password rotation failed: %s", err.Error())
+			logger.Errorw("Database password rotation failed", "error", err)
+			tr.Status.MarkInstallerSetNotReady(msg)
+			return nil
+		}
+	}
+
+	now := time.Now()
+	nextBackupCheck, err := r.reconcileBackup(ctx, tr, now)
+	if err != nil {
+		msg := fmt.Sprintf("Database backup reconciliation failed: %s", err.Error())
+		logger.Errorw("Database backup reconciliation failed", "error", err)
+		tr.Status.MarkInstallerSetNotReady(msg)
+		return nil
+	}
+
 	if err := r.extension.PostReconcile(ctx, tr); err != nil {
 		if err == v1alpha1.REQUEUE_EVENT_AFTER {
 			logger.Infow("PostReconciliation requested requeue")
@@ -443,7 +502,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 		"ready", tr.Status.GetCondition(apis.ConditionReady).IsTrue(),
 		"generation", tr.Status.ObservedGeneration)
 
-	return nil
+	// reconcile again when the generated material is due for rotation, or
+	// to report the next backup
+	return common.RequeueAt(now, append(common.NextRotations(tr.Status.Rotations), nextBackupCheck)...)
 }
 
 func (r *Reconciler) updateTektonResultsStatus(ctx context.Context, tr *v1alpha1.TektonResult, createdIs *v1alpha1.TektonInstallerSet) {
@@ -493,8 +554,9 @@ func (r *Reconciler) createDBSecret(ctx context.Context, tr *v1alpha1.TektonResu
 	logger := logging.FromContext(ctx)
 
 	// Get the DB secret, if not found then create the DB secret
-	_, err := r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Get(ctx, DefaultDbSecretName, metav1.GetOptions{})
+	secret, err := r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Get(ctx, DefaultDbSecretName, metav1.GetOptions{})
 	if err == nil {
+		recordDBRotation(tr, secret)
 		return nil
 	}
 	if !apierrors.IsNotFound(err) {
@@ -506,12 +568,14 @@ func (r *Reconciler) createDBSecret(ctx context.Context, tr *v1alpha1.TektonResu
 		logger.Errorf("failed to generate default TektonResult database secret %s: %s", DefaultDbSecretName, err)
 		return err
 	}
+	common.MarkIssued(newDBSecret, tr, time.Now())
 	_, err = r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Create(ctx, newDBSecret, metav1.CreateOptions{})
 	if err != nil {
 		logger.Errorf("failed to create default TektonResult database secret %s in namespace %s: %v", DefaultDbSecretName, tr.Spec.TargetNamespace, err)
 		tr.Status.MarkDependencyMissing(fmt.Sprintf("Default db %s creation is failing", DefaultDbSecretName))
 		return err
 	}
+	recordDBRotation(tr, newDBSecret)
 	return nil
 }
 
@@ -521,18 +585,59 @@ func (r *Reconciler) createTLSSecret(ctx context.Context, tr *v1alpha1.TektonRes
 
 	if v1alpha1.IsOpenShiftPlatform() {
 		logger.Info("Skipping default TLS secret creation: running on OpenShift platform")
+		tr.Status.Rotations = v1alpha1.RemoveRotation(tr.Status.Rotations, TlsSecretName)
 		return nil
 	}
 
-	_, err := r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Get(ctx, TlsSecretName, metav1.GetOptions{})
+	if tr.Spec.TLS.IsCertManager() {
+		// cert-manager renews the certificate following the rotation policy
+		tr.Status.Rotations = v1alpha1.RemoveRotation(tr.Status.Rotations, TlsSecretName)
+		return r.reconcileCertificate(ctx, tr)
+	}
+	if err := r.installerSetClient.CleanupCustomSet(ctx, certificateSetName); err != nil {
+		return err
+	}
+
+	// the certificate is also served for the host of the Ingress or the route
+	hosts := routeHosts(tr)
+
+	secret, err := r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Get(ctx, TlsSecretName, metav1.GetOptions{})
 	if err == nil {
-		return nil
+		switch {
+		case isIssuedByCertManager(secret):
+			// cert-manager no longer renews it once the selfSigned provider is selected
+		case !isGeneratedTLSSecret(secret, tr.Spec.TargetNamespace):
+			tr.Status.Rotations = v1alpha1.RemoveRotation(tr.Status.Rotations, TlsSecretName)
+			return nil
+		case certificateCovers(secret, hosts) && !tlsRotationDue(tr, secret, time.Now()):
+			return recordTLSRotation(tr, secret)
+		}
+		logger.Infof("Regenerating TLS secret %s for hosts %v", TlsSecretName, hosts)
+		certPEM, keyPEM, err := generateTLSCertificate(tr.Spec.TargetNamespace, tlsValidity(tr), hosts...)
+		if err != nil {
+			logger.Errorf("failed to generate default TektonResult TLS certificate: %v", err)
+			return err
+		}
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[v1alpha1.CreatedByKey] = createdByValue
+		delete(secret.Annotations, certManagerCertificateAnnotation)
+		common.MarkIssued(secret, tr, time.Now())
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		}
+		if _, err = r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		return recordTLSRotation(tr, secret)
 	}
 	if !apierrors.IsNotFound(err) {
 		logger.Errorf("failed to find default TektonResult TLS secret %s in namespace %s: %v", TlsSecretName, tr.Spec.TargetNamespace, err)
 		return err
 	}
-	certPEM, keyPEM, err := generateTLSCertificate(tr.Spec.TargetNamespace)
+	certPEM, keyPEM, err := generateTLSCertificate(tr.Spec.TargetNamespace, tlsValidity(tr), hosts...)
 	if err != nil {
 		logger.Errorf("failed to generate default TektonResult TLS certificate: %v", err)
 		return err
@@ -546,6 +651,54 @@ func (r *Reconciler) createTLSSecret(ctx context.Context, tr *v1alpha1.TektonRes
 	return nil
 }
 
+// isGeneratedTLSSecret tells whether the operator generated the TLS secret,
+// a secret provided by the user is never replaced. Secrets generated by
+// earlier releases have no label but carry the self-signed certificate of
+// the API service.
+func isGeneratedTLSSecret(secret *corev1.Secret, targetNS string) bool {
+	if secret.Labels[v1alpha1.CreatedByKey] == createdByValue {
+		return true
+	}
+	cert, err := parseCertificate(secret)
+	if err != nil {
+		return false
+	}
+	dnsName := apiServiceHostname(targetNS)
+	return cert.Subject.CommonName == dnsName && cert.Issuer.CommonName == dnsName
+}
+
+// isIssuedByCertManager tells whether cert-manager issued the TLS secret for
+// the Certificate of the certManager TLS provider
+func isIssuedByCertManager(secret *corev1.Secret) bool {
+	return secret.Annotations[certManagerCertificateAnnotation] == apiCertificateName
+}
+
+// certificateCovers tells whether the certificate of the secret is issued
+// for all the hosts
+func certificateCovers(secret *corev1.Secret, hosts []string) bool {
+	if len(hosts) == 0 {
+		return true
+	}
+	cert, err := parseCertificate(secret)
+	if err != nil {
+		return false
+	}
+	for _, host := range hosts {
+		if !slices.Contains(cert.DNSNames, host) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseCertificate(secret *corev1.Secret) (*x509.Certificate, error) {
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil || block.Type != CertificateBlockType {
+		return nil, fmt.Errorf("no certificate in secret %s", secret.Name)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
 // Get an owner reference of Tekton Result
 func getOwnerRef(tr *v1alpha1.TektonResult) metav1.OwnerReference {
 	return *metav1.NewControllerRef(tr, tr.GroupVersionKind())
@@ -565,11 +718,12 @@ func generateRandomBaseString(size int) (string, error) {
 	return base64String, nil
 }
 
-// generateTLSCertificate generates a self-signed TLS certificate and private key.
-func generateTLSCertificate(targetNS string) (certPEM, keyPEM []byte, err error) {
+// generateTLSCertificate generates a self-signed TLS certificate and private key
+// valid for validity, for the API service and the additional hosts.
+func generateTLSCertificate(targetNS string, validity time.Duration, hosts ...string) (certPEM, keyPEM []byte, err error) {
 
 	// Define subject and DNS names
-	dnsName := fmt.Sprintf("tekton-results-api-service.%s.svc.cluster.local", targetNS)
+	dnsName := apiServiceHostname(targetNS)
 
 	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
@@ -577,7 +731,7 @@ func generateTLSCertificate(targetNS string) (certPEM, keyPEM []byte, err error)
 	}
 
 	notBefore := time.Now()
-	notAfter := notBefore.Add(365 * 24 * time.Hour)
+	notAfter := notBefore.Add(validity)
 
 	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
 	if err != nil {
@@ -590,7 +744,7 @@ func generateTLSCertificate(targetNS string) (certPEM, keyPEM []byte, err error)
 		Subject: pkix.Name{
 			CommonName: dnsName,
 		},
-		DNSNames:              []string{dnsName},
+		DNSNames:              append([]string{dnsName}, hosts...),
 		NotBefore:             notBefore,
 		NotAfter:              notAfter,
 		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
@@ -621,8 +775,10 @@ func (r *Reconciler) createKubernetesTLSSecret(ctx context.Context, namespace, s
 	logger := logging.FromContext(ctx)
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: namespace,
+			Name:        secretName,
+			Namespace:   namespace,
+			Labels:      map[string]string{v1alpha1.CreatedByKey: createdByValue},
+			Annotations: common.IssueAnnotations(tr, time.Now()),
 		},
 		Type: corev1.SecretTypeTLS,
 		Data: map[string][]byte{
@@ -639,5 +795,5 @@ func (r *Reconciler) createKubernetesTLSSecret(ctx context.Context, namespace, s
 	}
 
 	logger.Infof("Secret '%s' created successfully in namespace '%s'\n", secretName, namespace)
-	return nil
+	return recordTLSRotation(tr, secret)
 }
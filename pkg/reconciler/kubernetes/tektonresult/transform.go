@@ -119,6 +119,9 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp
 		common.AddConfigMapValues(tektonResultleaderElectionConfig, instance.Spec.Performance.PerformanceLeaderElectionConfig),
 		common.UpdatePerformanceFlagsInDeploymentAndLeaderConfigMap(&instance.Spec.Performance, tektonResultleaderElectionConfig, resultWatcherDeployment, resultWatcherContainer),
 		updateWatcherFlagsInDeployment(&instance.Spec.Watcher, resultWatcherDeployment, resultWatcherContainer),
+		updateRetentionPolicyConfig(instance.Spec.RetentionPolicy),
+		common.AddRotatedAtAnnotation(apiRotatedAt(instance), resultAPIDeployment),
+		common.AddRotatedAtAnnotation(databaseRotatedAt(instance), retentionAgentName, postgresStatefulSet),
 		// Note: PostgreSQL upgrade transformer is NOT needed for Kubernetes
 	}
 
@@ -65,7 +65,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 		}
 
 		tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
-		metrics, _ := NewRecorder()
+		metrics, _ := common.NewRecorder(v1alpha1.KindTektonScheduler)
 		c := &Reconciler{
 			operatorClientSet:      operatorclient.Get(ctx),
 			kubeClientSet:          kubeclient.Get(ctx),
@@ -78,6 +78,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			operatorVersion:        operatorVer,
 		}
 		impl := tektonschedulerreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonScheduler, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for TektonScheduler")
 
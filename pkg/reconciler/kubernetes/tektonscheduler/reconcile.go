@@ -74,9 +74,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, TektonScheduler *v1alpha
 		)
 		logger.Error(msg)
 		TektonScheduler.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, TektonScheduler, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, TektonScheduler, &TektonScheduler.Status); err != nil {
+		return err
+	}
+
 	// reconcile target namespace
 	if err := common.ReconcileTargetNamespace(ctx, nil, nil, TektonScheduler, r.kubeClientSet); err != nil {
 		return err
@@ -57,7 +57,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		manifest, triggersVer := ctrl.InitController(ctx, common.PayloadOptions{})
 
-		metrics, _ := common.NoMetrics()
+		metrics, _ := common.NewRecorder(v1alpha1.KindTektonTrigger)
 
 		operatorVer, err := common.OperatorVersion(ctx)
 		if err != nil {
@@ -73,6 +73,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		c := &Reconciler{
 			kubeClientSet:      kubeclient.Get(ctx),
+			operatorClientSet:  operatorclient.Get(ctx),
 			pipelineInformer:   tektonPipelineinformer.Get(ctx),
 			installerSetClient: client.NewInstallerSetClient(tisClient, operatorVer, triggersVer, v1alpha1.KindTektonTrigger, metrics),
 			extension:          generator(ctx),
@@ -81,6 +82,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			platformParams:     params,
 		}
 		impl := tektonTriggerreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonTrigger, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for TektonTrigger")
 
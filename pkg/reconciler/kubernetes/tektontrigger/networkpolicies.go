@@ -119,19 +119,24 @@ func (r *Reconciler) reconcileNetworkPolicies(ctx context.Context, tt *v1alpha1.
 	if tt.Spec.NetworkPolicy.Disabled {
 		return r.installerSetClient.CleanupCustomSet(ctx, "triggers-network-policies")
 	}
+	manifest, err := networkPolicies(tt, r.platformParams)
+	if err != nil {
+		return err
+	}
+	return r.installerSetClient.CustomSet(ctx, tt, "triggers-network-policies", &manifest, passthroughTransform, nil)
+}
+
+// networkPolicies generates the NetworkPolicy manifest for the given TektonTrigger
+func networkPolicies(tt *v1alpha1.TektonTrigger, params networkpolicy.PlatformParams) (mf.Manifest, error) {
 	defaults := append(
 		[]networkingv1.NetworkPolicy{defaultDenyPolicy()},
-		triggersDefaultPolicies(r.platformParams)...,
+		triggersDefaultPolicies(params)...,
 	)
-	manifest, err := networkpolicy.Generate(
+	return networkpolicy.Generate(
 		tt.Spec.NetworkPolicy,
 		tt.Spec.GetTargetNamespace(),
 		defaults,
 	)
-	if err != nil {
-		return err
-	}
-	return r.installerSetClient.CustomSet(ctx, tt, "triggers-network-policies", &manifest, passthroughTransform, nil)
 }
 
 // passthroughTransform is a no-op FilterAndTransform used for pre-built manifests
@@ -22,6 +22,7 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	pipelineinformer "github.com/tektoncd/operator/pkg/client/informers/externalversions/operator/v1alpha1"
 	tektontriggerreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektontrigger"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
@@ -39,6 +40,8 @@ import (
 type Reconciler struct {
 	// kube client to interact with core k8s resources
 	kubeClientSet kubernetes.Interface
+	// operator client to interact with the operator resources
+	operatorClientSet clientset.Interface
 	// installer Set client to do CRUD operations for components
 	installerSetClient *client.InstallerSetClient
 	// pipelineInformer to query for TektonPipeline
@@ -76,9 +79,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tt *v1alpha1.TektonTrigg
 			v1alpha1.TriggerResourceName,
 			tt.GetName())
 		tt.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, tt, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, tt, &tt.Status); err != nil {
+		return err
+	}
+
 	if err := r.targetNamespaceCheck(ctx, tt); err != nil {
 		logger.Errorw("Target namespace check failed", "error", err)
 		return err
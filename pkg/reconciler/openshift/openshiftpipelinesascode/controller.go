@@ -61,7 +61,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
 
-		metrics, err := common.NoMetrics()
+		metrics, err := common.NewRecorder(v1alpha1.KindOpenShiftPipelinesAsCode)
 		if err != nil {
 			logger.Errorf("Failed to create trigger metrics recorder %v", err)
 		}
@@ -72,6 +72,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 		}
 
 		c := &Reconciler{
+			operatorClientSet:     operatorclient.Get(ctx),
 			pipelineInformer:      tektonPipelineinformer.Get(ctx),
 			installerSetClient:    client.NewInstallerSetClient(tisClient, operatorVer, pacVersion, v1alpha1.KindOpenShiftPipelinesAsCode, metrics),
 			extension:             generator(ctx),
@@ -81,12 +82,20 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			platformParams:        params,
 		}
 		impl := pacreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindOpenShiftPipelinesAsCode, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for OpenShiftPipelinesAsCode")
 
 		if _, err := pacInformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
 			logger.Panicf("Couldn't register OpenShiftPipelinesAsCode informer event handler: %w", err)
 		}
+		// OpenShiftPipelinesAsCode has no finalizer, its events are forgotten
+		// when the informer sees it deleted
+		if _, err := pacInformer.Get(ctx).Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			DeleteFunc: common.ForgetEventsOnDelete,
+		}); err != nil {
+			logger.Panicf("Couldn't register OpenShiftPipelinesAsCode informer event handler: %w", err)
+		}
 
 		if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.OpenShiftPipelinesAsCode{}),
@@ -23,6 +23,7 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	pipelineinformer "github.com/tektoncd/operator/pkg/client/informers/externalversions/operator/v1alpha1"
 	pacreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/openshiftpipelinesascode"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
@@ -41,6 +42,8 @@ const (
 
 // Reconciler implements controller.Reconciler for OpenShiftPipelinesAsCode resources.
 type Reconciler struct {
+	// operator client to interact with the operator resources
+	operatorClientSet clientset.Interface
 	// installer Set client to do CRUD operations for components
 	installerSetClient *client.InstallerSetClient
 	// pipelineInformer to query for TektonPipeline
@@ -75,9 +78,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, pac *v1alpha1.OpenShiftP
 		)
 		logger.Error(msg)
 		pac.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, pac, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, pac, &pac.Status); err != nil {
+		return err
+	}
+
 	//Make sure TektonPipeline is installed before proceeding with OpenShiftPipelinesAsCode
 	if _, err := common.PipelineReady(r.pipelineInformer); err != nil {
 		if err.Error() == common.PipelineNotReady || err == v1alpha1.DEPENDENCY_UPGRADE_PENDING_ERR {
@@ -70,6 +70,7 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 		syncerVersion:      syncerVer,
 	}
 	impl := syncerServiceReconciler.NewImpl(ctx, c)
+	impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindSyncerService, impl.Reconciler)
 
 	logger.Debug("Setting up event handlers for syncer-service")
 
@@ -32,6 +32,7 @@ var _ syncerservicereconciler.Finalizer = (*Reconciler)(nil)
 // FinalizeKind removes all resources after deletion of a SyncerService.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.SyncerService) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
+	common.ForgetEvents(original)
 
 	labelSelector, err := common.LabelSelector(ls)
 	if err != nil {
@@ -66,9 +66,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ss *v1alpha1.SyncerServi
 		msg := fmt.Sprintf("Resource ignored, Expected Name: %s, Got Name: %s",
 			v1alpha1.SyncerServiceResourceName, ss.GetName())
 		ss.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, ss, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, ss, &ss.Status); err != nil {
+		return err
+	}
+
 	// Check for TektonPipeline dependency
 	tp, err := common.PipelineReady(r.pipelineInformer)
 	if err != nil {
@@ -76,7 +76,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 		}
 
 		tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
-		metrics, _ := NewRecorder()
+		metrics, _ := common.NewRecorder(v1alpha1.KindTektonAddon)
 
 		resolverTaskManifest := &mf.Manifest{}
 		if err := applyAddons(resolverTaskManifest, "06-ecosystem/tasks"); err != nil {
@@ -138,6 +138,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			communityResolverTaskManifest: communityResolverTaskManifest,
 		}
 		impl := tektonAddonreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonAddon, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for TektonAddon")
 
@@ -75,6 +75,7 @@ var _ tektonaddonreconciler.Finalizer = (*Reconciler)(nil)
 // FinalizeKind removes all resources after deletion of a TektonTriggers.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonAddon) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
+	common.ForgetEvents(original)
 	if err := r.installerSetClient.CleanupAllCustomSet(ctx); err != nil {
 		logger.Errorf("failed to cleanup custom set: %v", err)
 		return err
@@ -96,9 +97,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ta *v1alpha1.TektonAddon
 		)
 		logger.Error(msg)
 		ta.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, ta, msg)
 		return nil
 	}
 
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, ta, &ta.Status); err != nil {
+		return err
+	}
+
 	// Pass the object through defaulting
 	ta.SetDefaults(ctx)
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	openshiftpipelinesascodeinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/openshiftpipelinesascode"
+	syncerServiceinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/syncerservice"
 	tektonAddoninformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonaddon"
 	occommon "github.com/tektoncd/operator/pkg/reconciler/openshift/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig"
@@ -37,7 +38,11 @@ import (
 // Registers eventhandlers to enqueue events
 func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	logger := logging.FromContext(ctx)
-	ctrl := tektonconfig.NewExtensibleController(OpenShiftExtension)(ctx, cmw)
+	ctrl := tektonconfig.NewExtensibleController(OpenShiftExtension, map[string]tektonconfig.ComponentLister{
+		v1alpha1.KindTektonAddon:              tektonconfig.NewComponentLister(tektonAddoninformer.Get(ctx).Lister().Get, v1alpha1.AddonResourceName),
+		v1alpha1.KindOpenShiftPipelinesAsCode: tektonconfig.NewComponentLister(openshiftpipelinesascodeinformer.Get(ctx).Lister().Get, v1alpha1.OpenShiftPipelinesAsCodeName),
+		v1alpha1.KindSyncerService:            tektonconfig.NewComponentLister(syncerServiceinformer.Get(ctx).Lister().Get, v1alpha1.SyncerServiceResourceName),
+	})(ctx, cmw)
 	if _, err := tektonAddoninformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
 		Handler:    controller.HandleAll(ctrl.EnqueueControllerOf),
@@ -70,6 +70,13 @@ func OpenShiftExtension(ctx context.Context) common.Extension {
 	return ext
 }
 
+// OfflineExtension returns the extension without any cluster clients, so
+// only the transformers that don't depend on cluster state are applied.
+// It must not be used by the reconciler.
+func OfflineExtension() common.Extension {
+	return &openshiftExtension{}
+}
+
 type openshiftExtension struct {
 	installerSetClient *client.InstallerSetClient
 	kubeClientSet      kubernetes.Interface
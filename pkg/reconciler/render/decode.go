@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/yaml"
+)
+
+// Decode parses a v1alpha1 or v1beta1 custom resource supported by Render,
+// v1beta1 resources are converted to v1alpha1
+func Decode(ctx context.Context, data []byte) (v1alpha1.TektonComponent, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := yaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, err
+	}
+
+	comp := newComponent(typeMeta.Kind)
+	if comp == nil {
+		return nil, fmt.Errorf("unsupported kind %q", typeMeta.Kind)
+	}
+
+	switch typeMeta.APIVersion {
+	case v1alpha1.SchemeGroupVersion.String():
+		if err := yaml.UnmarshalStrict(data, comp); err != nil {
+			return nil, err
+		}
+	case v1beta1.SchemeGroupVersion.String():
+		source := newV1beta1Component(typeMeta.Kind)
+		if err := yaml.UnmarshalStrict(data, source); err != nil {
+			return nil, err
+		}
+		if err := source.ConvertTo(ctx, comp.(apis.Convertible)); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported apiVersion %q", typeMeta.APIVersion)
+	}
+	return comp, nil
+}
+
+func newComponent(kind string) v1alpha1.TektonComponent {
+	switch kind {
+	case v1alpha1.KindTektonConfig:
+		return &v1alpha1.TektonConfig{}
+	case v1alpha1.KindTektonPipeline:
+		return &v1alpha1.TektonPipeline{}
+	case v1alpha1.KindTektonTrigger:
+		return &v1alpha1.TektonTrigger{}
+	case v1alpha1.KindTektonChain:
+		return &v1alpha1.TektonChain{}
+	case v1alpha1.KindTektonResult:
+		return &v1alpha1.TektonResult{}
+	case v1alpha1.KindTektonDashboard:
+		return &v1alpha1.TektonDashboard{}
+	}
+	return nil
+}
+
+func newV1beta1Component(kind string) apis.Convertible {
+	switch kind {
+	case v1alpha1.KindTektonConfig:
+		return &v1beta1.TektonConfig{}
+	case v1alpha1.KindTektonPipeline:
+		return &v1beta1.TektonPipeline{}
+	case v1alpha1.KindTektonTrigger:
+		return &v1beta1.TektonTrigger{}
+	case v1alpha1.KindTektonChain:
+		return &v1beta1.TektonChain{}
+	case v1alpha1.KindTektonResult:
+		return &v1beta1.TektonResult{}
+	case v1alpha1.KindTektonDashboard:
+		return &v1beta1.TektonDashboard{}
+	}
+	return nil
+}
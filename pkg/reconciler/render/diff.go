@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Change is the kind of change of a resource between two manifests
+type Change string
+
+const (
+	ChangeAdded    Change = "added"
+	ChangeRemoved  Change = "removed"
+	ChangeModified Change = "modified"
+
+	redactedValue = "<redacted>"
+)
+
+// derivedFields are computed from the rest of the resource by the
+// transformers, they change along with it and are left out of the diff
+var derivedFields = map[string]bool{
+	joinPath("spec.template.metadata.labels", v1alpha1.DeploymentSpecHashValueLabelKey): true,
+}
+
+// ResourceDiff describes the change of a resource between two manifests
+type ResourceDiff struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Namespace  string      `json:"namespace,omitempty"`
+	Name       string      `json:"name"`
+	Change     Change      `json:"change"`
+	Fields     []FieldDiff `json:"fields,omitempty"`
+}
+
+// FieldDiff describes the change of a field of a modified resource, a nil
+// Old or New value means the field is not set
+type FieldDiff struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// Diff compares two rendered manifests resource by resource. Resources are
+// matched by group, kind, namespace and name, and returned sorted by kind,
+// namespace and name. The values of Secret data are redacted.
+func Diff(oldManifest, newManifest mf.Manifest) []ResourceDiff {
+	oldResources := indexResources(oldManifest)
+	newResources := indexResources(newManifest)
+
+	diffs := []ResourceDiff{}
+	for key, old := range oldResources {
+		current, ok := newResources[key]
+		if !ok {
+			diffs = append(diffs, newResourceDiff(old, ChangeRemoved, nil))
+			continue
+		}
+		fields := []FieldDiff{}
+		compareValues("", old.Object, current.Object, &fields)
+		if len(fields) != 0 {
+			if current.GetKind() == "Secret" {
+				redactSecretFields(fields)
+			}
+			diffs = append(diffs, newResourceDiff(current, ChangeModified, fields))
+		}
+	}
+	for key, current := range newResources {
+		if _, ok := oldResources[key]; !ok {
+			diffs = append(diffs, newResourceDiff(current, ChangeAdded, nil))
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Kind != diffs[j].Kind {
+			return diffs[i].Kind < diffs[j].Kind
+		}
+		if diffs[i].Namespace != diffs[j].Namespace {
+			return diffs[i].Namespace < diffs[j].Namespace
+		}
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs
+}
+
+func indexResources(manifest mf.Manifest) map[string]unstructured.Unstructured {
+	resources := map[string]unstructured.Unstructured{}
+	for _, r := range manifest.Resources() {
+		gvk := r.GroupVersionKind()
+		resources[strings.Join([]string{gvk.Group, gvk.Kind, r.GetNamespace(), r.GetName()}, "/")] = r
+	}
+	return resources
+}
+
+func newResourceDiff(u unstructured.Unstructured, change Change, fields []FieldDiff) ResourceDiff {
+	return ResourceDiff{
+		APIVersion: u.GetAPIVersion(),
+		Kind:       u.GetKind(),
+		Namespace:  u.GetNamespace(),
+		Name:       u.GetName(),
+		Change:     change,
+		Fields:     fields,
+	}
+}
+
+// compareValues appends the differences between old and new to fields.
+// Lists of objects having a name, like containers or env, are matched by
+// name, other lists by index.
+func compareValues(path string, old, new interface{}, fields *[]FieldDiff) {
+	switch o := old.(type) {
+	case map[string]interface{}:
+		n, ok := new.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for _, key := range unionKeys(o, n) {
+			compareValues(joinPath(path, key), o[key], n[key], fields)
+		}
+		return
+	case []interface{}:
+		n, ok := new.([]interface{})
+		if !ok {
+			break
+		}
+		oldNamed, newNamed := namedItems(o), namedItems(n)
+		if oldNamed != nil && newNamed != nil {
+			for _, name := range unionKeys(oldNamed, newNamed) {
+				compareValues(fmt.Sprintf("%s[name=%s]", path, name), oldNamed[name], newNamed[name], fields)
+			}
+			return
+		}
+		for i := 0; i < len(o) || i < len(n); i++ {
+			var oldItem, newItem interface{}
+			if i < len(o) {
+				oldItem = o[i]
+			}
+			if i < len(n) {
+				newItem = n[i]
+			}
+			compareValues(fmt.Sprintf("%s[%d]", path, i), oldItem, newItem, fields)
+		}
+		return
+	}
+	if !reflect.DeepEqual(old, new) && !derivedFields[path] {
+		*fields = append(*fields, FieldDiff{Path: path, Old: old, New: new})
+	}
+}
+
+// namedItems returns the items of the list by name, or nil when an item has
+// no unique name
+func namedItems(list []interface{}) map[string]interface{} {
+	if len(list) == 0 {
+		return nil
+	}
+	items := map[string]interface{}{}
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		name, ok := m["name"].(string)
+		if !ok {
+			return nil
+		}
+		if _, exists := items[name]; exists {
+			return nil
+		}
+		items[name] = item
+	}
+	return items
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := []string{}
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if strings.ContainsAny(key, ".[]") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func redactSecretFields(fields []FieldDiff) {
+	for i := range fields {
+		if !strings.HasPrefix(fields[i].Path, "data") && !strings.HasPrefix(fields[i].Path, "stringData") {
+			continue
+		}
+		if fields[i].Old != nil {
+			fields[i].Old = redactedValue
+		}
+		if fields[i].New != nil {
+			fields[i].New = redactedValue
+		}
+	}
+}
+
+// WriteDiff writes a human readable form of the diff, one resource per
+// block followed by the changed fields
+func WriteDiff(w io.Writer, diffs []ResourceDiff) error {
+	counts := map[Change]int{}
+	for _, d := range diffs {
+		counts[d.Change]++
+		marker := map[Change]string{ChangeAdded: "+", ChangeRemoved: "-", ChangeModified: "~"}[d.Change]
+		name := d.Name
+		if d.Namespace != "" {
+			name = d.Namespace + "/" + d.Name
+		}
+		if _, err := fmt.Fprintf(w, "%s %s %s (%s)\n", marker, d.Kind, name, d.APIVersion); err != nil {
+			return err
+		}
+		for _, f := range d.Fields {
+			if _, err := fmt.Fprintf(w, "    %s: %s -> %s\n", f.Path, formatValue(f.Old), formatValue(f.New)); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d added, %d removed, %d modified\n", counts[ChangeAdded], counts[ChangeRemoved], counts[ChangeModified])
+	return err
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
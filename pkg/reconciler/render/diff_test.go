@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pipeline/test/diff"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newManifest(t *testing.T, objects ...map[string]interface{}) mf.Manifest {
+	t.Helper()
+	resources := []unstructured.Unstructured{}
+	for _, o := range objects {
+		resources = append(resources, unstructured.Unstructured{Object: o})
+	}
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	assert.NilError(t, err)
+	return manifest
+}
+
+func deployment(image string, env ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "controller",
+			"namespace": "tekton-pipelines",
+			"labels":    map[string]interface{}{"app.kubernetes.io/version": "v1"},
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+						map[string]interface{}{"name": "controller", "image": image, "env": env},
+					},
+				},
+			},
+		},
+	}
+}
+
+func configMap(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "tekton-pipelines"},
+	}
+}
+
+func secret(value string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "signing-secrets", "namespace": "tekton-pipelines"},
+		"data":       map[string]interface{}{"cosign.key": value},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	oldManifest := newManifest(t,
+		deployment("controller:v1", map[string]interface{}{"name": "A", "value": "1"}),
+		configMap("removed"),
+		configMap("unchanged"),
+		secret("b2xk"),
+	)
+	newManifest := newManifest(t,
+		configMap("added"),
+		configMap("unchanged"),
+		deployment("controller:v2", map[string]interface{}{"name": "A", "value": "2"}, map[string]interface{}{"name": "B", "value": "3"}),
+		secret("bmV3"),
+	)
+
+	want := []ResourceDiff{{
+		APIVersion: "v1", Kind: "ConfigMap", Namespace: "tekton-pipelines", Name: "added", Change: ChangeAdded,
+	}, {
+		APIVersion: "v1", Kind: "ConfigMap", Namespace: "tekton-pipelines", Name: "removed", Change: ChangeRemoved,
+	}, {
+		APIVersion: "apps/v1", Kind: "Deployment", Namespace: "tekton-pipelines", Name: "controller", Change: ChangeModified,
+		Fields: []FieldDiff{
+			{Path: "spec.template.spec.containers[name=controller].env[name=A].value", Old: "1", New: "2"},
+			{Path: "spec.template.spec.containers[name=controller].env[name=B]", New: map[string]interface{}{"name": "B", "value": "3"}},
+			{Path: "spec.template.spec.containers[name=controller].image", Old: "controller:v1", New: "controller:v2"},
+		},
+	}, {
+		APIVersion: "v1", Kind: "Secret", Namespace: "tekton-pipelines", Name: "signing-secrets", Change: ChangeModified,
+		Fields: []FieldDiff{{Path: `data["cosign.key"]`, Old: redactedValue, New: redactedValue}},
+	}}
+	if d := cmp.Diff(want, Diff(oldManifest, newManifest)); d != "" {
+		t.Errorf("Diff() mismatch %s", diff.PrintWantGot(d))
+	}
+
+	assert.Equal(t, len(Diff(oldManifest, oldManifest)), 0)
+}
+
+func TestDiffPayloads(t *testing.T) {
+	tp := func() v1alpha1.TektonComponent {
+		comp := &v1alpha1.TektonPipeline{}
+		comp.Spec.TargetNamespace = "tekton-pipelines"
+		return comp
+	}
+	oldManifest, err := Render(context.Background(), tp(), Options{Platform: PlatformKubernetes, KoDataPath: koDataPath})
+	assert.NilError(t, err)
+	newManifest, err := Render(context.Background(), tp(), Options{Platform: PlatformKubernetes, KoDataPath: "testdata/kodata-next"})
+	assert.NilError(t, err)
+
+	buf := &bytes.Buffer{}
+	assert.NilError(t, WriteDiff(buf, Diff(oldManifest, newManifest)))
+	assert.Equal(t, buf.String(), `~ ConfigMap tekton-pipelines/pipelines-info (v1)
+    data.version: "v0.70.0" -> "v0.71.0"
+~ Deployment tekton-pipelines/tekton-pipelines-controller (apps/v1)
+    spec.template.spec.containers[name=tekton-pipelines-controller].image: "ghcr.io/tektoncd/pipeline/controller:v0.70.0" -> "ghcr.io/tektoncd/pipeline/controller:v0.71.0"
++ ServiceAccount tekton-pipelines/tekton-pipelines-controller (v1)
+1 added, 0 removed, 2 modified
+`)
+}
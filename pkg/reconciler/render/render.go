@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render produces the manifests the operator applies for a custom
+// resource without access to a cluster.
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/common/networkpolicy"
+	k8schain "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonchain"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonconfig/extension"
+	k8sdashboard "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektondashboard"
+	k8spipeline "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonpipeline"
+	k8sresult "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonresult"
+	k8strigger "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektontrigger"
+	ocpchain "github.com/tektoncd/operator/pkg/reconciler/openshift/tektonchain"
+	ocppipeline "github.com/tektoncd/operator/pkg/reconciler/openshift/tektonpipeline"
+	ocpresult "github.com/tektoncd/operator/pkg/reconciler/openshift/tektonresult"
+	ocptrigger "github.com/tektoncd/operator/pkg/reconciler/openshift/tektontrigger"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/chain"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/result"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/trigger"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	PlatformKubernetes = "kubernetes"
+	PlatformOpenShift  = "openshift"
+
+	platformEnvKey = "PLATFORM"
+)
+
+// Options configures the platform and the payload used for rendering
+type Options struct {
+	// Platform is the platform the operator runs on, kubernetes or openshift
+	Platform string
+	// KoDataPath is the directory holding the component payloads, the
+	// kodata directory of the operator image
+	KoDataPath string
+}
+
+func (o Options) validate() error {
+	if o.Platform != PlatformKubernetes && o.Platform != PlatformOpenShift {
+		return fmt.Errorf("unsupported platform %q, expected %s or %s", o.Platform, PlatformKubernetes, PlatformOpenShift)
+	}
+	if _, err := os.Stat(o.KoDataPath); err != nil {
+		return fmt.Errorf("invalid kodata directory: %w", err)
+	}
+	return nil
+}
+
+// Render returns the manifests the operator applies for the passed custom
+// resource, after running the same defaulting, transformers and
+// NetworkPolicy generation as the reconcilers. A TektonConfig is expanded
+// into the Pipelines, Triggers, Chains, Results and Dashboard components its
+// reconciler creates, the pruners, the scheduler and the OpenShift specific
+// components are not rendered. It does not need access to a cluster, which
+// lets the manifests be previewed offline.
+//
+// On OpenShift the transformers which depend on cluster state (trusted CA
+// bundles content, TLS profile and metrics mTLS) are not applied.
+//
+// Render sets the environment variables read by the reconcilers to select
+// the platform and the payload, so it must not be called concurrently.
+func Render(ctx context.Context, comp v1alpha1.TektonComponent, opts Options) (mf.Manifest, error) {
+	if err := opts.validate(); err != nil {
+		return mf.Manifest{}, err
+	}
+	restore := setEnv(map[string]string{
+		platformEnvKey:  opts.Platform,
+		common.KoEnvKey: opts.KoDataPath,
+	})
+	defer restore()
+
+	comps, err := components(ctx, comp, opts.Platform)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+
+	result := mf.Manifest{}
+	for _, c := range comps {
+		m, err := renderComponent(ctx, c, opts.Platform)
+		if err != nil {
+			return mf.Manifest{}, fmt.Errorf("failed to render %s: %w", kindOf(c), err)
+		}
+		result = result.Append(m)
+	}
+	return result, nil
+}
+
+// components returns the defaulted component custom resources the passed
+// one results in
+func components(ctx context.Context, comp v1alpha1.TektonComponent, platform string) ([]v1alpha1.TektonComponent, error) {
+	tc, ok := comp.(*v1alpha1.TektonConfig)
+	if !ok {
+		setDefaults(ctx, comp)
+		return []v1alpha1.TektonComponent{comp}, nil
+	}
+
+	tc.SetDefaults(ctx)
+	comps := []v1alpha1.TektonComponent{pipeline.GetTektonPipelineCR(tc, "")}
+	if tc.Spec.Profile == v1alpha1.ProfileAll || tc.Spec.Profile == v1alpha1.ProfileBasic {
+		if !tc.Spec.Trigger.Disabled {
+			comps = append(comps, trigger.GetTektonTriggerCR(tc, ""))
+		}
+		if !tc.Spec.Chain.Disabled {
+			comps = append(comps, chain.GetTektonChainCR(tc, ""))
+		}
+		if !tc.Spec.Result.Disabled {
+			comps = append(comps, result.GetTektonResultCR(tc, ""))
+		}
+	}
+	if tc.Spec.Profile == v1alpha1.ProfileAll && platform == PlatformKubernetes {
+		comps = append(comps, extension.GetTektonDashboardCR(tc))
+	}
+
+	for _, c := range comps {
+		setDefaults(ctx, c)
+	}
+	return comps, nil
+}
+
+func setDefaults(ctx context.Context, comp v1alpha1.TektonComponent) {
+	if d, ok := comp.(interface{ SetDefaults(context.Context) }); ok {
+		d.SetDefaults(ctx)
+	}
+}
+
+func renderComponent(ctx context.Context, comp v1alpha1.TektonComponent, platform string) (mf.Manifest, error) {
+	if _, ok := comp.(*v1alpha1.TektonDashboard); ok && platform == PlatformOpenShift {
+		return mf.Manifest{}, fmt.Errorf("TektonDashboard is not supported on %s", platform)
+	}
+	// the payload lookup panics on a missing component directory
+	if _, err := os.Stat(common.ComponentDir(comp)); err != nil {
+		return mf.Manifest{}, fmt.Errorf("payload not found: %w", err)
+	}
+
+	params := networkpolicy.KubernetesPlatformDefaults()
+	if platform == PlatformOpenShift {
+		params = networkpolicy.OpenShiftPlatformDefaults()
+	}
+	openshift := platform == PlatformOpenShift
+
+	switch c := comp.(type) {
+	case *v1alpha1.TektonPipeline:
+		manifest, err := sourceManifest(ctx, "pipelines-info", common.PayloadOptions{})
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		ext := common.NoExtension(ctx)
+		if openshift {
+			ext = ocppipeline.OfflineExtension()
+		}
+		return k8spipeline.Render(ctx, manifest, c, ext, params)
+	case *v1alpha1.TektonTrigger:
+		manifest, err := sourceManifest(ctx, "triggers-info", common.PayloadOptions{})
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		ext := common.NoExtension(ctx)
+		if openshift {
+			ext = ocptrigger.OfflineExtension()
+		}
+		return k8strigger.Render(ctx, manifest, c, ext, params)
+	case *v1alpha1.TektonChain:
+		manifest, err := sourceManifest(ctx, "chains-info", common.PayloadOptions{})
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		ext := common.NoExtension(ctx)
+		if openshift {
+			ext = ocpchain.OfflineExtension()
+		}
+		return k8schain.Render(ctx, manifest, c, ext, params)
+	case *v1alpha1.TektonResult:
+		manifest, err := sourceManifest(ctx, "tekton-results-info", common.PayloadOptions{})
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		ext := common.NoExtension(ctx)
+		if openshift {
+			ext = ocpresult.OfflineExtension()
+		}
+		return k8sresult.Render(ctx, manifest, c, ext, params)
+	case *v1alpha1.TektonDashboard:
+		manifest, err := sourceManifest(ctx, "dashboard-info", common.PayloadOptions{ReadOnly: c.Spec.Readonly})
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		return k8sdashboard.Render(ctx, manifest, c, common.NoExtension(ctx))
+	}
+	return mf.Manifest{}, fmt.Errorf("rendering %s is not supported", kindOf(comp))
+}
+
+// kindOf returns the kind of the component, the generated custom resources
+// have no TypeMeta set
+func kindOf(comp v1alpha1.TektonComponent) string {
+	return reflect.TypeOf(comp).Elem().Name()
+}
+
+func sourceManifest(ctx context.Context, versionConfigMap string, opts common.PayloadOptions) (mf.Manifest, error) {
+	ctrl := common.Controller{VersionConfigMap: versionConfigMap}
+	return ctrl.SourceManifest(ctx, opts)
+}
+
+// setEnv sets the passed environment variables and returns a function
+// restoring their previous values
+func setEnv(env map[string]string) func() {
+	previous := map[string]*string{}
+	for key, value := range env {
+		if old, ok := os.LookupEnv(key); ok {
+			previous[key] = &old
+		} else {
+			previous[key] = nil
+		}
+		os.Setenv(key, value)
+	}
+	return func() {
+		for key, old := range previous {
+			if old == nil {
+				os.Unsetenv(key)
+				continue
+			}
+			os.Setenv(key, *old)
+		}
+	}
+}
+
+// WriteYAML writes the resources of the manifest as a multi document YAML
+func WriteYAML(w io.Writer, manifest mf.Manifest) error {
+	for _, r := range manifest.Resources() {
+		data, err := yaml.Marshal(r.Object)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
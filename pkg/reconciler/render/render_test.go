@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const koDataPath = "testdata/kodata"
+
+func resourceKeys(manifest mf.Manifest) []string {
+	keys := []string{}
+	for _, r := range manifest.Resources() {
+		keys = append(keys, r.GetKind()+"/"+r.GetNamespace()+"/"+r.GetName())
+	}
+	return keys
+}
+
+func findResource(t *testing.T, manifest mf.Manifest, kind, name string) unstructured.Unstructured {
+	t.Helper()
+	for _, r := range manifest.Filter(mf.ByKind(kind), mf.ByName(name)).Resources() {
+		return r
+	}
+	t.Fatalf("%s %s not found in the rendered manifest", kind, name)
+	return unstructured.Unstructured{}
+}
+
+func TestRenderTektonConfig(t *testing.T) {
+	tc := &v1alpha1.TektonConfig{}
+	tc.Name = v1alpha1.ConfigResourceName
+	tc.Spec.Profile = v1alpha1.ProfileBasic
+	tc.Spec.TargetNamespace = "tekton-ci"
+	tc.Spec.Chain.Disabled = true
+	tc.Spec.Result.Disabled = true
+	tc.Spec.Pipeline.EnableApiFields = "beta"
+	replicas := int32(3)
+	tc.Spec.Pipeline.Options.Deployments = map[string]appsv1.Deployment{
+		"tekton-pipelines-controller": {Spec: appsv1.DeploymentSpec{Replicas: &replicas}},
+	}
+
+	manifest, err := Render(context.Background(), tc, Options{Platform: PlatformKubernetes, KoDataPath: koDataPath})
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, resourceKeys(manifest), []string{
+		"ConfigMap/tekton-ci/feature-flags",
+		"ConfigMap/tekton-ci/pipelines-info",
+		"Deployment/tekton-ci/tekton-pipelines-controller",
+		"ServiceAccount/tekton-ci/tekton-operators-proxy-webhook",
+		"NetworkPolicy/tekton-ci/pipeline-controller",
+		"NetworkPolicy/tekton-ci/pipeline-default-deny",
+		"NetworkPolicy/tekton-ci/pipeline-events-controller",
+		"NetworkPolicy/tekton-ci/pipeline-resolvers",
+		"NetworkPolicy/tekton-ci/pipeline-webhook",
+		"NetworkPolicy/tekton-ci/proxy-webhook",
+		"NetworkPolicy/tekton-ci/tekton-proxy-webhook-default-deny",
+		"ConfigMap/tekton-ci/feature-flags-triggers",
+		"ConfigMap/tekton-ci/triggers-info",
+		"NetworkPolicy/tekton-ci/tekton-default-deny",
+		"NetworkPolicy/tekton-ci/triggers-controller",
+		"NetworkPolicy/tekton-ci/triggers-core-interceptors",
+		"NetworkPolicy/tekton-ci/triggers-webhook",
+	})
+
+	featureFlags := findResource(t, manifest, "ConfigMap", "feature-flags")
+	value, _, _ := unstructured.NestedString(featureFlags.Object, "data", "enable-api-fields")
+	assert.Equal(t, value, "beta")
+
+	deployment := findResource(t, manifest, "Deployment", "tekton-pipelines-controller")
+	got, _, _ := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	assert.Equal(t, got, int64(3))
+
+	// the environment must be restored once rendered
+	_, found := os.LookupEnv(common.KoEnvKey)
+	assert.Equal(t, found, false)
+}
+
+func TestRenderTektonPipelineWithoutNetworkPolicies(t *testing.T) {
+	tp := &v1alpha1.TektonPipeline{}
+	tp.Name = v1alpha1.PipelineResourceName
+	tp.Spec.TargetNamespace = "tekton-pipelines"
+	tp.Spec.NetworkPolicy.Disabled = true
+
+	manifest, err := Render(context.Background(), tp, Options{Platform: PlatformOpenShift, KoDataPath: koDataPath})
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Filter(mf.ByKind("NetworkPolicy")).Resources()), 0)
+	assert.Equal(t, len(manifest.Filter(mf.ByKind("Namespace")).Resources()), 0)
+}
+
+func TestRenderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		comp    v1alpha1.TektonComponent
+		opts    Options
+		wantErr string
+	}{
+		{
+			name:    "invalid platform",
+			comp:    &v1alpha1.TektonPipeline{},
+			opts:    Options{Platform: "minikube", KoDataPath: koDataPath},
+			wantErr: "unsupported platform",
+		},
+		{
+			name:    "missing kodata",
+			comp:    &v1alpha1.TektonPipeline{},
+			opts:    Options{Platform: PlatformKubernetes, KoDataPath: "testdata/missing"},
+			wantErr: "invalid kodata directory",
+		},
+		{
+			name:    "missing component payload",
+			comp:    &v1alpha1.TektonChain{},
+			opts:    Options{Platform: PlatformKubernetes, KoDataPath: koDataPath},
+			wantErr: "failed to render TektonChain: payload not found",
+		},
+		{
+			name:    "dashboard on openshift",
+			comp:    &v1alpha1.TektonDashboard{},
+			opts:    Options{Platform: PlatformOpenShift, KoDataPath: koDataPath},
+			wantErr: "TektonDashboard is not supported on openshift",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Render(context.Background(), test.comp, test.opts)
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	ctx := context.Background()
+
+	comp, err := Decode(ctx, []byte(`
+apiVersion: operator.tekton.dev/v1beta1
+kind: TektonPipeline
+metadata:
+  name: pipeline
+spec:
+  targetNamespace: tekton-ci
+  featureFlags:
+    enableAPIFields: alpha
+`))
+	assert.NilError(t, err)
+	tp, ok := comp.(*v1alpha1.TektonPipeline)
+	assert.Assert(t, ok)
+	assert.Equal(t, tp.Spec.TargetNamespace, "tekton-ci")
+	assert.Equal(t, tp.Spec.Pipeline.EnableApiFields, "alpha")
+
+	_, err = Decode(ctx, []byte("apiVersion: operator.tekton.dev/v1alpha1\nkind: TektonAddon\n"))
+	assert.ErrorContains(t, err, "unsupported kind")
+
+	_, err = Decode(ctx, []byte("apiVersion: operator.tekton.dev/v1alpha1\nkind: TektonConfig\nspec:\n  profil: all\n"))
+	assert.ErrorContains(t, err, "unknown field")
+}
+
+func TestWriteYAML(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Recursive("testdata/kodata/webhook"))
+	assert.NilError(t, err)
+
+	buf := &bytes.Buffer{}
+	assert.NilError(t, WriteYAML(buf, manifest))
+	assert.Assert(t, strings.HasPrefix(buf.String(), "---\napiVersion: v1\nkind: ServiceAccount\n"))
+}
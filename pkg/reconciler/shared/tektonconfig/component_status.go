@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+)
+
+// requeueLoopThreshold is how long a component stays not ready before the
+// operator reports it is stuck retrying
+const requeueLoopThreshold = 10 * time.Minute
+
+// componentGetter fetches a component CR which is managed through TektonConfig
+// from the API server and applies merge patches to it, the rollback needs the
+// live object before patching it
+type componentGetter struct {
+	kind  string
+	get   func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error)
+	patch func(ctx context.Context, c clientset.Interface, data []byte) error
+}
+
+// ComponentLister reads a component CR which is managed through TektonConfig
+// from the informer cache of the controller
+type ComponentLister func() (v1alpha1.TektonComponent, error)
+
+// NewComponentLister returns the ComponentLister of the component CR with the
+// given name, get is the Get of its generated lister
+func NewComponentLister[T v1alpha1.TektonComponent](get func(name string) (T, error), name string) ComponentLister {
+	return func() (v1alpha1.TektonComponent, error) {
+		cr, err := get(name)
+		if err != nil {
+			// do not wrap the nil pointer of a missing CR into the interface
+			return nil, err
+		}
+		return cr, nil
+	}
+}
+
+// managedComponents lists the component CRs reported in TektonConfig status.
+// Components which are created by the platform extensions are listed too,
+// they are simply skipped on platforms where they do not exist
+var managedComponents = []componentGetter{
+	{kind: v1alpha1.KindTektonPipeline, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonPipelines().Patch(ctx, v1alpha1.PipelineResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindTektonTrigger, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonTriggers().Get(ctx, v1alpha1.TriggerResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonTriggers().Patch(ctx, v1alpha1.TriggerResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindTektonChain, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonChains().Patch(ctx, v1alpha1.ChainResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindTektonResult, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonResults().Get(ctx, v1alpha1.ResultResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonResults().Patch(ctx, v1alpha1.ResultResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindTektonPruner, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonPruners().Get(ctx, v1alpha1.TektonPrunerResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonPruners().Patch(ctx, v1alpha1.TektonPrunerResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindTektonScheduler, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonSchedulers().Get(ctx, v1alpha1.TektonSchedulerResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonSchedulers().Patch(ctx, v1alpha1.TektonSchedulerResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindTektonMulticlusterProxyAAE, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonMulticlusterProxyAAEs().Get(ctx, v1alpha1.MultiClusterProxyAAEResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonMulticlusterProxyAAEs().Patch(ctx, v1alpha1.MultiClusterProxyAAEResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindSyncerService, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().SyncerServices().Get(ctx, v1alpha1.SyncerServiceResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().SyncerServices().Patch(ctx, v1alpha1.SyncerServiceResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindTektonDashboard, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonDashboards().Get(ctx, v1alpha1.DashboardResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonDashboards().Patch(ctx, v1alpha1.DashboardResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindTektonAddon, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonAddons().Get(ctx, v1alpha1.AddonResourceName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().TektonAddons().Patch(ctx, v1alpha1.AddonResourceName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+	{kind: v1alpha1.KindOpenShiftPipelinesAsCode, get: func(ctx context.Context, c clientset.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().Get(ctx, v1alpha1.OpenShiftPipelinesAsCodeName, metav1.GetOptions{})
+	}, patch: func(ctx context.Context, c clientset.Interface, data []byte) error {
+		_, err := c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().Patch(ctx, v1alpha1.OpenShiftPipelinesAsCodeName, types.MergePatchType, data, metav1.PatchOptions{})
+		return err
+	}},
+}
+
+// updateComponentStatuses records the version, readiness and installer sets of
+// every component CR in TektonConfig status, and in the component metrics. Failures are only logged, the
+// per-component breakdown is informational and must not block the reconcile.
+// Everything is read from the informer caches, a component without a lister on
+// this platform is reported as absent
+func (r *Reconciler) updateComponentStatuses(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	logger := logging.FromContext(ctx)
+
+	installerSets := map[string][]string{}
+	isList, err := r.installerSetLister.List(labels.Everything())
+	if err != nil {
+		logger.Debugw("failed to list TektonInstallerSets for component status", "error", err)
+	} else {
+		for _, is := range isList {
+			for _, owner := range is.GetOwnerReferences() {
+				key := owner.Kind + "/" + owner.Name
+				installerSets[key] = append(installerSets[key], is.GetName())
+			}
+		}
+	}
+
+	for _, component := range managedComponents {
+		var previous *v1alpha1.ComponentStatus
+		if existing := tc.Status.GetComponentStatus(component.kind); existing != nil {
+			previous = existing.DeepCopy()
+		}
+		cr, err := r.getComponent(component.kind)
+		if err != nil {
+			if apierrs.IsNotFound(err) {
+				if previous != nil {
+					common.EmitComponentEvent(ctx, tc, component.kind, corev1.EventTypeNormal, common.ReasonComponentDisabled,
+						"%s %s was removed", previous.Kind, previous.Name)
+				}
+				tc.Status.RemoveComponentStatus(component.kind)
+				common.ForgetComponentStatus(component.kind)
+			} else {
+				logger.Debugw("failed to get component for component status", "kind", component.kind, "error", err)
+			}
+			continue
+		}
+		common.ResolveComponentEvent(tc, component.kind, common.ReasonComponentDisabled)
+		cs := getComponentStatus(component.kind, cr, installerSets[component.kind+"/"+cr.GetName()])
+		tc.Status.SetComponentStatus(cs)
+		common.RecordComponentStatus(cs.Kind, cs.Name, cs.Version, cs.Ready == corev1.ConditionTrue)
+		emitComponentEvents(ctx, tc, cr, previous, *tc.Status.GetComponentStatus(component.kind))
+	}
+	common.RecordComponentStatus(v1alpha1.KindTektonConfig, tc.GetName(), tc.Status.GetVersion(), tc.Status.IsReady())
+
+	if ready := tc.Status.GetCondition(apis.ConditionReady); ready != nil && !ready.IsTrue() &&
+		time.Since(ready.LastTransitionTime.Inner.Time) > requeueLoopThreshold {
+		common.EmitEvent(ctx, tc, corev1.EventTypeWarning, common.ReasonRequeueLoop,
+			"%s %s has not been ready for more than %s, the operator keeps retrying", v1alpha1.KindTektonConfig, tc.GetName(), requeueLoopThreshold)
+	} else if ready.IsTrue() {
+		common.ResolveEvent(tc, common.ReasonRequeueLoop)
+	}
+}
+
+// getComponent reads a component CR from the informer cache, a kind without a
+// lister does not exist on this platform
+func (r *Reconciler) getComponent(kind string) (v1alpha1.TektonComponent, error) {
+	lister, ok := r.componentListers[kind]
+	if !ok {
+		return nil, apierrs.NewNotFound(v1alpha1.Resource(strings.ToLower(kind)), "")
+	}
+	return lister()
+}
+
+// emitComponentEvents emits the events of the readiness transitions of a
+// component, on TektonConfig, and the requeue loop events of a component
+// which stays not ready, on TektonConfig and on the component
+func emitComponentEvents(ctx context.Context, tc *v1alpha1.TektonConfig, cr v1alpha1.TektonComponent, previous *v1alpha1.ComponentStatus, cs v1alpha1.ComponentStatus) {
+	if previous == nil || previous.Ready != cs.Ready {
+		switch cs.Ready {
+		case corev1.ConditionTrue:
+			common.ResolveComponentEvent(tc, cs.Kind, common.ReasonComponentNotReady)
+			common.EmitComponentEvent(ctx, tc, cs.Kind, corev1.EventTypeNormal, common.ReasonComponentReady,
+				"%s %s is ready, version %s", cs.Kind, cs.Name, cs.Version)
+		case corev1.ConditionFalse:
+			common.ResolveComponentEvent(tc, cs.Kind, common.ReasonComponentReady)
+			common.EmitComponentEvent(ctx, tc, cs.Kind, corev1.EventTypeWarning, common.ReasonComponentNotReady,
+				"%s %s is not ready: %s", cs.Kind, cs.Name, cs.Message)
+		}
+	}
+
+	if cs.Ready == corev1.ConditionTrue {
+		common.ResolveComponentEvent(tc, cs.Kind, common.ReasonRequeueLoop)
+		common.ResolveEvent(cr, common.ReasonRequeueLoop)
+		return
+	}
+	if time.Since(cs.LastTransitionTime.Inner.Time) > requeueLoopThreshold {
+		msg := fmt.Sprintf("%s %s has not been ready for more than %s, the operator keeps retrying", cs.Kind, cs.Name, requeueLoopThreshold)
+		common.EmitComponentEvent(ctx, tc, cs.Kind, corev1.EventTypeWarning, common.ReasonRequeueLoop, "%s", msg)
+		common.EmitEvent(ctx, cr, corev1.EventTypeWarning, common.ReasonRequeueLoop, "%s", msg)
+	}
+}
+
+// getComponentStatus builds the TektonConfig status entry of a component CR
+func getComponentStatus(kind string, cr v1alpha1.TektonComponent, installerSets []string) v1alpha1.ComponentStatus {
+	cs := v1alpha1.ComponentStatus{
+		Kind:    kind,
+		Name:    cr.GetName(),
+		Version: cr.GetStatus().GetVersion(),
+		Ready:   corev1.ConditionUnknown,
+	}
+	if cond := cr.GetStatus().GetCondition(apis.ConditionReady); cond != nil {
+		cs.Ready = cond.Status
+		cs.Reason = cond.Reason
+		cs.Message = cond.Message
+		cs.LastTransitionTime = cond.LastTransitionTime
+	}
+	if len(installerSets) > 0 {
+		cs.InstallerSets = append([]string{}, installerSets...)
+		sort.Strings(cs.InstallerSets)
+	}
+	return cs
+}
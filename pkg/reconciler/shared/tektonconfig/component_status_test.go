@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	fakechaininformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonchain/fake"
+	fakeinstallersetinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektoninstallerset/fake"
+	_ "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonmulticlusterproxyaae/fake"
+	fakepipelineinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpipeline/fake"
+	_ "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpruner/fake"
+	_ "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonresult/fake"
+	_ "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonscheduler/fake"
+	_ "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektontrigger/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	ts "knative.dev/pkg/reconciler/testing"
+)
+
+func TestUpdateComponentStatuses(t *testing.T) {
+	ctx, _, _ := ts.SetupFakeContextWithCancel(t)
+
+	tp := &v1alpha1.TektonPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName},
+	}
+	tp.Status.InitializeConditions()
+	tp.Status.SetVersion("v0.70.0")
+	tp.Status.MarkNotReady("Components not ready")
+	assert.NilError(t, fakepipelineinformer.Get(ctx).Informer().GetIndexer().Add(tp))
+
+	for _, name := range []string{"pipeline-main-static-abcd", "pipeline-main-deployment-efgh"} {
+		is := &v1alpha1.TektonInstallerSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				OwnerReferences: []metav1.OwnerReference{{
+					Kind: v1alpha1.KindTektonPipeline,
+					Name: v1alpha1.PipelineResourceName,
+				}},
+			},
+		}
+		assert.NilError(t, fakeinstallersetinformer.Get(ctx).Informer().GetIndexer().Add(is))
+	}
+
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	// a stale entry of a component which no longer exists
+	tc.Status.SetComponentStatus(v1alpha1.ComponentStatus{Kind: v1alpha1.KindTektonTrigger, Ready: corev1.ConditionTrue})
+	// a stale entry of a component which has no lister on this platform
+	tc.Status.SetComponentStatus(v1alpha1.ComponentStatus{Kind: v1alpha1.KindTektonDashboard, Ready: corev1.ConditionTrue})
+
+	r := newStatusReconciler(ctx)
+	r.updateComponentStatuses(ctx, tc)
+
+	assert.Equal(t, len(tc.Status.Components), 1)
+	assert.Assert(t, tc.Status.GetComponentStatus(v1alpha1.KindTektonTrigger) == nil)
+	assert.Assert(t, tc.Status.GetComponentStatus(v1alpha1.KindTektonDashboard) == nil)
+
+	got := tc.Status.GetComponentStatus(v1alpha1.KindTektonPipeline)
+	assert.Assert(t, got != nil)
+	assert.Equal(t, got.Name, v1alpha1.PipelineResourceName)
+	assert.Equal(t, got.Version, "v0.70.0")
+	assert.Equal(t, got.Ready, corev1.ConditionFalse)
+	assert.Equal(t, got.Message, tp.Status.GetCondition(apis.ConditionReady).Message)
+	assert.DeepEqual(t, got.InstallerSets, []string{"pipeline-main-deployment-efgh", "pipeline-main-static-abcd"})
+}
+
+func TestUpdateComponentStatusesEvents(t *testing.T) {
+	ctx, _, _ := ts.SetupFakeContextWithCancel(t)
+	recorder := record.NewFakeRecorder(10)
+	ctx = controller.WithEventRecorder(ctx, recorder)
+
+	// a component which was ready
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName}}
+	tp.Status.InitializeConditions()
+	tp.Status.MarkNotReady("Components not ready")
+	assert.NilError(t, fakepipelineinformer.Get(ctx).Informer().GetIndexer().Add(tp))
+
+	// a component which has not been ready for a while
+	chain := &v1alpha1.TektonChain{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ChainResourceName}}
+	chain.Status.InitializeConditions()
+	chain.Status.MarkNotReady("Components not ready")
+	for i := range chain.Status.Conditions {
+		chain.Status.Conditions[i].LastTransitionTime = apis.VolatileTime{Inner: metav1.NewTime(time.Now().Add(-time.Hour))}
+	}
+	assert.NilError(t, fakechaininformer.Get(ctx).Informer().GetIndexer().Add(chain))
+
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName, UID: types.UID("events-test")}}
+	tc.Status.SetComponentStatus(v1alpha1.ComponentStatus{Kind: v1alpha1.KindTektonPipeline, Name: v1alpha1.PipelineResourceName, Ready: corev1.ConditionTrue})
+	tc.Status.SetComponentStatus(v1alpha1.ComponentStatus{Kind: v1alpha1.KindTektonTrigger, Name: v1alpha1.TriggerResourceName, Ready: corev1.ConditionTrue})
+	tc.Status.SetComponentStatus(v1alpha1.ComponentStatus{Kind: v1alpha1.KindTektonChain, Name: v1alpha1.ChainResourceName, Ready: corev1.ConditionFalse})
+
+	r := newStatusReconciler(ctx)
+	r.updateComponentStatuses(ctx, tc)
+
+	stuck := "Warning RequeueLoop TektonChain chain has not been ready for more than 10m0s, the operator keeps retrying"
+	assert.DeepEqual(t, drainEvents(recorder), []string{
+		"Warning ComponentNotReady TektonPipeline pipeline is not ready: Ready: Components not ready",
+		"Normal ComponentDisabled TektonTrigger trigger was removed",
+		stuck,
+		stuck,
+	})
+
+	// the same statuses do not emit the events again
+	r.updateComponentStatuses(ctx, tc)
+	assert.Equal(t, len(drainEvents(recorder)), 0)
+}
+
+// newStatusReconciler returns a Reconciler reading the component statuses from
+// the fake informer caches
+func newStatusReconciler(ctx context.Context) *Reconciler {
+	return &Reconciler{
+		componentListers:   componentListers(ctx),
+		installerSetLister: fakeinstallersetinformer.Get(ctx).Lister(),
+	}
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
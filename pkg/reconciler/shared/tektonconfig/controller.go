@@ -29,16 +29,22 @@ import (
 	tektonChaininformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonchain"
 	tektonConfiginformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonconfig"
 	tektonInstallerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektoninstallerset"
+	proxyAAEinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonmulticlusterproxyaae"
 	tektonPipelineinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpipeline"
+	tektonPrunerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpruner"
 	tektonResultinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonresult"
+	tektonSchedulerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonscheduler"
 	tektonTriggerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektontrigger"
 	tektonConfigreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonconfig"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/upgrade"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/verification"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	cronjobinformer "knative.dev/pkg/client/injection/kube/informers/batch/v1/cronjob"
 	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -47,8 +53,11 @@ import (
 	"knative.dev/pkg/logging"
 )
 
-// NewExtensibleController returns a controller extended to a specific platform
-func NewExtensibleController(generator common.ExtensionGenerator) injection.ControllerConstructor {
+// NewExtensibleController returns a controller extended to a specific platform.
+// platformListers are the listers of the component CRs which only exist on
+// that platform, they are reported in the TektonConfig status next to the
+// shared ones
+func NewExtensibleController(generator common.ExtensionGenerator, platformListers map[string]ComponentLister) injection.ControllerConstructor {
 	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 		logger := logging.FromContext(ctx)
 
@@ -68,16 +77,25 @@ func NewExtensibleController(generator common.ExtensionGenerator) injection.Cont
 			logger.Fatal(err)
 		}
 
+		listers := componentListers(ctx)
+		for kind, lister := range platformListers {
+			listers[kind] = lister
+		}
+
 		c := &Reconciler{
-			kubeClientSet:     kubeclient.Get(ctx),
-			operatorClientSet: operatorclient.Get(ctx),
-			extension:         generator(ctx),
-			manifest:          manifest,
-			operatorVersion:   operatorVer,
+			kubeClientSet:      kubeclient.Get(ctx),
+			operatorClientSet:  operatorclient.Get(ctx),
+			componentListers:   listers,
+			installerSetLister: tektonInstallerinformer.Get(ctx).Lister(),
+			extension:          generator(ctx),
+			manifest:           manifest,
+			operatorVersion:    operatorVer,
 		}
 		c.upgrade = upgrade.New(operatorVer, c.kubeClientSet, c.operatorClientSet, injection.GetConfig(ctx))
+		c.verifier = verification.New(operatorVer, c.kubeClientSet, dynamic.NewForConfigOrDie(injection.GetConfig(ctx)))
 
 		impl := tektonConfigreconciler.NewImpl(ctx, c)
+		impl.Reconciler = common.InstrumentReconciler(v1alpha1.KindTektonConfig, impl.Reconciler)
 
 		logger.Debug("Setting up event handlers for TektonConfig")
 
@@ -120,6 +138,15 @@ func NewExtensibleController(generator common.ExtensionGenerator) injection.Cont
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
 
+		// the pruner CronJobs are owned by the TektonConfig, their last runs
+		// are reported in its status
+		if _, err := cronjobinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		}); err != nil {
+			logger.Panicf("Couldn't register CronJob informer event handler: %w", err)
+		}
+
 		if _, err := namespaceinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(enqueueCustomName(impl, v1alpha1.ConfigResourceName))); err != nil {
 			logger.Panicf("Couldn't register Namespace informer event handler: %w", err)
 		}
@@ -133,6 +160,20 @@ func NewExtensibleController(generator common.ExtensionGenerator) injection.Cont
 	}
 }
 
+// componentListers returns the listers of the component CRs which exist on
+// every platform
+func componentListers(ctx context.Context) map[string]ComponentLister {
+	return map[string]ComponentLister{
+		v1alpha1.KindTektonPipeline:             NewComponentLister(tektonPipelineinformer.Get(ctx).Lister().Get, v1alpha1.PipelineResourceName),
+		v1alpha1.KindTektonTrigger:              NewComponentLister(tektonTriggerinformer.Get(ctx).Lister().Get, v1alpha1.TriggerResourceName),
+		v1alpha1.KindTektonChain:                NewComponentLister(tektonChaininformer.Get(ctx).Lister().Get, v1alpha1.ChainResourceName),
+		v1alpha1.KindTektonResult:               NewComponentLister(tektonResultinformer.Get(ctx).Lister().Get, v1alpha1.ResultResourceName),
+		v1alpha1.KindTektonPruner:               NewComponentLister(tektonPrunerinformer.Get(ctx).Lister().Get, v1alpha1.TektonPrunerResourceName),
+		v1alpha1.KindTektonScheduler:            NewComponentLister(tektonSchedulerinformer.Get(ctx).Lister().Get, v1alpha1.TektonSchedulerResourceName),
+		v1alpha1.KindTektonMulticlusterProxyAAE: NewComponentLister(proxyAAEinformer.Get(ctx).Lister().Get, v1alpha1.MultiClusterProxyAAEResourceName),
+	}
+}
+
 // enqueueCustomName adds an event with name `config` in work queue so that
 // whenever a namespace event occurs, the TektonConfig reconciler get triggered.
 // This is required because we want to get our TektonConfig reconciler triggered
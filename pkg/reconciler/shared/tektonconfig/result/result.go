@@ -145,6 +145,21 @@ func UpdateResult(ctx context.Context, old *v1alpha1.TektonResult, new *v1alpha1
 		updated = true
 	}
 
+	if !reflect.DeepEqual(old.Spec.Rotation, new.Spec.Rotation) {
+		old.Spec.Rotation = new.Spec.Rotation
+		updated = true
+	}
+
+	if !reflect.DeepEqual(old.Spec.Backup, new.Spec.Backup) {
+		old.Spec.Backup = new.Spec.Backup
+		updated = true
+	}
+
+	if !reflect.DeepEqual(old.Spec.RetentionPolicy, new.Spec.RetentionPolicy) {
+		old.Spec.RetentionPolicy = new.Spec.RetentionPolicy
+		updated = true
+	}
+
 	if !reflect.DeepEqual(old.Spec.Config, new.Spec.Config) {
 		old.Spec.Config = new.Spec.Config
 		updated = true
@@ -155,6 +170,11 @@ func UpdateResult(ctx context.Context, old *v1alpha1.TektonResult, new *v1alpha1
 		updated = true
 	}
 
+	if !reflect.DeepEqual(old.Spec.TLS, new.Spec.TLS) {
+		old.Spec.TLS = new.Spec.TLS
+		updated = true
+	}
+
 	if old.ObjectMeta.OwnerReferences == nil {
 		old.ObjectMeta.OwnerReferences = new.ObjectMeta.OwnerReferences
 		updated = true
@@ -200,6 +220,12 @@ func GetTektonResultCR(config *v1alpha1.TektonConfig, operatorVersion string) *v
 		result = disableWatcherAndRetentionAgentOnHubCluster(result)
 	}
 
+	// The watcher holds its finalizer on the runs which are not stored yet,
+	// so that the pruners can't delete them before the grace period
+	if config.Spec.ResultsGuardActive() && result.Watcher.StoreDeadline == nil {
+		result.Watcher.StoreDeadline = &metav1.Duration{Duration: config.Spec.ResultsGuard.GetGracePeriod()}
+	}
+
 	return &v1alpha1.TektonResult{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            v1alpha1.ResultResourceName,
@@ -215,6 +241,7 @@ func GetTektonResultCR(config *v1alpha1.TektonConfig, operatorVersion string) *v
 			Result:        result,
 			Config:        config.Spec.Config,
 			NetworkPolicy: config.Spec.NetworkPolicy,
+			TLS:           config.Spec.TLS,
 		},
 	}
 }
@@ -397,3 +397,31 @@ func TestDisableWatcherAndRetentionAgentOnHubCluster(t *testing.T) {
 		}
 	})
 }
+
+func TestGetTektonResultCR_ResultsGuard(t *testing.T) {
+	config := getTektonConfig()
+	result := GetTektonResultCR(config, "v0.70.0")
+	if result.Spec.Watcher.StoreDeadline == nil || result.Spec.Watcher.StoreDeadline.Duration != v1alpha1.DefaultResultsGuardGracePeriod {
+		t.Errorf("expected the store deadline to be the default grace period, got %v", result.Spec.Watcher.StoreDeadline)
+	}
+
+	config.Spec.ResultsGuard.GracePeriod = &metav1.Duration{Duration: time.Hour}
+	result = GetTektonResultCR(config, "v0.70.0")
+	if result.Spec.Watcher.StoreDeadline == nil || result.Spec.Watcher.StoreDeadline.Duration != time.Hour {
+		t.Errorf("expected the store deadline to be the grace period, got %v", result.Spec.Watcher.StoreDeadline)
+	}
+
+	// a store deadline set explicitly is kept
+	config.Spec.Result.Watcher.StoreDeadline = &metav1.Duration{Duration: 10 * time.Minute}
+	result = GetTektonResultCR(config, "v0.70.0")
+	if result.Spec.Watcher.StoreDeadline.Duration != 10*time.Minute {
+		t.Errorf("expected the store deadline to be kept, got %v", result.Spec.Watcher.StoreDeadline)
+	}
+
+	config.Spec.Result.Watcher.StoreDeadline = nil
+	config.Spec.ResultsGuard.Disabled = true
+	result = GetTektonResultCR(config, "v0.70.0")
+	if result.Spec.Watcher.StoreDeadline != nil {
+		t.Errorf("expected no store deadline when the guard is disabled, got %v", result.Spec.Watcher.StoreDeadline)
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+)
+
+// reconcileRollback restores the installer sets of the previous release of
+// the components which are not ready within the rollback deadline after an
+// upgrade. The rolled back components are paused until the next operator
+// release, or until their annotations are removed to retry the upgrade.
+// A rollback is refused once an irreversible upgrade step ran for the
+// operator version
+func (r *Reconciler) reconcileRollback(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx)
+
+	rolledBack, err := r.resumeRolledBackComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := r.listSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+
+	requeue := false
+	var refused []string
+	for _, component := range managedComponents {
+		sets := snapshots[component.kind]
+		if len(sets) == 0 {
+			continue
+		}
+		cr, err := component.get(ctx, r.operatorClientSet)
+		if err != nil {
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		setClient := client.NewInstallerSetClient(r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets(),
+			r.operatorVersion, "", component.kind, nil)
+
+		// complete the rollback which was started, once the upgraded sets are deleted
+		if cr.GetAnnotations()[v1alpha1.RolledBackFromKey] == r.operatorVersion {
+			if err := r.restoreSnapshot(ctx, tc, setClient); err != nil {
+				if err != v1alpha1.REQUEUE_EVENT_AFTER {
+					return err
+				}
+				requeue = true
+			}
+			continue
+		}
+
+		if tc.Spec.Rollback.Disabled {
+			continue
+		}
+
+		started := oldestCreation(sets)
+		deadline := tc.Spec.Rollback.GetDeadline()
+		if time.Since(started) < deadline {
+			continue
+		}
+		ready, err := r.mainSetsReady(ctx, component.kind)
+		if err != nil {
+			return err
+		}
+		if ready {
+			continue
+		}
+
+		previous := sets[0].GetLabels()[v1alpha1.ReleaseVersionKey]
+		if version, steps := tc.Status.GetIrreversibleUpgrades(); version == r.operatorVersion && len(steps) != 0 {
+			msg := fmt.Sprintf("%s is not ready %s after the upgrade to %s, not rolling back to %s as irreversible upgrade steps ran: %s",
+				component.kind, deadline, r.operatorVersion, previous, strings.Join(steps, ", "))
+			logger.Warn(msg)
+			common.EmitEvent(ctx, tc, corev1.EventTypeWarning, common.ReasonUpgradeFailed, "%s", msg)
+			refused = append(refused, msg)
+			continue
+		}
+
+		logger.Infow("rolling back the upgrade of a component which is not ready",
+			"kind", component.kind, "from", r.operatorVersion, "to", previous, "deadline", deadline)
+		data, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{
+					v1alpha1.PausedKey:         "true",
+					v1alpha1.RolledBackFromKey: r.operatorVersion,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := component.patch(ctx, r.operatorClientSet, data); err != nil {
+			return fmt.Errorf("failed to pause %s for the rollback: %v", component.kind, err)
+		}
+		msg := fmt.Sprintf("%s was not ready %s after the upgrade to %s and was rolled back to %s",
+			component.kind, deadline, r.operatorVersion, previous)
+		common.EmitEvent(ctx, tc, corev1.EventTypeWarning, common.ReasonUpgradeFailed, "%s", msg)
+		common.EmitEvent(ctx, cr, corev1.EventTypeWarning, common.ReasonUpgradeFailed, "%s", msg)
+		rolledBack = append(rolledBack, msg)
+		if err := r.restoreSnapshot(ctx, tc, setClient); err != nil {
+			if err != v1alpha1.REQUEUE_EVENT_AFTER {
+				return err
+			}
+			requeue = true
+		}
+	}
+
+	switch {
+	case len(refused) != 0:
+		tc.Status.MarkRollbackRefused(strings.Join(refused, "; "))
+	case len(rolledBack) != 0:
+		tc.Status.MarkRolledBack(strings.Join(rolledBack, "; "))
+	default:
+		tc.Status.ClearRolledBack()
+	}
+
+	if requeue {
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+	return nil
+}
+
+// resumeRolledBackComponents removes the pause of the components which were
+// rolled back by a previous operator release, so that the new release is
+// installed. It returns a message for each component still rolled back
+func (r *Reconciler) resumeRolledBackComponents(ctx context.Context) ([]string, error) {
+	logger := logging.FromContext(ctx)
+
+	var rolledBack []string
+	for _, component := range managedComponents {
+		cr, err := component.get(ctx, r.operatorClientSet)
+		if err != nil {
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		from := cr.GetAnnotations()[v1alpha1.RolledBackFromKey]
+		if from == "" {
+			continue
+		}
+		if from == r.operatorVersion {
+			rolledBack = append(rolledBack, fmt.Sprintf("%s was rolled back after the upgrade to %s failed", component.kind, from))
+			continue
+		}
+
+		logger.Infow("resuming component rolled back by a previous release", "kind", component.kind, "rolledBackFrom", from)
+		data, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					v1alpha1.PausedKey:         nil,
+					v1alpha1.RolledBackFromKey: nil,
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := component.patch(ctx, r.operatorClientSet, data); err != nil {
+			return nil, fmt.Errorf("failed to resume %s after the rollback: %v", component.kind, err)
+		}
+	}
+	return rolledBack, nil
+}
+
+// restoreSnapshot restores the sets of the previous release, they are owned
+// by TektonConfig as the component is paused
+func (r *Reconciler) restoreSnapshot(ctx context.Context, tc *v1alpha1.TektonConfig, setClient *client.InstallerSetClient) error {
+	owner := *metav1.NewControllerRef(tc, v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonConfig))
+	if err := setClient.RestoreSnapshot(ctx, owner); err != nil && err != client.ErrNotFound {
+		return err
+	}
+	return nil
+}
+
+// listSnapshots returns the snapshot sets grouped by component kind
+func (r *Reconciler) listSnapshots(ctx context.Context) (map[string][]v1alpha1.TektonInstallerSet, error) {
+	typeReq, err := labels.NewRequirement(v1alpha1.InstallerSetType, selection.Equals, []string{client.InstallerTypeSnapshot})
+	if err != nil {
+		return nil, err
+	}
+	list, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.NewSelector().Add(*typeReq).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	snapshots := map[string][]v1alpha1.TektonInstallerSet{}
+	for _, set := range list.Items {
+		kind := set.GetLabels()[v1alpha1.CreatedByKey]
+		snapshots[kind] = append(snapshots[kind], set)
+	}
+	return snapshots, nil
+}
+
+// mainSetsReady returns true if the main sets of the component for the
+// operator version exist and are all ready
+func (r *Reconciler) mainSetsReady(ctx context.Context, kind string) (bool, error) {
+	selector := labels.SelectorFromSet(labels.Set{
+		v1alpha1.CreatedByKey:     kind,
+		v1alpha1.InstallerSetType: client.InstallerTypeMain,
+	})
+	list, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(list.Items) == 0 {
+		return false, nil
+	}
+	for _, set := range list.Items {
+		if set.GetLabels()[v1alpha1.ReleaseVersionKey] != r.operatorVersion ||
+			!set.Status.GetCondition(apis.ConditionReady).IsTrue() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// oldestCreation returns the time at which the first set was created, that
+// is when the upgrade of the component started
+func oldestCreation(sets []v1alpha1.TektonInstallerSet) time.Time {
+	oldest := sets[0].GetCreationTimestamp().Time
+	for _, set := range sets[1:] {
+		if created := set.GetCreationTimestamp().Time; created.Before(oldest) {
+			oldest = created
+		}
+	}
+	return oldest
+}
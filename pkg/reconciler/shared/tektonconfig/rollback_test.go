@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/injection/client/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	ts "knative.dev/pkg/reconciler/testing"
+)
+
+const (
+	previousVersion = "v0.1.0"
+	currentVersion  = "v0.2.0"
+)
+
+// setupUpgrade creates a TektonPipeline whose upgrade to currentVersion
+// started at the given time and whose new main sets are not ready
+func setupUpgrade(t *testing.T, started time.Time) (context.Context, *Reconciler) {
+	t.Helper()
+	ctx, _, _ := ts.SetupFakeContextWithCancel(t)
+	c := fake.Get(ctx)
+	// the fake clientset doesn't generate names
+	c.PrependReactor("create", "tektoninstallersets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		is := action.(k8stesting.CreateAction).GetObject().(*v1alpha1.TektonInstallerSet)
+		if is.GetName() == "" {
+			is.SetName(is.GetGenerateName() + "restored")
+		}
+		return false, is, nil
+	})
+
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName}}
+	_, err := c.OperatorV1alpha1().TektonPipelines().Create(ctx, tp, metav1.CreateOptions{})
+	assert.NilError(t, err)
+
+	owner := []metav1.OwnerReference{{Kind: v1alpha1.KindTektonPipeline, Name: v1alpha1.PipelineResourceName}}
+	sets := []*v1alpha1.TektonInstallerSet{
+		installerSet("pipeline-snapshot-static-abcd", client.InstallerTypeSnapshot, previousVersion, "pipeline-main-static-old", started, owner),
+		installerSet("pipeline-snapshot-deployment-efgh", client.InstallerTypeSnapshot, previousVersion, "pipeline-main-deployment-old", started, owner),
+		installerSet("pipeline-main-static-new", client.InstallerTypeMain, currentVersion, "", started, owner),
+		installerSet("pipeline-main-deployment-new", client.InstallerTypeMain, currentVersion, "", started, owner),
+	}
+	for _, is := range sets {
+		_, err := c.OperatorV1alpha1().TektonInstallerSets().Create(ctx, is, metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+	return ctx, &Reconciler{operatorClientSet: c, operatorVersion: currentVersion}
+}
+
+func installerSet(name, setType, version, snapshotOf string, created time.Time, owner []metav1.OwnerReference) *v1alpha1.TektonInstallerSet {
+	is := &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			GenerateName:      name + "-",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				v1alpha1.CreatedByKey:      v1alpha1.KindTektonPipeline,
+				v1alpha1.InstallerSetType:  setType,
+				v1alpha1.ReleaseVersionKey: version,
+			},
+			OwnerReferences: owner,
+		},
+	}
+	if snapshotOf != "" {
+		is.Annotations = map[string]string{v1alpha1.SnapshotOfKey: snapshotOf}
+	}
+	is.Status.InitializeConditions()
+	is.Status.MarkNotReady("deployment not ready")
+	return is
+}
+
+func listSets(t *testing.T, ctx context.Context, setType string) []v1alpha1.TektonInstallerSet {
+	t.Helper()
+	list, err := fake.Get(ctx).OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{v1alpha1.InstallerSetType: setType}).String(),
+	})
+	assert.NilError(t, err)
+	return list.Items
+}
+
+func getPipelineAnnotations(t *testing.T, ctx context.Context) map[string]string {
+	t.Helper()
+	tp, err := fake.Get(ctx).OperatorV1alpha1().TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	return tp.GetAnnotations()
+}
+
+func TestReconcileRollback(t *testing.T) {
+	ctx, r := setupUpgrade(t, time.Now().Add(-time.Hour))
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+
+	// the component is paused and the upgraded sets are deleted first
+	err := r.reconcileRollback(ctx, tc)
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	annotations := getPipelineAnnotations(t, ctx)
+	assert.Equal(t, annotations[v1alpha1.PausedKey], "true")
+	assert.Equal(t, annotations[v1alpha1.RolledBackFromKey], currentVersion)
+	assert.Equal(t, len(listSets(t, ctx, client.InstallerTypeMain)), 0)
+	cond := tc.Status.GetCondition(v1alpha1.RolledBack)
+	assert.Assert(t, cond != nil)
+	assert.Equal(t, cond.Status, corev1.ConditionTrue)
+	assert.Equal(t, cond.Reason, v1alpha1.UpgradeRolledBackReason)
+
+	// then the snapshot is restored, owned by TektonConfig
+	assert.NilError(t, r.reconcileRollback(ctx, tc))
+	restored := listSets(t, ctx, client.InstallerTypeMain)
+	assert.Equal(t, len(restored), 2)
+	for _, is := range restored {
+		assert.Equal(t, is.GetLabels()[v1alpha1.ReleaseVersionKey], previousVersion)
+		assert.Equal(t, is.GetOwnerReferences()[0].Kind, v1alpha1.KindTektonConfig)
+	}
+	assert.Equal(t, len(listSets(t, ctx, client.InstallerTypeSnapshot)), 0)
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.RolledBack).Status, corev1.ConditionTrue)
+
+	// the rollback doesn't affect the readiness of TektonConfig
+	tc.Status.InitializeConditions()
+	tc.Status.MarkComponentsReady()
+	tc.Status.MarkPreInstallComplete()
+	tc.Status.MarkPostInstallComplete()
+	tc.Status.MarkPreUpgradeComplete()
+	tc.Status.MarkPostUpgradeComplete()
+	assert.Assert(t, tc.Status.GetCondition(apis.ConditionReady).IsTrue())
+}
+
+func TestReconcileRollbackWithinDeadline(t *testing.T) {
+	ctx, r := setupUpgrade(t, time.Now())
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+
+	assert.NilError(t, r.reconcileRollback(ctx, tc))
+	assert.Equal(t, len(getPipelineAnnotations(t, ctx)), 0)
+	assert.Equal(t, len(listSets(t, ctx, client.InstallerTypeMain)), 2)
+	assert.Assert(t, tc.Status.GetCondition(v1alpha1.RolledBack) == nil)
+}
+
+func TestReconcileRollbackDisabled(t *testing.T) {
+	ctx, r := setupUpgrade(t, time.Now().Add(-time.Hour))
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Spec.Rollback.Disabled = true
+
+	assert.NilError(t, r.reconcileRollback(ctx, tc))
+	assert.Equal(t, len(getPipelineAnnotations(t, ctx)), 0)
+	assert.Equal(t, len(listSets(t, ctx, client.InstallerTypeMain)), 2)
+}
+
+func TestReconcileRollbackCustomDeadline(t *testing.T) {
+	ctx, r := setupUpgrade(t, time.Now().Add(-time.Hour))
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Spec.Rollback.Deadline = &metav1.Duration{Duration: 2 * time.Hour}
+
+	assert.NilError(t, r.reconcileRollback(ctx, tc))
+	assert.Equal(t, len(listSets(t, ctx, client.InstallerTypeMain)), 2)
+}
+
+func TestReconcileRollbackRefused(t *testing.T) {
+	ctx, r := setupUpgrade(t, time.Now().Add(-time.Hour))
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Status.AddIrreversibleUpgrades(currentVersion, "upgradeStorageVersion")
+
+	assert.NilError(t, r.reconcileRollback(ctx, tc))
+	assert.Equal(t, len(getPipelineAnnotations(t, ctx)), 0)
+	assert.Equal(t, len(listSets(t, ctx, client.InstallerTypeMain)), 2)
+	cond := tc.Status.GetCondition(v1alpha1.RolledBack)
+	assert.Assert(t, cond != nil)
+	assert.Equal(t, cond.Status, corev1.ConditionFalse)
+	assert.Equal(t, cond.Reason, v1alpha1.RollbackRefusedReason)
+	assert.Assert(t, strings.Contains(cond.Message, "upgradeStorageVersion"), cond.Message)
+
+	// steps recorded for another release don't prevent the rollback
+	tc.Status.AddIrreversibleUpgrades("v0.1.5", "upgradeStorageVersion")
+	assert.Equal(t, r.reconcileRollback(ctx, tc), v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.RolledBack).Reason, v1alpha1.UpgradeRolledBackReason)
+}
+
+func TestReconcileRollbackResume(t *testing.T) {
+	ctx, _, _ := ts.SetupFakeContextWithCancel(t)
+	c := fake.Get(ctx)
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{
+		Name: v1alpha1.PipelineResourceName,
+		Annotations: map[string]string{
+			v1alpha1.PausedKey:         "true",
+			v1alpha1.RolledBackFromKey: currentVersion,
+			"keep":                     "me",
+		},
+	}}
+	_, err := c.OperatorV1alpha1().TektonPipelines().Create(ctx, tp, metav1.CreateOptions{})
+	assert.NilError(t, err)
+
+	// the same release keeps the component rolled back
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	r := &Reconciler{operatorClientSet: c, operatorVersion: currentVersion}
+	assert.NilError(t, r.reconcileRollback(ctx, tc))
+	assert.Equal(t, getPipelineAnnotations(t, ctx)[v1alpha1.PausedKey], "true")
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.RolledBack).Status, corev1.ConditionTrue)
+
+	// the next release resumes it
+	r.operatorVersion = "v0.3.0"
+	assert.NilError(t, r.reconcileRollback(ctx, tc))
+	assert.DeepEqual(t, getPipelineAnnotations(t, ctx), map[string]string{"keep": "me"})
+	assert.Assert(t, tc.Status.GetCondition(v1alpha1.RolledBack) == nil)
+}
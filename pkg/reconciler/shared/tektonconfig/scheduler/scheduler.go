@@ -87,6 +87,7 @@ func GetTektonSchedulerCR(config *v1alpha1.TektonConfig, operatorVersion string)
 			},
 			Scheduler:     config.Spec.Scheduler,
 			NetworkPolicy: config.Spec.NetworkPolicy,
+			TLS:           config.Spec.TLS,
 		},
 	}
 }
@@ -134,6 +135,11 @@ func UpdateScheduler(ctx context.Context, old *v1alpha1.TektonScheduler, new *v1
 		updated = true
 	}
 
+	if !reflect.DeepEqual(old.Spec.TLS, new.Spec.TLS) {
+		old.Spec.TLS = new.Spec.TLS
+		updated = true
+	}
+
 	if old.ObjectMeta.OwnerReferences == nil {
 		old.ObjectMeta.OwnerReferences = new.ObjectMeta.OwnerReferences
 		updated = true
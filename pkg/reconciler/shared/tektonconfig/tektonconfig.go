@@ -24,6 +24,7 @@ import (
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	tektonConfigreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonconfig"
+	operatorlisters "github.com/tektoncd/operator/pkg/client/listers/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/chain"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/multiclusterproxyaae"
@@ -34,6 +35,8 @@ import (
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/syncerservice"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/trigger"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/upgrade"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/verification"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/apis"
@@ -47,12 +50,17 @@ type Reconciler struct {
 	kubeClientSet kubernetes.Interface
 	// operatorClientSet allows us to configure operator objects
 	operatorClientSet clientset.Interface
+	// read the component CRs and their installer sets from the informer caches
+	componentListers   map[string]ComponentLister
+	installerSetLister operatorlisters.TektonInstallerSetLister
 	// Platform-specific behavior to affect the transform
 	extension       common.Extension
 	manifest        mf.Manifest
 	operatorVersion string
 	// performs pre and post upgrade operations
 	upgrade *upgrade.Upgrade
+	// runs the post-install verification
+	verifier *verification.Verifier
 }
 
 // Check that our Reconciler implements controller.Reconciler
@@ -64,6 +72,13 @@ var (
 // FinalizeKind removes all resources after deletion of a TektonConfig.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonConfig) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
+	common.ForgetEvents(original)
+
+	// the components are being removed, stop reporting them in the metrics
+	common.ForgetComponentStatus(v1alpha1.KindTektonConfig)
+	for _, component := range managedComponents {
+		common.ForgetComponentStatus(component.kind)
+	}
 
 	if err := r.extension.Finalize(ctx, original); err != nil {
 		logger.Error("Failed to finalize platform resources", err)
@@ -130,12 +145,24 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		)
 		logger.Errorw("Invalid resource name", "expectedName", v1alpha1.ConfigResourceName, "actualName", tc.GetName())
 		tc.Status.MarkNotReady(msg)
+		common.EmitConfigurationRejected(ctx, tc, msg)
 		return nil
 	}
 
+	// record the per-component breakdown whichever way the reconcile ends
+	defer r.updateComponentStatuses(ctx, tc)
+
+	if err := common.ReconcilePaused(ctx, r.operatorClientSet, tc, &tc.Status); err != nil {
+		return err
+	}
+
 	// run pre upgrade
 	if err := r.upgrade.RunPreUpgrade(ctx); err != nil {
 		logger.Errorw("Pre-upgrade failed", "error", err)
+		if err != v1alpha1.RECONCILE_AGAIN_ERR {
+			common.EmitEvent(ctx, tc, corev1.EventTypeWarning, common.ReasonUpgradeFailed,
+				"Pre-upgrade to %s failed: %v", r.operatorVersion, err)
+		}
 		return err
 	}
 	logger.Debug("Pre-upgrade completed successfully")
@@ -146,6 +173,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		return err
 	}
 
+	// restore the previous release of the components which failed to upgrade
+	if err := r.reconcileRollback(ctx, tc); err != nil {
+		logger.Errorw("Failed to reconcile rollback", "error", err)
+		return err
+	}
+
 	// reconcile target namespace
 	nsMetaLabels := map[string]string{}
 	nsMetaAnnotations := map[string]string{}
@@ -208,6 +241,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		msg := "Invalid Pruner Configuration!! Both pruners, tektonpruner(event based) and pruner(job based) cannot be enabled simultaneously. Please disable one of them."
 		logger.Error(msg)
 		tc.Status.MarkComponentNotReady(msg)
+		common.EmitConfigurationRejected(ctx, tc, msg)
 		return v1alpha1.REQUEUE_EVENT_AFTER
 	} else {
 		logger.Infof("TektonPruner is enabled. Creating TektonPruner CR")
@@ -224,6 +258,8 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		}
 	}
 
+	common.ResolveEvent(tc, common.ReasonConfigurationRejected)
+
 	// Ensure TektonMulticlusterProxyAAE CR (conditional based on scheduler multi-cluster config).
 	// Run before EnsureSchedulerComponent so the CR is created even when scheduler component
 	// is blocked (e.g. cert-manager or Kueue not installed). Multicluster-proxy-aae is deployed only when:
@@ -327,7 +363,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	}
 
 	// Ensure Result CR
-	if !tc.Spec.Result.Disabled && (tc.Spec.Profile == v1alpha1.ProfileAll || tc.Spec.Profile == v1alpha1.ProfileBasic) {
+	if tc.Spec.IsResultEnabled() {
 		tektonresult := result.GetTektonResultCR(tc, r.operatorVersion)
 		if platformData := r.extension.GetPlatformData(); platformData != "" {
 			if tektonresult.Annotations == nil {
@@ -394,6 +430,16 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		logger.Debug("Pruner installer set reconciled successfully")
 	}
 
+	// Report whether the pruners keep the runs not yet stored in Results
+	switch {
+	case !tc.Spec.IsResultEnabled() || (tc.Spec.Pruner.Disabled && tc.Spec.TektonPruner.IsDisabled()):
+		tc.Status.ClearResultsGuard()
+	case tc.Spec.ResultsGuard.Disabled:
+		tc.Status.MarkResultsGuardDisabled("spec.resultsGuard is disabled, the pruners may delete PipelineRuns and TaskRuns before they are stored in Tekton Results")
+	default:
+		tc.Status.MarkResultsGuardActive()
+	}
+
 	// Run resource pruning
 	if err := common.Prune(ctx, r.kubeClientSet, tc); err != nil {
 		errMsg := fmt.Sprintf("tekton-resource-pruner: %s", err.Error())
@@ -403,6 +449,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		logger.Debug("Resource pruning completed successfully")
 	}
 
+	// NetworkPolicies of the TaskRun pods in the selected namespaces
+	if err := common.ReconcilePipelineNetworkPolicies(ctx, r.kubeClientSet, tc); err != nil {
+		errMsg := fmt.Sprintf("pipeline network policies: %s", err.Error())
+		logger.Errorw("Failed to reconcile pipeline network policies", "error", err)
+		tc.Status.MarkComponentNotReady(errMsg)
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
 	tc.Status.MarkComponentsReady()
 	logger.Debug("All components marked ready")
 
@@ -430,10 +484,22 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	// run post upgrade
 	if err := r.upgrade.RunPostUpgrade(ctx); err != nil {
 		logger.Errorw("Post-upgrade failed", "error", err)
+		if err != v1alpha1.RECONCILE_AGAIN_ERR {
+			common.EmitEvent(ctx, tc, corev1.EventTypeWarning, common.ReasonUpgradeFailed,
+				"Post-upgrade to %s failed: %v", r.operatorVersion, err)
+		}
 		return err
 	}
 	logger.Debug("Post-upgrade completed successfully")
 
+	// run post-install verification, once per operator version
+	if err := r.verifier.Reconcile(ctx, tc); err != nil {
+		if err != v1alpha1.REQUEUE_EVENT_AFTER {
+			logger.Errorw("Post-install verification failed", "error", err)
+		}
+		return err
+	}
+
 	logger.Debugw("TektonConfig reconciliation completed successfully",
 		"status", tc.Status.GetCondition(apis.ConditionReady))
 	return nil
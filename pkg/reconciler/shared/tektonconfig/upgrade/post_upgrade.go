@@ -71,6 +71,9 @@ func upgradeStorageVersion(ctx context.Context, logger *zap.SugaredLogger, k8sCl
 		"triggertemplates.triggers.tekton.dev",
 	}
 
+	// objects are rewritten in a storage version the previous release may not serve
+	markIrreversible(ctx, "upgradeStorageVersion")
+
 	migrator := upgrade.NewMigrator(
 		dynamic.NewForConfigOrDie(restConfig),
 		apixclient.NewForConfigOrDie(restConfig),
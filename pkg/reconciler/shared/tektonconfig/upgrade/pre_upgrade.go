@@ -109,6 +109,8 @@ func deleteTektonResultsTLSSecret(ctx context.Context, logger *zap.SugaredLogger
 
 	// delete default tekton-results-tls secret which has no OwnerReferences
 	if len(tlsSecret.OwnerReferences) == 0 {
+		// the previous release of results relies on the deleted certificate
+		markIrreversible(ctx, "deleteTektonResultsTLSSecret")
 		err = k8sClient.CoreV1().Secrets(trCR.Spec.TargetNamespace).Delete(ctx, tektonresult.TlsSecretName, metav1.DeleteOptions{})
 		if err != nil {
 			return err
@@ -18,10 +18,15 @@ package upgrade
 
 import (
 	"context"
+	"reflect"
+	"runtime"
+	"strings"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -51,6 +56,27 @@ var (
 	}
 )
 
+// irreversibleStepsKey is the context key of the irreversible steps executed
+// by the running upgrade
+type irreversibleStepsKey struct{}
+
+// markIrreversible flags the running upgrade step as irreversible: its changes
+// are not undone by restoring the installer sets of the previous release, so
+// a failed upgrade is not rolled back once it ran.
+// Steps call it only when they actually apply such a change
+func markIrreversible(ctx context.Context, step string) {
+	if steps, ok := ctx.Value(irreversibleStepsKey{}).(*[]string); ok {
+		*steps = append(*steps, step)
+	}
+}
+
+// stepName returns the name of an upgrade function, used as the step label
+// of the upgrade metrics
+func stepName(fn upgradeFunc) string {
+	name := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
 type upgradeFunc = func(ctx context.Context, logger *zap.SugaredLogger, k8sClient kubernetes.Interface, operatorClient versioned.Interface, restConfig *rest.Config) error
 
 type Upgrade struct {
@@ -104,12 +130,26 @@ func (ug *Upgrade) executeUpgrade(ctx context.Context, upgradeFunctions []upgrad
 	}
 
 	// execute upgrade functions
+	var irreversibleSteps []string
+	upgradeCtx := context.WithValue(ctx, irreversibleStepsKey{}, &irreversibleSteps)
+	phase := "post"
+	if isPreUpgrade {
+		phase = "pre"
+	}
 	for _, _upgradeFunc := range upgradeFunctions {
-		if err := _upgradeFunc(ctx, ug.logger, ug.k8sClient, ug.operatorClient, ug.restConfig); err != nil {
+		err := _upgradeFunc(upgradeCtx, ug.logger, ug.k8sClient, ug.operatorClient, ug.restConfig)
+		common.RecordUpgradeStep(ctx, phase, stepName(_upgradeFunc), err)
+		if err != nil {
 			ug.logger.Error("error on upgrade", err)
+			if recordErr := ug.recordIrreversibleSteps(ctx, irreversibleSteps); recordErr != nil {
+				return recordErr
+			}
 			return err
 		}
 	}
+	if err := ug.recordIrreversibleSteps(ctx, irreversibleSteps); err != nil {
+		return err
+	}
 	if isPreUpgrade {
 		ug.logger.Debug("completed pre upgrade execution")
 	} else {
@@ -147,6 +187,7 @@ func (ug *Upgrade) updateUpgradeVersion(ctx context.Context, isPreUpgrade bool)
 	}
 
 	// update upgrade version into TektonConfig CR, under status
+	previousVersion := _cr.Status.GetPostUpgradeVersion()
 	if isPreUpgrade {
 		_cr.Status.SetPreUpgradeVersion(ug.operatorVersion)
 	} else {
@@ -158,9 +199,34 @@ func (ug *Upgrade) updateUpgradeVersion(ctx context.Context, isPreUpgrade bool)
 		ug.logger.Errorw("error on updating TektonConfig CR status", "version", ug.operatorVersion, err)
 		return err
 	}
+	// the upgrade steps also run on a fresh install, which has no previous version
+	if !isPreUpgrade && previousVersion != "" {
+		common.EmitEvent(ctx, _cr, corev1.EventTypeNormal, common.ReasonUpgradeCompleted,
+			"Upgraded the operator from %s to %s", previousVersion, ug.operatorVersion)
+	}
 	return v1alpha1.RECONCILE_AGAIN_ERR
 }
 
+// recordIrreversibleSteps keeps the irreversible steps executed for the
+// operator version in TektonConfig status, rollback is refused after them
+func (ug *Upgrade) recordIrreversibleSteps(ctx context.Context, steps []string) error {
+	if len(steps) == 0 {
+		return nil
+	}
+	_cr, err := ug.operatorClient.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	if err != nil {
+		ug.logger.Error("error on getting TektonConfig CR", err)
+		return err
+	}
+
+	_cr.Status.AddIrreversibleUpgrades(ug.operatorVersion, steps...)
+	if _, err = ug.operatorClient.OperatorV1alpha1().TektonConfigs().UpdateStatus(ctx, _cr, metav1.UpdateOptions{}); err != nil {
+		ug.logger.Errorw("error on updating TektonConfig CR status", "version", ug.operatorVersion, err)
+		return err
+	}
+	return nil
+}
+
 func (ug *Upgrade) markUpgradeFalse(ctx context.Context, isPreUpgrade bool, reason, message string) error {
 	_cr, err := ug.operatorClient.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
 	if err != nil {
@@ -171,6 +237,10 @@ func (ug *Upgrade) markUpgradeFalse(ctx context.Context, isPreUpgrade bool, reas
 	isStatusChanged := false
 	if isPreUpgrade {
 		isStatusChanged = _cr.Status.MarkPreUpgradeFalse(reason, message)
+		if previousVersion := _cr.Status.GetPreUpgradeVersion(); previousVersion != "" {
+			common.EmitEvent(ctx, _cr, corev1.EventTypeNormal, common.ReasonUpgradeStarted,
+				"Upgrading the operator from %s to %s", previousVersion, ug.operatorVersion)
+		}
 	} else {
 		isStatusChanged = _cr.Status.MarkPostUpgradeFalse(reason, message)
 	}
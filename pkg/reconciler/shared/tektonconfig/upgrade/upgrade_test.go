@@ -273,6 +273,44 @@ func TestRunPostUpgrade(t *testing.T) {
 	assert.Equal(t, operatorVersion, tc.Status.GetPostUpgradeVersion())
 }
 
+func TestRunUpgradeRecordsIrreversibleSteps(t *testing.T) {
+	operatorVersion := "0.68.0"
+	ctx := context.TODO()
+	ug := getUpgradeStructWithFakeClients(ctx, operatorVersion)
+
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: v1alpha1.ConfigResourceName,
+		},
+	}
+	_, err := ug.operatorClient.OperatorV1alpha1().TektonConfigs().Create(ctx, tc, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	postUpgradeFunctions = []upgradeFunc{
+		func(ctx context.Context, logger *zap.SugaredLogger, k8sClient kubernetes.Interface, operatorClient versioned.Interface, restConfig *rest.Config) error {
+			markIrreversible(ctx, "migrate")
+			return nil
+		},
+		func(ctx context.Context, logger *zap.SugaredLogger, k8sClient kubernetes.Interface, operatorClient versioned.Interface, restConfig *rest.Config) error {
+			return errors.New("error on execution")
+		},
+	}
+
+	// status update: upgrade in progress
+	err = ug.RunPostUpgrade(ctx)
+	assert.Equal(t, v1alpha1.RECONCILE_AGAIN_ERR, err)
+
+	// the irreversible step is recorded even if the upgrade fails
+	err = ug.RunPostUpgrade(ctx)
+	assert.Error(t, err)
+	tc, err = ug.operatorClient.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	version, steps := tc.Status.GetIrreversibleUpgrades()
+	assert.Equal(t, operatorVersion, version)
+	assert.Equal(t, []string{"migrate"}, steps)
+	assert.Equal(t, "", tc.Status.GetPostUpgradeVersion())
+}
+
 func getUpgradeStructWithFakeClients(ctx context.Context, operatorVersion string) *Upgrade {
 	operatorClient := operatorFake.NewSimpleClientset()
 	k8sClient := k8sFake.NewSimpleClientset()
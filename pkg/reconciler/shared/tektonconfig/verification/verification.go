@@ -0,0 +1,503 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	taskRunName          = "verify-taskrun"
+	triggerTaskRunPrefix = "verify-trigger-"
+	eventListenerName    = "verify"
+	serviceAccountName   = "tekton-verification"
+	resultsRecordKey     = "results.tekton.dev/record"
+	verificationStepName = "verify"
+	verificationScript   = "echo \"Tekton is working\""
+
+	// roles of the Triggers release given to the service account of the EventListener
+	eventListenerRole        = "tekton-triggers-eventlistener-roles"
+	eventListenerClusterRole = "tekton-triggers-eventlistener-clusterroles"
+
+	// triggerKey labels the TaskRuns created by the trigger of the EventListener
+	triggerKey = "operator.tekton.dev/verification-trigger"
+
+	// imageEnvKey is the environment variable of the default step image
+	imageEnvKey = "IMAGE_JOB_VERIFICATION"
+	// legacyImageEnvKey held the tkn image before the job-based pruner had
+	// its own image, it is read when imageEnvKey is not set
+	legacyImageEnvKey = "IMAGE_JOB_PRUNER_TKN"
+)
+
+var (
+	taskRunGVR       = schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	eventListenerGVR = schema.GroupVersionResource{Group: "triggers.tekton.dev", Version: "v1beta1", Resource: "eventlisteners"}
+)
+
+// state of a check
+type state int
+
+const (
+	pending state = iota
+	passed
+	failed
+)
+
+// Verifier runs the post-install verification: a TaskRun in a scratch
+// namespace, and optionally a request to an EventListener and a check that
+// the TaskRun is stored by Tekton Results. It runs once the components are
+// ready for each operator version, and reports its outcome in the Verified
+// condition of TektonConfig
+type Verifier struct {
+	operatorVersion string
+	kubeClient      kubernetes.Interface
+	dynamicClient   dynamic.Interface
+	httpClient      *http.Client
+}
+
+func New(operatorVersion string, kubeClient kubernetes.Interface, dynamicClient dynamic.Interface) *Verifier {
+	return &Verifier{
+		operatorVersion: operatorVersion,
+		kubeClient:      kubeClient,
+		dynamicClient:   dynamicClient,
+		httpClient:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Reconcile runs the checks which are due and records their outcome in tc
+// status. It returns REQUEUE_EVENT_AFTER while the checks are running
+func (v *Verifier) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx)
+	spec := tc.Spec.Verification
+
+	if !spec.Enabled {
+		if inProgress(tc) {
+			if err := v.cleanup(ctx, spec.GetNamespace()); err != nil {
+				return err
+			}
+		}
+		tc.Status.ClearVerified()
+		return nil
+	}
+	// verify once per operator version, once the installation is ready
+	if tc.Status.GetVerifiedVersion() == v.operatorVersion || !tc.Status.IsReady() {
+		return nil
+	}
+
+	st, msg, err := v.check(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if st == pending {
+		logger.Debugw("post-install verification in progress", "status", msg)
+		tc.Status.MarkVerificationInProgress(msg)
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
+	if err := v.cleanup(ctx, spec.GetNamespace()); err != nil {
+		return err
+	}
+	tc.Status.SetVerifiedVersion(v.operatorVersion)
+	if st == failed {
+		logger.Warnw("post-install verification failed", "reason", msg)
+		tc.Status.MarkVerificationFailed(msg)
+		common.EmitEvent(ctx, tc, corev1.EventTypeWarning, v1alpha1.VerificationFailedReason, "%s", msg)
+		return nil
+	}
+	logger.Infow("post-install verification succeeded", "version", v.operatorVersion)
+	tc.Status.MarkVerified(msg)
+	common.EmitEvent(ctx, tc, corev1.EventTypeNormal, v1alpha1.VerificationSucceededReason, "%s", msg)
+	return nil
+}
+
+func inProgress(tc *v1alpha1.TektonConfig) bool {
+	cond := tc.Status.GetCondition(v1alpha1.Verified)
+	return cond != nil && cond.Reason == v1alpha1.VerificationInProgressReason
+}
+
+// check runs the checks in order, the first one which did not pass gives
+// the state of the verification
+func (v *Verifier) check(ctx context.Context, spec v1alpha1.Verification) (state, string, error) {
+	ns, st, msg, err := v.ensureNamespace(ctx, spec.GetNamespace())
+	if err != nil || st != passed {
+		return st, msg, err
+	}
+
+	checks := []func(context.Context, v1alpha1.Verification) (state, string, error){v.checkTaskRun}
+	if spec.Results {
+		checks = append(checks, v.checkResults)
+	}
+	if spec.Triggers {
+		checks = append(checks, v.checkTrigger)
+	}
+	for _, check := range checks {
+		st, msg, err := check(ctx, spec)
+		if err != nil {
+			return pending, "", err
+		}
+		if st == pending && timedOut(ns, spec.GetTimeout()) {
+			return failed, fmt.Sprintf("verification did not complete within %s: %s", spec.GetTimeout(), msg), nil
+		}
+		if st != passed {
+			return st, msg, nil
+		}
+	}
+
+	checked := "a TaskRun succeeded"
+	if spec.Results {
+		checked += " and was stored by Tekton Results"
+	}
+	if spec.Triggers {
+		checked += ", an EventListener created a TaskRun which succeeded"
+	}
+	return passed, fmt.Sprintf("Verified release %s: %s", v.operatorVersion, checked), nil
+}
+
+// timedOut reports whether the checks started in ns exceeded the timeout
+func timedOut(ns *corev1.Namespace, timeout time.Duration) bool {
+	started := ns.GetCreationTimestamp()
+	return !started.IsZero() && time.Since(started.Time) > timeout
+}
+
+// ensureNamespace creates the scratch namespace, it refuses to use a
+// namespace which it did not create as the namespace is deleted afterwards
+func (v *Verifier) ensureNamespace(ctx context.Context, name string) (*corev1.Namespace, state, string, error) {
+	ns, err := v.kubeClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		ns, err = v.kubeClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1alpha1.VerificationKey: "true"},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return nil, pending, "", err
+		}
+		return ns, pending, fmt.Sprintf("created namespace %s", name), nil
+	}
+	if err != nil {
+		return nil, pending, "", err
+	}
+	if ns.GetLabels()[v1alpha1.VerificationKey] != "true" {
+		return nil, failed, fmt.Sprintf("namespace %s exists and was not created by the verification", name), nil
+	}
+	if ns.DeletionTimestamp != nil {
+		return nil, pending, fmt.Sprintf("waiting for the deletion of namespace %s", name), nil
+	}
+	return ns, passed, "", nil
+}
+
+// checkTaskRun creates the verification TaskRun and waits for its completion
+func (v *Verifier) checkTaskRun(ctx context.Context, spec v1alpha1.Verification) (state, string, error) {
+	tr, err := v.getTaskRun(ctx, spec.GetNamespace(), taskRunName)
+	if apierrs.IsNotFound(err) {
+		image := stepImage(spec)
+		if image == "" {
+			return failed, "no image for the verification TaskRun, set spec.verification.image", nil
+		}
+		obj, err := toUnstructured(newTaskRun(spec.GetNamespace(), taskRunName, image, spec.GetTimeout()))
+		if err != nil {
+			return pending, "", err
+		}
+		if _, err := v.dynamicClient.Resource(taskRunGVR).Namespace(spec.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			return pending, "", err
+		}
+		return pending, fmt.Sprintf("created TaskRun %s", taskRunName), nil
+	}
+	if err != nil {
+		return pending, "", err
+	}
+	return taskRunState(tr)
+}
+
+// checkResults waits for the Results watcher to store the verification TaskRun
+func (v *Verifier) checkResults(ctx context.Context, spec v1alpha1.Verification) (state, string, error) {
+	tr, err := v.getTaskRun(ctx, spec.GetNamespace(), taskRunName)
+	if err != nil {
+		return pending, "", err
+	}
+	if tr.GetAnnotations()[resultsRecordKey] == "" {
+		return pending, fmt.Sprintf("waiting for TaskRun %s to be stored by Tekton Results", taskRunName), nil
+	}
+	return passed, "", nil
+}
+
+// checkTrigger creates an EventListener whose trigger creates a TaskRun,
+// sends it a request and waits for the completion of the TaskRun
+func (v *Verifier) checkTrigger(ctx context.Context, spec v1alpha1.Verification) (state, string, error) {
+	namespace := spec.GetNamespace()
+	tr, err := v.getTriggerTaskRun(ctx, namespace)
+	if err != nil {
+		return pending, "", err
+	}
+	if tr != nil {
+		return taskRunState(tr)
+	}
+
+	if err := v.ensureEventListenerRBAC(ctx, namespace); err != nil {
+		return pending, "", err
+	}
+	obj, err := v.dynamicClient.Resource(eventListenerGVR).Namespace(namespace).Get(ctx, eventListenerName, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		el, err := newEventListener(namespace, newTriggerTaskRun(namespace, stepImage(spec), spec.GetTimeout()))
+		if err != nil {
+			return pending, "", err
+		}
+		if obj, err = toUnstructured(el); err != nil {
+			return pending, "", err
+		}
+		if _, err := v.dynamicClient.Resource(eventListenerGVR).Namespace(namespace).Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			return pending, "", err
+		}
+		return pending, fmt.Sprintf("created EventListener %s", eventListenerName), nil
+	}
+	if err != nil {
+		return pending, "", err
+	}
+
+	el := &triggersv1beta1.EventListener{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, el); err != nil {
+		return pending, "", err
+	}
+	if !el.Status.GetCondition(apis.ConditionReady).IsTrue() || el.Status.Address == nil || el.Status.Address.URL == nil {
+		return pending, fmt.Sprintf("waiting for EventListener %s to be ready", eventListenerName), nil
+	}
+	url := el.Status.Address.URL.String()
+	resp, err := v.httpClient.Post(url, "application/json", bytes.NewBufferString("{}"))
+	if err != nil {
+		return pending, fmt.Sprintf("request to EventListener %s failed: %v", eventListenerName, err), nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return pending, fmt.Sprintf("EventListener %s answered %s", eventListenerName, resp.Status), nil
+	}
+	return pending, fmt.Sprintf("sent a request to EventListener %s", eventListenerName), nil
+}
+
+// ensureEventListenerRBAC gives the service account of the EventListener the
+// roles of the Triggers release. The ClusterRoleBinding is owned by the
+// scratch namespace so that it is garbage collected with it.
+func (v *Verifier) ensureEventListenerRBAC(ctx context.Context, namespace string) error {
+	labels := map[string]string{v1alpha1.VerificationKey: "true"}
+	subjects := []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: serviceAccountName, Namespace: namespace}}
+
+	ns, err := v.kubeClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	_, err = v.kubeClient.CoreV1().ServiceAccounts(namespace).Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Namespace: namespace, Labels: labels},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrs.IsAlreadyExists(err) {
+		return err
+	}
+	_, err = v.kubeClient.RbacV1().RoleBindings(namespace).Create(ctx, &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Namespace: namespace, Labels: labels},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: eventListenerRole},
+		Subjects:   subjects,
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrs.IsAlreadyExists(err) {
+		return err
+	}
+	_, err = v.kubeClient.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   clusterRoleBindingName(namespace),
+			Labels: labels,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1",
+				Kind:       "Namespace",
+				Name:       ns.GetName(),
+				UID:        ns.GetUID(),
+			}},
+		},
+		RoleRef:  rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: eventListenerClusterRole},
+		Subjects: subjects,
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrs.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func clusterRoleBindingName(namespace string) string {
+	return serviceAccountName + "-" + namespace
+}
+
+// cleanup deletes the scratch namespace and the cluster scoped resources of
+// the checks, including the ones of a scratch namespace used before
+func (v *Verifier) cleanup(ctx context.Context, namespace string) error {
+	ns, err := v.kubeClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil && !apierrs.IsNotFound(err) {
+		return err
+	}
+	if err == nil && ns.GetLabels()[v1alpha1.VerificationKey] == "true" && ns.DeletionTimestamp == nil {
+		if err := v.kubeClient.CoreV1().Namespaces().Delete(ctx, namespace, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+	err = v.kubeClient.RbacV1().ClusterRoleBindings().DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+		LabelSelector: v1alpha1.VerificationKey + "=true",
+	})
+	if err != nil && !apierrs.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (v *Verifier) getTaskRun(ctx context.Context, namespace, name string) (*pipelinev1.TaskRun, error) {
+	obj, err := v.dynamicClient.Resource(taskRunGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	tr := &pipelinev1.TaskRun{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, tr); err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
+
+// getTriggerTaskRun returns a TaskRun created by the trigger of the
+// EventListener, or nil when there is none yet
+func (v *Verifier) getTriggerTaskRun(ctx context.Context, namespace string) (*pipelinev1.TaskRun, error) {
+	list, err := v.dynamicClient.Resource(taskRunGVR).Namespace(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: triggerKey + "=true",
+	})
+	if err != nil || len(list.Items) == 0 {
+		return nil, err
+	}
+	tr := &pipelinev1.TaskRun{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[0].Object, tr); err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
+
+// stepImage returns the image of the step of the verification TaskRuns
+func stepImage(spec v1alpha1.Verification) string {
+	if spec.Image != "" {
+		return spec.Image
+	}
+	if image := os.Getenv(imageEnvKey); image != "" {
+		return image
+	}
+	return os.Getenv(legacyImageEnvKey)
+}
+
+func taskRunState(tr *pipelinev1.TaskRun) (state, string, error) {
+	cond := tr.Status.GetCondition(apis.ConditionSucceeded)
+	switch {
+	case cond.IsTrue():
+		return passed, "", nil
+	case cond.IsFalse():
+		return failed, fmt.Sprintf("TaskRun %s failed: %s", tr.GetName(), cond.Message), nil
+	}
+	return pending, fmt.Sprintf("waiting for TaskRun %s to complete", tr.GetName()), nil
+}
+
+func newTaskRun(namespace, name, image string, timeout time.Duration) *pipelinev1.TaskRun {
+	return &pipelinev1.TaskRun{
+		TypeMeta: metav1.TypeMeta{APIVersion: pipelinev1.SchemeGroupVersion.String(), Kind: "TaskRun"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{v1alpha1.VerificationKey: "true"},
+		},
+		Spec: pipelinev1.TaskRunSpec{
+			TaskSpec: &pipelinev1.TaskSpec{
+				Steps: []pipelinev1.Step{{
+					Name:   verificationStepName,
+					Image:  image,
+					Script: verificationScript,
+				}},
+			},
+			Timeout: &metav1.Duration{Duration: timeout},
+		},
+	}
+}
+
+// newTriggerTaskRun returns the TaskRun created by the trigger of the
+// EventListener, its name is generated as each request creates a TaskRun
+func newTriggerTaskRun(namespace, image string, timeout time.Duration) *pipelinev1.TaskRun {
+	tr := newTaskRun(namespace, "", image, timeout)
+	tr.GenerateName = triggerTaskRunPrefix
+	tr.Labels[triggerKey] = "true"
+	return tr
+}
+
+func newEventListener(namespace string, tr *pipelinev1.TaskRun) (*triggersv1beta1.EventListener, error) {
+	raw, err := json.Marshal(tr)
+	if err != nil {
+		return nil, err
+	}
+	return &triggersv1beta1.EventListener{
+		TypeMeta: metav1.TypeMeta{APIVersion: triggersv1beta1.SchemeGroupVersion.String(), Kind: "EventListener"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      eventListenerName,
+			Namespace: namespace,
+			Labels:    map[string]string{v1alpha1.VerificationKey: "true"},
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			ServiceAccountName: serviceAccountName,
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: eventListenerName,
+				Template: &triggersv1beta1.EventListenerTemplate{
+					Spec: &triggersv1beta1.TriggerTemplateSpec{
+						ResourceTemplates: []triggersv1beta1.TriggerResourceTemplate{{
+							RawExtension: runtime.RawExtension{Raw: raw},
+						}},
+					},
+				},
+			}},
+		},
+	}, nil
+}
+
+// toUnstructured converts a typed object for the dynamic client, through
+// JSON as the typed objects have custom marshallers
+func toUnstructured(obj interface{}) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+)
+
+const testVersion = "v0.99.0"
+
+func newVerifier(objs ...runtime.Object) *Verifier {
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			taskRunGVR:       "TaskRunList",
+			eventListenerGVR: "EventListenerList",
+		})
+	return New(testVersion, k8sfake.NewSimpleClientset(objs...), dynamicClient)
+}
+
+func readyConfig(verification v1alpha1.Verification) *v1alpha1.TektonConfig {
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec:       v1alpha1.TektonConfigSpec{Verification: verification},
+	}
+	tc.Status.InitializeConditions()
+	tc.Status.MarkPreInstallComplete()
+	tc.Status.MarkComponentsReady()
+	tc.Status.MarkPostInstallComplete()
+	tc.Status.MarkPreUpgradeComplete()
+	tc.Status.MarkPostUpgradeComplete()
+	return tc
+}
+
+// setSucceeded sets the Succeeded condition of a TaskRun, as the pipelines controller would
+func setSucceeded(t *testing.T, v *Verifier, namespace, name string, status corev1.ConditionStatus) {
+	t.Helper()
+	ctx := context.Background()
+	obj, err := v.dynamicClient.Resource(taskRunGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	assert.NilError(t, err)
+	err = unstructured.SetNestedSlice(obj.Object, []interface{}{map[string]interface{}{
+		"type":    string(apis.ConditionSucceeded),
+		"status":  string(status),
+		"message": "step verify exited with 1",
+	}}, "status", "conditions")
+	assert.NilError(t, err)
+	_, err = v.dynamicClient.Resource(taskRunGVR).Namespace(namespace).Update(ctx, obj, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+}
+
+// runUntilTaskRun reconciles until the verification TaskRun is created
+func runUntilTaskRun(t *testing.T, v *Verifier, tc *v1alpha1.TektonConfig) {
+	t.Helper()
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		err := v.Reconcile(ctx, tc)
+		assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+		assert.Equal(t, tc.Status.GetCondition(v1alpha1.Verified).Reason, v1alpha1.VerificationInProgressReason)
+	}
+	_, err := v.getTaskRun(ctx, tc.Spec.Verification.GetNamespace(), taskRunName)
+	assert.NilError(t, err)
+}
+
+func TestVerifierSucceeded(t *testing.T) {
+	ctx := context.Background()
+	v := newVerifier()
+	tc := readyConfig(v1alpha1.Verification{Enabled: true, Image: "busybox"})
+
+	runUntilTaskRun(t, v, tc)
+	ns, err := v.kubeClient.CoreV1().Namespaces().Get(ctx, v1alpha1.DefaultVerificationNamespace, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, ns.Labels[v1alpha1.VerificationKey], "true")
+
+	tr, err := v.getTaskRun(ctx, v1alpha1.DefaultVerificationNamespace, taskRunName)
+	assert.NilError(t, err)
+	assert.Equal(t, tr.Spec.TaskSpec.Steps[0].Image, "busybox")
+	assert.Equal(t, tr.Spec.Timeout.Duration, v1alpha1.DefaultVerificationTimeout)
+
+	setSucceeded(t, v, v1alpha1.DefaultVerificationNamespace, taskRunName, corev1.ConditionTrue)
+	assert.NilError(t, v.Reconcile(ctx, tc))
+
+	cond := tc.Status.GetCondition(v1alpha1.Verified)
+	assert.Equal(t, cond.Status, corev1.ConditionTrue)
+	assert.Equal(t, cond.Reason, v1alpha1.VerificationSucceededReason)
+	assert.Equal(t, tc.Status.GetVerifiedVersion(), testVersion)
+	assert.Assert(t, tc.Status.IsReady())
+
+	// the scratch namespace is deleted
+	_, err = v.kubeClient.CoreV1().Namespaces().Get(ctx, v1alpha1.DefaultVerificationNamespace, metav1.GetOptions{})
+	assert.Assert(t, apierrs.IsNotFound(err))
+
+	// the verification does not run again for the same version
+	assert.NilError(t, v.Reconcile(ctx, tc))
+	_, err = v.kubeClient.CoreV1().Namespaces().Get(ctx, v1alpha1.DefaultVerificationNamespace, metav1.GetOptions{})
+	assert.Assert(t, apierrs.IsNotFound(err))
+
+	// disabling the verification removes the condition
+	tc.Spec.Verification.Enabled = false
+	assert.NilError(t, v.Reconcile(ctx, tc))
+	assert.Assert(t, tc.Status.GetCondition(v1alpha1.Verified) == nil)
+	assert.Equal(t, tc.Status.GetVerifiedVersion(), "")
+}
+
+func TestVerifierTaskRunFailed(t *testing.T) {
+	ctx := context.Background()
+	v := newVerifier()
+	tc := readyConfig(v1alpha1.Verification{Enabled: true, Image: "busybox", Namespace: "smoke"})
+
+	runUntilTaskRun(t, v, tc)
+	setSucceeded(t, v, "smoke", taskRunName, corev1.ConditionFalse)
+	assert.NilError(t, v.Reconcile(ctx, tc))
+
+	cond := tc.Status.GetCondition(v1alpha1.Verified)
+	assert.Equal(t, cond.Status, corev1.ConditionFalse)
+	assert.Equal(t, cond.Reason, v1alpha1.VerificationFailedReason)
+	assert.Assert(t, strings.Contains(cond.Message, "step verify exited with 1"), cond.Message)
+	assert.Equal(t, tc.Status.GetVerifiedVersion(), testVersion)
+	// a failed verification does not affect the readiness
+	assert.Assert(t, tc.Status.IsReady())
+}
+
+func TestVerifierTimeout(t *testing.T) {
+	ctx := context.Background()
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:              v1alpha1.DefaultVerificationNamespace,
+		Labels:            map[string]string{v1alpha1.VerificationKey: "true"},
+		CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+	}}
+	v := newVerifier(ns)
+	tc := readyConfig(v1alpha1.Verification{Enabled: true, Image: "busybox"})
+
+	assert.NilError(t, v.Reconcile(ctx, tc))
+	cond := tc.Status.GetCondition(v1alpha1.Verified)
+	assert.Equal(t, cond.Status, corev1.ConditionFalse)
+	assert.Assert(t, strings.Contains(cond.Message, "did not complete within 5m0s"), cond.Message)
+}
+
+func TestVerifierForeignNamespace(t *testing.T) {
+	ctx := context.Background()
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DefaultVerificationNamespace}}
+	v := newVerifier(ns)
+	tc := readyConfig(v1alpha1.Verification{Enabled: true, Image: "busybox"})
+
+	assert.NilError(t, v.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.Verified).Status, corev1.ConditionFalse)
+
+	// a namespace which was not created by the verification is never deleted
+	_, err := v.kubeClient.CoreV1().Namespaces().Get(ctx, v1alpha1.DefaultVerificationNamespace, metav1.GetOptions{})
+	assert.NilError(t, err)
+}
+
+func TestVerifierWaitsForReadiness(t *testing.T) {
+	ctx := context.Background()
+	v := newVerifier()
+	tc := readyConfig(v1alpha1.Verification{Enabled: true, Image: "busybox"})
+	tc.Status.MarkComponentNotReady("pipelines not ready")
+
+	assert.NilError(t, v.Reconcile(ctx, tc))
+	assert.Assert(t, tc.Status.GetCondition(v1alpha1.Verified) == nil)
+	_, err := v.kubeClient.CoreV1().Namespaces().Get(ctx, v1alpha1.DefaultVerificationNamespace, metav1.GetOptions{})
+	assert.Assert(t, apierrs.IsNotFound(err))
+}
+
+func TestVerifierResults(t *testing.T) {
+	ctx := context.Background()
+	v := newVerifier()
+	tc := readyConfig(v1alpha1.Verification{Enabled: true, Image: "busybox", Results: true})
+
+	runUntilTaskRun(t, v, tc)
+	setSucceeded(t, v, v1alpha1.DefaultVerificationNamespace, taskRunName, corev1.ConditionTrue)
+	assert.Equal(t, v.Reconcile(ctx, tc), v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Assert(t, strings.Contains(tc.Status.GetCondition(v1alpha1.Verified).Message, "Tekton Results"))
+
+	// the Results watcher records the TaskRun
+	obj, err := v.dynamicClient.Resource(taskRunGVR).Namespace(v1alpha1.DefaultVerificationNamespace).Get(ctx, taskRunName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	obj.SetAnnotations(map[string]string{resultsRecordKey: "tekton-verification/results/1/records/1"})
+	_, err = v.dynamicClient.Resource(taskRunGVR).Namespace(v1alpha1.DefaultVerificationNamespace).Update(ctx, obj, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+
+	assert.NilError(t, v.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.Verified).Status, corev1.ConditionTrue)
+}
+
+func TestVerifierTriggers(t *testing.T) {
+	ctx := context.Background()
+	v := newVerifier()
+	namespace := v1alpha1.DefaultVerificationNamespace
+	tc := readyConfig(v1alpha1.Verification{Enabled: true, Image: "busybox", Triggers: true})
+
+	// the EventListener creates the TaskRun of its trigger template
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		obj, err := v.dynamicClient.Resource(eventListenerGVR).Namespace(namespace).Get(r.Context(), eventListenerName, metav1.GetOptions{})
+		assert.NilError(t, err)
+		templates, _, _ := unstructured.NestedSlice(obj.Object, "spec", "triggers")
+		assert.Equal(t, len(templates), 1)
+		tr, err := toUnstructured(newTriggerTaskRun(namespace, "busybox", time.Minute))
+		assert.NilError(t, err)
+		// the fake client does not generate names
+		tr.SetName(triggerTaskRunPrefix + "abcde")
+		_, err = v.dynamicClient.Resource(taskRunGVR).Namespace(namespace).Create(r.Context(), tr, metav1.CreateOptions{})
+		assert.NilError(t, err)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	runUntilTaskRun(t, v, tc)
+	setSucceeded(t, v, namespace, taskRunName, corev1.ConditionTrue)
+
+	// the EventListener is created with its RBAC
+	assert.Equal(t, v.Reconcile(ctx, tc), v1alpha1.REQUEUE_EVENT_AFTER)
+	crb, err := v.kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, clusterRoleBindingName(namespace), metav1.GetOptions{})
+	assert.NilError(t, err)
+	// the binding is garbage collected with the namespace
+	assert.Equal(t, len(crb.OwnerReferences), 1)
+	assert.Equal(t, crb.OwnerReferences[0].Kind, "Namespace")
+	assert.Equal(t, crb.OwnerReferences[0].Name, namespace)
+	_, err = v.kubeClient.RbacV1().RoleBindings(namespace).Get(ctx, serviceAccountName, metav1.GetOptions{})
+	assert.NilError(t, err)
+
+	// no request is sent before the EventListener is ready
+	assert.Equal(t, v.Reconcile(ctx, tc), v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Equal(t, requests, 0)
+
+	obj, err := v.dynamicClient.Resource(eventListenerGVR).Namespace(namespace).Get(ctx, eventListenerName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	obj.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+		"address":    map[string]interface{}{"url": server.URL},
+	}
+	_, err = v.dynamicClient.Resource(eventListenerGVR).Namespace(namespace).Update(ctx, obj, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+
+	assert.Equal(t, v.Reconcile(ctx, tc), v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Equal(t, requests, 1)
+
+	// the request is sent once
+	assert.Equal(t, v.Reconcile(ctx, tc), v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Equal(t, requests, 1)
+
+	setSucceeded(t, v, namespace, triggerTaskRunPrefix+"abcde", corev1.ConditionTrue)
+	assert.NilError(t, v.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.Verified).Status, corev1.ConditionTrue)
+
+	// the cluster scoped bindings of the verification are removed with the namespace
+	deleted := false
+	for _, action := range v.kubeClient.(*k8sfake.Clientset).Actions() {
+		if dc, ok := action.(k8stesting.DeleteCollectionAction); ok && dc.GetResource().Resource == "clusterrolebindings" {
+			deleted = dc.GetListRestrictions().Labels.String() == v1alpha1.VerificationKey+"=true"
+		}
+	}
+	assert.Assert(t, deleted)
+}
+
+func TestStepImage(t *testing.T) {
+	tests := []struct {
+		name        string
+		image       string
+		envImage    string
+		legacyImage string
+		expected    string
+	}{
+		{name: "spec", image: "busybox", envImage: "tkn", legacyImage: "legacy-tkn", expected: "busybox"},
+		{name: "environment", envImage: "tkn", legacyImage: "legacy-tkn", expected: "tkn"},
+		{name: "legacy environment", legacyImage: "legacy-tkn", expected: "legacy-tkn"},
+		{name: "none", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(imageEnvKey, test.envImage)
+			t.Setenv(legacyImageEnvKey, test.legacyImage)
+			assert.Equal(t, stepImage(v1alpha1.Verification{Image: test.image}), test.expected)
+		})
+	}
+}
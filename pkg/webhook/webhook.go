@@ -20,12 +20,14 @@ import (
 	"context"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/webhook/configmaps"
 	"knative.dev/pkg/webhook/resourcesemantics"
+	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 )
@@ -91,6 +93,127 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 	)
 }
 
+func NewConversionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return conversion.NewConversionController(ctx,
+		// The path on which to serve the webhook.
+		"/resource-conversion",
+
+		// Specify the types of custom resource definitions that should be converted,
+		// objects are stored as v1alpha1 and converted through the v1beta1 hub.
+		map[schema.GroupKind]conversion.GroupKindConversion{
+			v1beta1.Kind(v1alpha1.KindTektonConfig): {
+				DefinitionName: "tektonconfigs.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonConfig{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonConfig{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonPipeline): {
+				DefinitionName: "tektonpipelines.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonPipeline{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonPipeline{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonTrigger): {
+				DefinitionName: "tektontriggers.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonTrigger{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonTrigger{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonChain): {
+				DefinitionName: "tektonchains.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonChain{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonChain{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonResult): {
+				DefinitionName: "tektonresults.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonResult{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonResult{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonDashboard): {
+				DefinitionName: "tektondashboards.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonDashboard{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonDashboard{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonAddon): {
+				DefinitionName: "tektonaddons.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonAddon{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonAddon{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonPruner): {
+				DefinitionName: "tektonpruners.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonPruner{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonPruner{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonScheduler): {
+				DefinitionName: "tektonschedulers.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonScheduler{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonScheduler{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindTektonMulticlusterProxyAAE): {
+				DefinitionName: "tektonmulticlusterproxyaaes.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.TektonMulticlusterProxyAAE{},
+					v1beta1.SchemaVersion:  &v1beta1.TektonMulticlusterProxyAAE{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindManualApprovalGate): {
+				DefinitionName: "manualapprovalgates.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.ManualApprovalGate{},
+					v1beta1.SchemaVersion:  &v1beta1.ManualApprovalGate{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindOpenShiftPipelinesAsCode): {
+				DefinitionName: "openshiftpipelinesascodes.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.OpenShiftPipelinesAsCode{},
+					v1beta1.SchemaVersion:  &v1beta1.OpenShiftPipelinesAsCode{},
+				},
+			},
+			v1beta1.Kind(v1alpha1.KindSyncerService): {
+				DefinitionName: "syncerservices.operator.tekton.dev",
+				HubVersion:     v1beta1.SchemaVersion,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					v1alpha1.SchemaVersion: &v1alpha1.SyncerService{},
+					v1beta1.SchemaVersion:  &v1beta1.SyncerService{},
+				},
+			},
+		},
+
+		// A function that infuses the context passed to ConvertTo/ConvertFrom/SetDefaults with custom metadata.
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+	)
+}
+
 func NewConfigValidationController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	return configmaps.NewAdmissionController(ctx,
 		// Name of the configmap webhook.